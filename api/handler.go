@@ -51,11 +51,17 @@ var (
 	HandlerPathManifestByHash             = `/block/{hash:(?i)[0-9a-z][0-9a-z]+}/manifest`
 	HandlerPathAccount                    = `/account/{address:(?i)` + types.REStringAddressString + `}`            // revive:disable-line:line-length-limit
 	HandlerPathAccountOperations          = `/account/{address:(?i)` + types.REStringAddressString + `}/operations` // revive:disable-line:line-length-limit
+	HandlerPathAccountTransferLocks       = `/account/{address:(?i)` + types.REStringAddressString + `}/locks`      // revive:disable-line:line-length-limit
+	HandlerPathAccountProposals           = `/account/{address:(?i)` + types.REStringAddressString + `}/proposals`  // revive:disable-line:line-length-limit
+	HandlerPathAccountAllowances          = `/account/{address:(?i)` + types.REStringAddressString + `}/allowances` // revive:disable-line:line-length-limit
+	HandlerPathAccountKeyHistory          = `/account/{address:(?i)` + types.REStringAddressString + `}/keys`       // revive:disable-line:line-length-limit
+	HandlerPathAccountOwnerHistory        = `/account/{address:(?i)` + types.REStringAddressString + `}/owners`     // revive:disable-line:line-length-limit
 	HandlerPathAccounts                   = `/accounts`
 	HandlerPathOperationBuildFactTemplate = `/builder/operation/fact/template/{fact:[\w][\w\-]*}`
 	HandlerPathOperationBuildFact         = `/builder/operation/fact`
 	HandlerPathOperationBuildSign         = `/builder/operation/sign`
 	HandlerPathOperationBuild             = `/builder/operation`
+	HandlerPathOperationFee               = `/builder/operation/fee`
 	HandlerPathSend                       = `/builder/send`
 	HandlerPathQueueSend                  = `/builder/send/queue`
 	HandelrPathEventOperation             = `/event/operation/{hash:(?i)[0-9a-z][0-9a-z]+}`
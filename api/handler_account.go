@@ -105,6 +105,38 @@ func buildAccountHal(hd *Handlers, va digest.AccountValue) (Hal, error) {
 		AddLink("operations:{offset}", NewHalLink(h+"?offset={offset}", nil).SetTemplated()).
 		AddLink("operations:{offset,reverse}", NewHalLink(h+"?offset={offset}&reverse=1", nil).SetTemplated())
 
+	h, err = hd.CombineURL(HandlerPathAccountTransferLocks, "address", hinted)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("locks", NewHalLink(h, nil))
+
+	h, err = hd.CombineURL(HandlerPathAccountProposals, "address", hinted)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("proposals", NewHalLink(h, nil))
+
+	h, err = hd.CombineURL(HandlerPathAccountAllowances, "address", hinted)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("allowances", NewHalLink(h, nil))
+
+	h, err = hd.CombineURL(HandlerPathAccountKeyHistory, "address", hinted)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("keys", NewHalLink(h, nil))
+
+	if va.ContractAccountStatus().Owner() != nil {
+		h, err = hd.CombineURL(HandlerPathAccountOwnerHistory, "address", hinted)
+		if err != nil {
+			return nil, err
+		}
+		hal = hal.AddLink("owners", NewHalLink(h, nil))
+	}
+
 	h, err = hd.CombineURL(HandlerPathBlockByHeight, "height", va.Height().String())
 	if err != nil {
 		return nil, err
@@ -114,6 +146,306 @@ func buildAccountHal(hd *Handlers, va digest.AccountValue) (Hal, error) {
 	return hal, nil
 }
 
+func HandleAccountTransferLocks(hd *Handlers, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	cachekey := CacheKeyPath(r)
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	var address base.Address
+	if a, err := base.DecodeAddress(strings.TrimSpace(mux.Vars(r)["address"]), hd.enc); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if err := a.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+		return
+	} else {
+		address = a
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return handleAccountTransferLocksInGroup(hd, address)
+	}); err != nil {
+		hd.Log().Err(err).Str("address", address.String()).Msg("get transfer locks")
+
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, hd.expireShortLived)
+		}
+	}
+}
+
+func handleAccountTransferLocksInGroup(hd *Handlers, address base.Address) (interface{}, error) {
+	vs, err := hd.database.OpenTransferLocks(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(vs) < 1 {
+		return hd.enc.Marshal(NewEmptyHal())
+	}
+
+	self, err := hd.CombineURL(HandlerPathAccountTransferLocks, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal = NewBaseHal(vs, NewHalLink(self, nil))
+
+	h, err := hd.CombineURL(HandlerPathAccount, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("account", NewHalLink(h, nil))
+
+	return hd.enc.Marshal(hal)
+}
+
+func HandleAccountAllowances(hd *Handlers, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	cachekey := CacheKeyPath(r)
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	var address base.Address
+	if a, err := base.DecodeAddress(strings.TrimSpace(mux.Vars(r)["address"]), hd.enc); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if err := a.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+		return
+	} else {
+		address = a
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return handleAccountAllowancesInGroup(hd, address)
+	}); err != nil {
+		hd.Log().Err(err).Str("address", address.String()).Msg("get allowances")
+
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, hd.expireShortLived)
+		}
+	}
+}
+
+func handleAccountAllowancesInGroup(hd *Handlers, address base.Address) (interface{}, error) {
+	vs, err := hd.database.Allowances(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(vs) < 1 {
+		return hd.enc.Marshal(NewEmptyHal())
+	}
+
+	self, err := hd.CombineURL(HandlerPathAccountAllowances, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal = NewBaseHal(vs, NewHalLink(self, nil))
+
+	h, err := hd.CombineURL(HandlerPathAccount, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("account", NewHalLink(h, nil))
+
+	return hd.enc.Marshal(hal)
+}
+
+func HandleAccountProposals(hd *Handlers, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	cachekey := CacheKeyPath(r)
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	var address base.Address
+	if a, err := base.DecodeAddress(strings.TrimSpace(mux.Vars(r)["address"]), hd.enc); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if err := a.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+		return
+	} else {
+		address = a
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return handleAccountProposalsInGroup(hd, address)
+	}); err != nil {
+		hd.Log().Err(err).Str("address", address.String()).Msg("get proposals")
+
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, hd.expireShortLived)
+		}
+	}
+}
+
+func handleAccountProposalsInGroup(hd *Handlers, address base.Address) (interface{}, error) {
+	vs, err := hd.database.PendingProposals(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(vs) < 1 {
+		return hd.enc.Marshal(NewEmptyHal())
+	}
+
+	self, err := hd.CombineURL(HandlerPathAccountProposals, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal = NewBaseHal(vs, NewHalLink(self, nil))
+
+	h, err := hd.CombineURL(HandlerPathAccount, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("account", NewHalLink(h, nil))
+
+	return hd.enc.Marshal(hal)
+}
+
+func HandleAccountKeyHistory(hd *Handlers, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	cachekey := CacheKeyPath(r)
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	var address base.Address
+	if a, err := base.DecodeAddress(strings.TrimSpace(mux.Vars(r)["address"]), hd.enc); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if err := a.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+		return
+	} else {
+		address = a
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return handleAccountKeyHistoryInGroup(hd, address)
+	}); err != nil {
+		hd.Log().Err(err).Str("address", address.String()).Msg("get key history")
+
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, hd.expireShortLived)
+		}
+	}
+}
+
+func handleAccountKeyHistoryInGroup(hd *Handlers, address base.Address) (interface{}, error) {
+	vs, err := hd.database.KeyHistory(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(vs) < 1 {
+		return hd.enc.Marshal(NewEmptyHal())
+	}
+
+	self, err := hd.CombineURL(HandlerPathAccountKeyHistory, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal = NewBaseHal(vs, NewHalLink(self, nil))
+
+	h, err := hd.CombineURL(HandlerPathAccount, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("account", NewHalLink(h, nil))
+
+	return hd.enc.Marshal(hal)
+}
+
+func HandleAccountOwnerHistory(hd *Handlers, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	cachekey := CacheKeyPath(r)
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	var address base.Address
+	if a, err := base.DecodeAddress(strings.TrimSpace(mux.Vars(r)["address"]), hd.enc); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if err := a.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+		return
+	} else {
+		address = a
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return handleAccountOwnerHistoryInGroup(hd, address)
+	}); err != nil {
+		hd.Log().Err(err).Str("address", address.String()).Msg("get owner history")
+
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, hd.expireShortLived)
+		}
+	}
+}
+
+func handleAccountOwnerHistoryInGroup(hd *Handlers, address base.Address) (interface{}, error) {
+	vs, err := hd.database.OwnerHistory(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(vs) < 1 {
+		return hd.enc.Marshal(NewEmptyHal())
+	}
+
+	self, err := hd.CombineURL(HandlerPathAccountOwnerHistory, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal = NewBaseHal(vs, NewHalLink(self, nil))
+
+	h, err := hd.CombineURL(HandlerPathAccount, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("account", NewHalLink(h, nil))
+
+	return hd.enc.Marshal(hal)
+}
+
 func HandleAccountOperations(hd *Handlers, w http.ResponseWriter, r *http.Request) {
 	defer r.Body.Close()
 	var address base.Address
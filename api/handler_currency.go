@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/gorilla/mux"
+	ccstate "github.com/imfact-labs/currency-model/state/currency"
 	"github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/pkg/errors"
@@ -110,6 +111,33 @@ func buildCurrency(hd *Handlers, de types.CurrencyDesign, st base.State) (Hal, e
 
 	var hal Hal
 	hal = NewBaseHal(de, NewHalLink(h, nil))
+	hal = hal.AddExtras("burned", de.Burned().String())
+	hal = hal.AddExtras("circulating_supply", de.TotalSupply().String())
+
+	pst, found, err := hd.database.PendingPolicy(de.Currency().String())
+	if err != nil {
+		return nil, err
+	}
+
+	active := de.Policy()
+	if found {
+		lastBlock := hd.database.LastBlock()
+		pv := pst.Value().(ccstate.PendingPolicyStateValue) //nolint:forcetypeassert //...
+
+		// NOTE the applied pending policy is already in the currency design.
+		if !pv.Applied {
+			switch ade, activated, err := ccstate.ActivatePendingPolicy(st, pst, lastBlock); {
+			case err != nil:
+				return nil, err
+			case activated:
+				active = ade.Policy()
+			case pv.Height > lastBlock:
+				hal = hal.AddExtras("pending_policy", pv)
+			}
+		}
+	}
+
+	hal = hal.AddExtras("active_policy", active)
 
 	hal = hal.AddLink("currency:{currency_id}", NewHalLink(HandlerPathCurrency, nil).SetTemplated())
 
@@ -28,6 +28,8 @@ func SetHandlers(hd *Handlers, digest bool) {
 			Methods(http.MethodOptions, "GET")
 		_ = hd.SetHandler(HandlerPathCurrency, HandleCurrency, true, get, get).
 			Methods(http.MethodOptions, "GET")
+		_ = hd.SetHandler(HandlerPathOperationFee, HandleOperationFee, false, post, post).
+			Methods(http.MethodOptions, http.MethodPost)
 		_ = hd.SetHandler(HandlerPathManifests, HandleManifests, true, get, get).
 			Methods(http.MethodOptions, "GET")
 		_ = hd.SetHandler(HandlerPathOperations, HandleOperations, true, get, get).
@@ -50,6 +52,16 @@ func SetHandlers(hd *Handlers, digest bool) {
 			Methods(http.MethodOptions, "GET")
 		_ = hd.SetHandler(HandlerPathAccountOperations, HandleAccountOperations, true, get, get).
 			Methods(http.MethodOptions, "GET")
+		_ = hd.SetHandler(HandlerPathAccountTransferLocks, HandleAccountTransferLocks, true, get, get).
+			Methods(http.MethodOptions, "GET")
+		_ = hd.SetHandler(HandlerPathAccountProposals, HandleAccountProposals, true, get, get).
+			Methods(http.MethodOptions, "GET")
+		_ = hd.SetHandler(HandlerPathAccountAllowances, HandleAccountAllowances, true, get, get).
+			Methods(http.MethodOptions, "GET")
+		_ = hd.SetHandler(HandlerPathAccountKeyHistory, HandleAccountKeyHistory, true, get, get).
+			Methods(http.MethodOptions, "GET")
+		_ = hd.SetHandler(HandlerPathAccountOwnerHistory, HandleAccountOwnerHistory, true, get, get).
+			Methods(http.MethodOptions, "GET")
 		_ = hd.SetHandler(HandlerPathAccounts, HandleAccounts, true, get, get).
 			Methods(http.MethodOptions, "GET")
 		_ = hd.SetHandler(HandlerPathDIDData, HandleDIDData, true, get, get).
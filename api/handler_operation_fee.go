@@ -0,0 +1,113 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/digest"
+	"github.com/imfact-labs/currency-model/operation/processor"
+	ccstate "github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+// HandleOperationFee estimates the fee of the operation in the request body
+// with the currency policies in digest. The operation does not need to be
+// signed.
+func HandleOperationFee(hd *Handlers, w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	body := &bytes.Buffer{}
+	defer body.Reset()
+	if _, err := io.Copy(body, r.Body); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	var v json.RawMessage
+	if err := json.Unmarshal(body.Bytes(), &v); err != nil {
+		HTTP2ProblemWithError(w, common.ErrDecodeJson.Wrap(err), http.StatusBadRequest)
+		return
+	}
+
+	hinter, err := hd.enc.Decode(body.Bytes())
+	if err != nil {
+		nerr := err
+		if !errors.Is(err, common.ErrDecodeJson) {
+			nerr = common.ErrDecodeJson.Wrap(err)
+		}
+		HTTP2ProblemWithError(w, nerr, http.StatusBadRequest)
+		return
+	}
+
+	op, ok := hinter.(base.Operation)
+	if !ok {
+		HTTP2ProblemWithError(w, errors.Errorf("expected Operation, not %T", hinter), http.StatusBadRequest)
+		return
+	}
+
+	hal, err := buildOperationFeeHal(hd, op)
+	if err != nil {
+		HTTP2HandleError(w, err)
+		return
+	}
+
+	HTTP2WriteHal(hd.enc, w, hal, http.StatusOK)
+}
+
+func buildOperationFeeHal(hd *Handlers, op base.Operation) (Hal, error) {
+	feeer, receipt, _, feeCID, reasonErr := processor.CalculateFee(op, digestDesignStateFunc(hd.database))
+	if reasonErr != nil {
+		return nil, digest.ErrBadRequest.Wrap(reasonErr)
+	}
+
+	var hal Hal = NewBaseHal(receipt, NewHalLink(HandlerPathOperationFee, nil))
+	hal = hal.AddExtras("feeer", feeer.Hint().String())
+	hal = hal.AddExtras("fee_currency", feeCID)
+
+	return hal, nil
+}
+
+// digestDesignStateFunc returns base.GetStateFunc, which finds only the
+// currency design states from digest. The pending policy, which will be
+// activated at the next block, is applied to the currency design.
+func digestDesignStateFunc(db *digest.Database) base.GetStateFunc {
+	return func(key string) (base.State, bool, error) {
+		if !ccstate.IsDesignStateKey(key) {
+			return nil, false, nil
+		}
+
+		cid := strings.TrimPrefix(key, ccstate.DesignStateKeyPrefix)
+
+		_, st, err := db.Currency(cid)
+		switch {
+		case errors.Is(err, util.ErrNotFound):
+			return nil, false, nil
+		case err != nil:
+			return nil, false, err
+		}
+
+		switch pst, found, err := db.PendingPolicy(cid); {
+		case err != nil:
+			return nil, false, err
+		case !found:
+			return st, true, nil
+		default:
+			de, activated, err := ccstate.ActivatePendingPolicy(st, pst, db.LastBlock()+1)
+			switch {
+			case err != nil:
+				return nil, false, err
+			case !activated:
+				return st, true, nil
+			}
+
+			return common.NewBaseState(
+				st.Height(), key, ccstate.NewCurrencyDesignStateValue(de), st.Previous(), st.Operations(),
+			), true, nil
+		}
+	}
+}
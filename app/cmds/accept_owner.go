@@ -0,0 +1,129 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/imfact-labs/currency-model/operation/extension"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/pkg/errors"
+
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type AcceptOwnerCommand struct {
+	BaseCommand
+	OperationFlags
+	Sender   AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract AddressFlag    `arg:"" name:"contract" help:"target contract account address" required:"true"`
+	Currency CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	OperationExtensionFlags
+	sender base.Address
+	target base.Address
+}
+
+func (cmd *AcceptOwnerCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *AcceptOwnerCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if sender, err := cmd.Sender.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %v", cmd.Sender.String())
+	} else if target, err := cmd.Contract.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid contract address format, %v", cmd.Contract.String())
+	} else {
+		cmd.sender = sender
+		cmd.target = target
+	}
+
+	err := cmd.OperationExtensionFlags.parseFlags(cmd.Encoders.JSON())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cmd *AcceptOwnerCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	fact := extension.NewAcceptOwnerFact([]byte(cmd.Token), cmd.sender, cmd.target, cmd.Currency.CID)
+
+	op, err := extension.NewAcceptOwner(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "create acceptOwner operation")
+	}
+
+	var baseAuthentication extras.OperationExtension
+	var baseSettlement extras.OperationExtension
+	var baseProxyPayer extras.OperationExtension
+	var proofData = cmd.Proof
+	if cmd.IsPrivateKey {
+		prk, err := base.DecodePrivatekeyFromString(cmd.Proof, enc)
+		if err != nil {
+			return nil, err
+		}
+
+		sig, err := prk.Sign(fact.Hash().Bytes())
+		if err != nil {
+			return nil, err
+		}
+		proofData = sig.String()
+	}
+
+	if cmd.didContract != nil && cmd.AuthenticationID != "" && cmd.Proof != "" {
+		baseAuthentication = extras.NewBaseAuthentication(cmd.didContract, cmd.AuthenticationID, proofData)
+		if err := op.AddExtension(baseAuthentication); err != nil {
+			return nil, err
+		}
+	}
+
+	if cmd.proxyPayer != nil {
+		baseProxyPayer = extras.NewBaseProxyPayer(cmd.proxyPayer)
+		if err := op.AddExtension(baseProxyPayer); err != nil {
+			return nil, err
+		}
+	}
+
+	if cmd.opSender != nil {
+		baseSettlement = extras.NewBaseSettlement(cmd.opSender)
+		if err := op.AddExtension(baseSettlement); err != nil {
+			return nil, err
+		}
+
+		err = op.HashSign(cmd.OpSenderPrivatekey, cmd.NetworkID.NetworkID())
+		if err != nil {
+			return nil, errors.Wrapf(err, "create %T operation", op)
+		}
+	} else {
+		err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+		if err != nil {
+			return nil, errors.Wrapf(err, "create %T operation", op)
+		}
+	}
+
+	if err := op.IsValid(cmd.OperationFlags.NetworkID); err != nil {
+		return nil, errors.Wrapf(err, "create %T operation", op)
+	}
+
+	return op, nil
+}
@@ -0,0 +1,143 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/imfact-labs/currency-model/operation/currency"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/pkg/errors"
+
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type ApproveCommand struct {
+	BaseCommand
+	OperationFlags
+	Sender   AddressFlag        `arg:"" name:"sender" help:"sender address" required:"true"`
+	Spender  AddressFlag        `arg:"" name:"spender" help:"spender address" required:"true"`
+	Amount   CurrencyAmountFlag `arg:"" name:"currency-amount" help:"allowance; zero revokes it (ex: \"<currency>,<amount>\")" required:"true"`
+	Currency CurrencyIDFlag     `arg:"" name:"currency-id" help:"fee currency id" required:"true"`
+	Expiry   base.Height        `name:"expiry" help:"block height, from which allowance can not be used; 0 for no expiry" default:"0"`
+	OperationExtensionFlags
+	EstimateFeeFlags
+	sender  base.Address
+	spender base.Address
+}
+
+func (cmd *ApproveCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	if cmd.EstimateFee {
+		return cmd.estimate(pctx, cmd.Out, op)
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *ApproveCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %v", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	sp, err := cmd.Spender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid spender format, %v", cmd.Spender.String())
+	}
+	cmd.spender = sp
+
+	err = cmd.OperationExtensionFlags.parseFlags(cmd.Encoders.JSON())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cmd *ApproveCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	am := types.NewAmount(cmd.Amount.Big, cmd.Amount.CID)
+
+	fact := currency.NewApproveFact(
+		[]byte(cmd.Token), cmd.sender, cmd.spender, am, cmd.Expiry, cmd.Currency.CID)
+
+	op, err := currency.NewApprove(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "create approve operation")
+	}
+
+	var baseAuthentication extras.OperationExtension
+	var baseSettlement extras.OperationExtension
+	var baseProxyPayer extras.OperationExtension
+	var proofData = cmd.Proof
+	if cmd.IsPrivateKey {
+		prk, err := base.DecodePrivatekeyFromString(cmd.Proof, enc)
+		if err != nil {
+			return nil, err
+		}
+
+		sig, err := prk.Sign(fact.Hash().Bytes())
+		if err != nil {
+			return nil, err
+		}
+		proofData = sig.String()
+	}
+
+	if cmd.didContract != nil && cmd.AuthenticationID != "" && cmd.Proof != "" {
+		baseAuthentication = extras.NewBaseAuthentication(cmd.didContract, cmd.AuthenticationID, proofData)
+		if err := op.AddExtension(baseAuthentication); err != nil {
+			return nil, err
+		}
+	}
+
+	if cmd.proxyPayer != nil {
+		baseProxyPayer = extras.NewBaseProxyPayer(cmd.proxyPayer)
+		if err := op.AddExtension(baseProxyPayer); err != nil {
+			return nil, err
+		}
+	}
+
+	if cmd.opSender != nil {
+		baseSettlement = extras.NewBaseSettlement(cmd.opSender)
+		if err := op.AddExtension(baseSettlement); err != nil {
+			return nil, err
+		}
+
+		err = op.HashSign(cmd.OpSenderPrivatekey, cmd.NetworkID.NetworkID())
+		if err != nil {
+			return nil, errors.Wrapf(err, "create %T operation", op)
+		}
+	} else {
+		err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+		if err != nil {
+			return nil, errors.Wrapf(err, "create %T operation", op)
+		}
+	}
+
+	if err := op.IsValid(cmd.OperationFlags.NetworkID); err != nil {
+		return nil, errors.Wrapf(err, "create %T operation", op)
+	}
+
+	return op, nil
+}
@@ -0,0 +1,73 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/imfact-labs/currency-model/operation/currency"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type ApproveOperationCommand struct {
+	BaseCommand
+	OperationFlags
+	Account  AddressFlag    `arg:"" name:"account" help:"account of the proposal" required:"true"`
+	Currency CurrencyIDFlag `arg:"" name:"currency-id" help:"fee currency id" required:"true"`
+	Proposal string         `name:"proposal" help:"proposal id, the fact hash of propose-operation" required:"true"`
+	account  base.Address
+}
+
+func (cmd *ApproveOperationCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *ApproveOperationCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Account.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid account format, %v", cmd.Account.String())
+	}
+	cmd.account = a
+
+	return nil
+}
+
+func (cmd *ApproveOperationCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	fact := currency.NewApproveOperationFact([]byte(cmd.Token), cmd.account, cmd.Proposal, cmd.Currency.CID)
+
+	op, err := currency.NewApproveOperation(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "create approve-operation operation")
+	}
+
+	if err := op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID()); err != nil {
+		return nil, errors.Wrapf(err, "create %T operation", op)
+	}
+
+	if err := op.IsValid(cmd.OperationFlags.NetworkID); err != nil {
+		return nil, errors.Wrapf(err, "create %T operation", op)
+	}
+
+	return op, nil
+}
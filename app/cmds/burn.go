@@ -0,0 +1,144 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/imfact-labs/currency-model/operation/currency"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/pkg/errors"
+
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type BurnCommand struct {
+	BaseCommand
+	OperationFlags
+	Sender   AddressFlag        `arg:"" name:"sender" help:"sender address" required:"true"`
+	Amount   CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount to burn (ex: \"<currency>,<amount>\")" required:"true"`
+	Currency CurrencyIDFlag     `arg:"" name:"currency-id" help:"fee currency id" required:"true"`
+	Target   AddressFlag        `name:"target" help:"target address to burn from; only genesis account of currency can burn from other account (default: sender)"` // nolint lll
+	OperationExtensionFlags
+	EstimateFeeFlags
+	sender base.Address
+	target base.Address
+}
+
+func (cmd *BurnCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	if cmd.EstimateFee {
+		return cmd.estimate(pctx, cmd.Out, op)
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *BurnCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %v", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	cmd.target = a
+	if len(cmd.Target.String()) > 0 {
+		t, err := cmd.Target.Encode(enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid target format, %v", cmd.Target.String())
+		}
+		cmd.target = t
+	}
+
+	err = cmd.OperationExtensionFlags.parseFlags(cmd.Encoders.JSON())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cmd *BurnCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	am := types.NewAmount(cmd.Amount.Big, cmd.Amount.CID)
+
+	fact := currency.NewBurnFact([]byte(cmd.Token), cmd.sender, cmd.target, am, cmd.Currency.CID)
+
+	op, err := currency.NewBurn(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "create burn operation")
+	}
+
+	var baseAuthentication extras.OperationExtension
+	var baseSettlement extras.OperationExtension
+	var baseProxyPayer extras.OperationExtension
+	var proofData = cmd.Proof
+	if cmd.IsPrivateKey {
+		prk, err := base.DecodePrivatekeyFromString(cmd.Proof, enc)
+		if err != nil {
+			return nil, err
+		}
+
+		sig, err := prk.Sign(fact.Hash().Bytes())
+		if err != nil {
+			return nil, err
+		}
+		proofData = sig.String()
+	}
+
+	if cmd.didContract != nil && cmd.AuthenticationID != "" && cmd.Proof != "" {
+		baseAuthentication = extras.NewBaseAuthentication(cmd.didContract, cmd.AuthenticationID, proofData)
+		if err := op.AddExtension(baseAuthentication); err != nil {
+			return nil, err
+		}
+	}
+
+	if cmd.proxyPayer != nil {
+		baseProxyPayer = extras.NewBaseProxyPayer(cmd.proxyPayer)
+		if err := op.AddExtension(baseProxyPayer); err != nil {
+			return nil, err
+		}
+	}
+
+	if cmd.opSender != nil {
+		baseSettlement = extras.NewBaseSettlement(cmd.opSender)
+		if err := op.AddExtension(baseSettlement); err != nil {
+			return nil, err
+		}
+
+		err = op.HashSign(cmd.OpSenderPrivatekey, cmd.NetworkID.NetworkID())
+		if err != nil {
+			return nil, errors.Wrapf(err, "create %T operation", op)
+		}
+	} else {
+		err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+		if err != nil {
+			return nil, errors.Wrapf(err, "create %T operation", op)
+		}
+	}
+
+	if err := op.IsValid(cmd.OperationFlags.NetworkID); err != nil {
+		return nil, errors.Wrapf(err, "create %T operation", op)
+	}
+
+	return op, nil
+}
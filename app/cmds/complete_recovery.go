@@ -0,0 +1,72 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/imfact-labs/currency-model/operation/currency"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type CompleteRecoveryCommand struct {
+	BaseCommand
+	OperationFlags
+	Account  AddressFlag    `arg:"" name:"account" help:"account under recovery" required:"true"`
+	Currency CurrencyIDFlag `arg:"" name:"currency-id" help:"fee currency id" required:"true"`
+	account  base.Address
+}
+
+func (cmd *CompleteRecoveryCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *CompleteRecoveryCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Account.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid account format, %v", cmd.Account.String())
+	}
+	cmd.account = a
+
+	return nil
+}
+
+func (cmd *CompleteRecoveryCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	fact := currency.NewCompleteRecoveryFact([]byte(cmd.Token), cmd.account, cmd.Currency.CID)
+
+	op, err := currency.NewCompleteRecovery(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "create complete-recovery operation")
+	}
+
+	if err := op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID()); err != nil {
+		return nil, errors.Wrapf(err, "create %T operation", op)
+	}
+
+	if err := op.IsValid(cmd.OperationFlags.NetworkID); err != nil {
+		return nil, errors.Wrapf(err, "create %T operation", op)
+	}
+
+	return op, nil
+}
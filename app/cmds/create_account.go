@@ -20,6 +20,7 @@ type CreateAccountCommand struct {
 	Amount    CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\")"`
 	Currency  CurrencyIDFlag     `arg:"" name:"currency-id" help:"fee currency id" required:"true"`
 	OperationExtensionFlags
+	EstimateFeeFlags
 	sender base.Address
 	keys   types.AccountKeys
 }
@@ -38,6 +39,10 @@ func (cmd *CreateAccountCommand) Run(pctx context.Context) error { // nolint:dup
 		return err
 	}
 
+	if cmd.EstimateFee {
+		return cmd.estimate(pctx, cmd.Out, op)
+	}
+
 	PrettyPrint(cmd.Out, op)
 
 	return nil
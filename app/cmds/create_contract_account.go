@@ -18,6 +18,7 @@ type CreateContractAccountCommand struct {
 	Key       KeyFlag            `name:"key" help:"key for new account (ex: \"<public key>,<weight>\") separator @"`
 	Amount    CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\")"`
 	Currency  CurrencyIDFlag     `arg:"" name:"currency-id" help:"fee currency id" required:"true"`
+	Salt      string             `name:"salt" help:"salt to derive contract account address from sender" optional:""`
 	OperationExtensionFlags
 	sender base.Address
 	keys   types.AccountKeys
@@ -93,7 +94,7 @@ func (cmd *CreateContractAccountCommand) createOperation() (base.Operation, erro
 
 	ams[0] = am
 
-	item := extension.NewCreateContractAccountItemMultiAmounts(cmd.keys, ams)
+	item := extension.NewCreateContractAccountItemMultiAmounts(cmd.keys, ams, cmd.Salt)
 	if err := item.IsValid(nil); err != nil {
 		return nil, err
 	}
@@ -4,10 +4,33 @@ type CurrencyCommand struct {
 	CreateAccount         CreateAccountCommand         `cmd:"" name:"create-account" help:"create new account"`
 	UpdateKey             UpdateKeyCommand             `cmd:"" name:"update-key" help:"update account keys"`
 	Transfer              TransferCommand              `cmd:"" name:"transfer" help:"transfer"`
+	Burn                  BurnCommand                  `cmd:"" name:"burn" help:"burn amount from balance and total supply"`
+	FreezeAccount         FreezeAccountCommand         `cmd:"" name:"freeze-account" help:"freeze account in currency"`
+	UnfreezeAccount       UnfreezeAccountCommand       `cmd:"" name:"unfreeze-account" help:"unfreeze account in currency"`
+	ForceTransfer         ForceTransferCommand         `cmd:"" name:"force-transfer" help:"transfer amount out of frozen account"`
+	CreateVesting         CreateVestingCommand         `cmd:"" name:"create-vesting" help:"lock amount in vesting schedule of receiver"`
+	ReleaseVesting        ReleaseVestingCommand        `cmd:"" name:"release-vesting" help:"release vested amount to balance"`
+	LockTransfer          LockTransferCommand          `cmd:"" name:"lock-transfer" help:"lock amount under hashlock for receiver"`
+	ClaimTransfer         ClaimTransferCommand         `cmd:"" name:"claim-transfer" help:"claim locked amount with preimage of hashlock"`
+	RefundTransfer        RefundTransferCommand        `cmd:"" name:"refund-transfer" help:"refund expired locked amount to sender"`
+	ScheduleTransfer      ScheduleTransferCommand      `cmd:"" name:"schedule-transfer" help:"schedule one-shot or recurring transfer"`
+	CancelSchedule        CancelScheduleCommand        `cmd:"" name:"cancel-schedule" help:"cancel scheduled transfer"`
+	ProposeOperation      ProposeOperationCommand      `cmd:"" name:"propose-operation" help:"propose operation for approval of account keys"`
+	ApproveOperation      ApproveOperationCommand      `cmd:"" name:"approve-operation" help:"approve proposed operation"`
+	Approve               ApproveCommand               `cmd:"" name:"approve" help:"approve allowance of spender"`
+	TransferFrom          TransferFromCommand          `cmd:"" name:"transfer-from" help:"transfer amount from owner within allowance"`
+	SetGuardians          SetGuardiansCommand          `cmd:"" name:"set-guardians" help:"set guardians for account recovery"`
+	RecoverAccount        RecoverAccountCommand        `cmd:"" name:"recover-account" help:"start recovery of account keys by guardians"`
+	CompleteRecovery      CompleteRecoveryCommand      `cmd:"" name:"complete-recovery" help:"replace account keys after recovery delay"`
+	CancelRecovery        CancelRecoveryCommand        `cmd:"" name:"cancel-recovery" help:"cancel pending recovery of account"`
 	RegisterCurrency      RegisterCurrencyCommand      `cmd:"" name:"register-currency" help:"register new currency"`
 	UpdateCurrency        UpdateCurrencyCommand        `cmd:"" name:"update-currency" help:"update currency policy"`
 	CreateContractAccount CreateContractAccountCommand `cmd:"" name:"create-contract-account" help:"create new contract account"`
 	UpdateHandler         UpdateHandlerCommand         `cmd:"" name:"update-handler" help:"update handler of contract account"`
 	UpdateRecipient       UpdateRecipientCommand       `cmd:"" name:"update-recipient" help:"update recipient of contract account"`
+	UpdateOwner           UpdateOwnerCommand           `cmd:"" name:"update-owner" help:"nominate new owner of contract account"`
+	AcceptOwner           AcceptOwnerCommand           `cmd:"" name:"accept-owner" help:"accept ownership of contract account"`
+	UpdateBalanceStatus   UpdateBalanceStatusCommand   `cmd:"" name:"update-balance-status" help:"update balance status of contract account"`
+	UpdateActivation      UpdateActivationCommand      `cmd:"" name:"update-activation" help:"activate or deactivate contract account"`
 	Withdraw              WithdrawCommand              `cmd:"" name:"withdraw" help:"withdraw amounts from target contract account"`
 }
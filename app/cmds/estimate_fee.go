@@ -0,0 +1,77 @@
+package cmds
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/imfact-labs/currency-model/api"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type EstimateFeeFlags struct {
+	EstimateFee bool          `name:"estimate-fee" help:"print the estimated fee of operation by digest api instead of operation"`
+	API         string        `name:"estimate-fee-api" help:"digest api url for fee estimation" default:"https://127.0.0.1:54320"`
+	TLSInsecure bool          `name:"estimate-fee-tls-insecure" help:"skip tls verification of digest api"`
+	Timeout     time.Duration `name:"estimate-fee-timeout" help:"timeout for fee estimation" default:"10s"`
+}
+
+// estimate sends the operation to the fee api of digest and writes the
+// response to out.
+func (f *EstimateFeeFlags) estimate(pctx context.Context, out io.Writer, op base.Operation) error {
+	u, err := url.Parse(f.API)
+	if err != nil {
+		return errors.Wrapf(err, "invalid estimate fee api, %v", f.API)
+	}
+
+	u = u.JoinPath(api.HandlerPathOperationFee)
+
+	b, err := enc.Marshal(op)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(pctx, f.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: f.TLSInsecure}, //nolint:gosec //...
+		},
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "estimate fee")
+	}
+
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return errors.Wrap(err, "estimate fee")
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf("estimate fee, %v: %s", res.Status, body)
+	}
+
+	_, _ = fmt.Fprintln(out, string(body))
+
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
 )
 
 type KeyFlag struct {
@@ -276,6 +277,60 @@ func (v *CurrencyAmountFlag) String() string {
 	return v.CID.String() + "," + v.Big.String()
 }
 
+// HandlerScopeFlag is the scope of handler,
+// "<handler>@<operation hint type>,...@<currency id>:<withdraw limit>,...".
+type HandlerScopeFlag struct {
+	handler    string
+	operations []hint.Type
+	limits     []types.Amount
+}
+
+func (v *HandlerScopeFlag) UnmarshalText(b []byte) error {
+	l := strings.SplitN(string(b), "@", 3)
+	if len(l) != 3 {
+		return fmt.Errorf("invalid handler scope, %q", string(b))
+	}
+
+	v.handler = l[0]
+
+	if len(l[1]) > 0 {
+		for _, o := range strings.Split(l[1], ",") {
+			t := hint.Type(o)
+			if err := t.IsValid(nil); err != nil {
+				return errors.Wrapf(err, "invalid operation hint type, %q", o)
+			}
+
+			v.operations = append(v.operations, t)
+		}
+	}
+
+	if len(l[2]) > 0 {
+		for _, a := range strings.Split(l[2], ",") {
+			var f CurrencyAmountFlag
+			if err := f.UnmarshalText([]byte(strings.Replace(a, ":", ",", 1))); err != nil {
+				return errors.Wrapf(err, "invalid withdraw limit, %q", a)
+			}
+
+			v.limits = append(v.limits, types.NewAmount(f.Big, f.CID))
+		}
+	}
+
+	return nil
+}
+
+func (v *HandlerScopeFlag) String() string {
+	return v.handler
+}
+
+func (v *HandlerScopeFlag) Encode(enc encoder.Encoder) (types.HandlerScope, error) {
+	handler, err := base.DecodeAddress(v.handler, enc)
+	if err != nil {
+		return types.HandlerScope{}, err
+	}
+
+	return types.NewHandlerScope(handler, v.operations, v.limits), nil
+}
+
 type ContractIDFlag struct {
 	ID types.ContractID
 }
@@ -0,0 +1,36 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/pkg/errors"
+)
+
+type KeyContractAddressCommand struct {
+	BaseCommand
+	Owner AddressFlag `arg:"" name:"owner" help:"owner address of contract account" required:"true"`
+	Salt  string      `arg:"" name:"salt" help:"salt for contract account address" required:"true"`
+}
+
+func (cmd *KeyContractAddressCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	owner, err := cmd.Owner.Encode(cmd.Encoder)
+	if err != nil {
+		return errors.Wrapf(err, "invalid owner format, %v", cmd.Owner.String())
+	}
+
+	cmd.Log.Debug().Stringer("owner", owner).Str("salt", cmd.Salt).Msg("flags")
+
+	a, err := types.NewContractAddress(owner, cmd.Salt)
+	if err != nil {
+		return err
+	}
+
+	cmd.print(a.String())
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package cmds
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type KeyDeriveCommand struct {
+	BaseCommand
+	Mnemonic   string `arg:"" name:"mnemonic" help:"mnemonic phrase; '-' reads from stdin"`
+	Index      uint32 `arg:"" name:"index" help:"index of account"`
+	Passphrase string `name:"passphrase" help:"optional BIP-39 passphrase"`
+	Path       string `name:"path" help:"BIP-32 derivation path of accounts" default:"m/44'/60'/0'/0"`
+}
+
+func (cmd *KeyDeriveCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	cmd.Log.Debug().
+		Str("path", cmd.Path).
+		Uint32("index", cmd.Index).
+		Msg("flags")
+
+	mnemonic, err := loadMnemonic(cmd.Mnemonic)
+	if err != nil {
+		return err
+	}
+
+	if len(mnemonic) < 1 {
+		return errors.Errorf("Empty mnemonic")
+	}
+
+	path, err := types.ParseDerivationPath(cmd.Path)
+	if err != nil {
+		return err
+	}
+
+	seed, err := types.NewSeedFromMnemonic(mnemonic, cmd.Passphrase)
+	if err != nil {
+		return err
+	}
+
+	o, err := newDerivedKey(seed, path.Child(cmd.Index))
+	if err != nil {
+		return err
+	}
+
+	b, err := util.MarshalJSONIndent(o)
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, string(b))
+
+	return nil
+}
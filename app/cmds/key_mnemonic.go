@@ -0,0 +1,159 @@
+package cmds
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type KeyMnemonicCommand struct {
+	BaseCommand
+	Mnemonic   string `arg:"" name:"mnemonic" optional:"" help:"mnemonic phrase to import; '-' reads from stdin. new phrase is created if empty"` // nolint
+	Words      int    `name:"words" help:"number of words of new mnemonic" default:"24" enum:"12,15,18,21,24"`
+	Passphrase string `name:"passphrase" help:"optional BIP-39 passphrase"`
+	Path       string `name:"path" help:"BIP-32 derivation path of accounts" default:"m/44'/60'/0'/0"`
+	Index      uint32 `name:"index" help:"index of first account" default:"0"`
+	Count      uint32 `name:"count" help:"number of accounts to derive" default:"1"`
+}
+
+func (cmd *KeyMnemonicCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	cmd.Log.Debug().
+		Int("words", cmd.Words).
+		Str("path", cmd.Path).
+		Uint32("index", cmd.Index).
+		Uint32("count", cmd.Count).
+		Msg("flags")
+
+	// NOTE the accounts are derived with the non-hardened child indices.
+	switch {
+	case cmd.Count < 1:
+		return errors.Errorf("Empty count")
+	case uint64(cmd.Index)+uint64(cmd.Count) > uint64(types.HardenedKeyStart):
+		return errors.Errorf(
+			"Index of last account, %d over max non-hardened index, %d",
+			uint64(cmd.Index)+uint64(cmd.Count)-1, types.HardenedKeyStart-1)
+	}
+
+	mnemonic, err := loadMnemonic(cmd.Mnemonic)
+	if err != nil {
+		return err
+	}
+
+	if len(mnemonic) < 1 {
+		i, err := types.NewMnemonic(cmd.Words)
+		if err != nil {
+			return err
+		}
+
+		mnemonic = i
+	}
+
+	path, err := types.ParseDerivationPath(cmd.Path)
+	if err != nil {
+		return err
+	}
+
+	seed, err := types.NewSeedFromMnemonic(mnemonic, cmd.Passphrase)
+	if err != nil {
+		return err
+	}
+
+	accounts := make([]derivedKey, cmd.Count)
+
+	for i := range accounts {
+		a, err := newDerivedKey(seed, path.Child(cmd.Index+uint32(i)))
+		if err != nil {
+			return err
+		}
+
+		accounts[i] = a
+	}
+
+	o := struct {
+		Mnemonic string       `json:"mnemonic"`
+		Path     string       `json:"path"`
+		Accounts []derivedKey `json:"accounts"`
+	}{
+		Mnemonic: mnemonic,
+		Path:     path.String(),
+		Accounts: accounts,
+	}
+
+	b, err := util.MarshalJSONIndent(o)
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, string(b))
+
+	return nil
+}
+
+type derivedKey struct {
+	Path         string       `json:"path"`
+	PrivateKey   base.PKKey   `json:"privatekey"` //nolint:tagliatelle //...
+	Publickey    base.PKKey   `json:"publickey"`
+	Address      base.Address `json:"address"`
+	EtherAddress string       `json:"ether_address"`
+}
+
+// newDerivedKey derives the key at path. The address is the account address
+// of the single key with the default create-account weight and threshold.
+func newDerivedKey(seed []byte, path types.DerivationPath) (derivedKey, error) {
+	priv, err := types.DeriveMEPrivatekey(seed, path)
+	if err != nil {
+		return derivedKey{}, err
+	}
+
+	pub := priv.Publickey().(types.MEPublickey) //nolint:forcetypeassert //...
+
+	k, err := types.NewBaseAccountKey(pub, 100)
+	if err != nil {
+		return derivedKey{}, err
+	}
+
+	keys, err := types.NewBaseAccountKeys([]types.AccountKey{k}, 100)
+	if err != nil {
+		return derivedKey{}, err
+	}
+
+	a, err := types.NewAddressFromKeys(keys)
+	if err != nil {
+		return derivedKey{}, err
+	}
+
+	return derivedKey{
+		Path:         path.String(),
+		PrivateKey:   priv,
+		Publickey:    pub,
+		Address:      a,
+		EtherAddress: pub.EtherAddress(),
+	}, nil
+}
+
+func loadMnemonic(s string) (string, error) {
+	if s != "-" {
+		return strings.TrimSpace(s), nil
+	}
+
+	b, err := LoadFromStdInput()
+	if err != nil {
+		return "", err
+	}
+
+	if len(b) < 1 {
+		return "", errors.Errorf("Empty mnemonic from stdin")
+	}
+
+	return string(b), nil
+}
@@ -16,6 +16,7 @@ import (
 type KeyNewCommand struct {
 	BaseCommand
 	Seed string `arg:"" name:"seed" optional:"" help:"seed for generating key"`
+	Type string `name:"type" help:"key type. select secp256k1 or ed25519" default:"secp256k1" enum:"secp256k1,ed25519"`
 }
 
 func (cmd *KeyNewCommand) Run(pctx context.Context) error {
@@ -25,6 +26,7 @@ func (cmd *KeyNewCommand) Run(pctx context.Context) error {
 
 	cmd.Log.Debug().
 		Str("seed", cmd.Seed).
+		Str("type", cmd.Type).
 		Msg("flags")
 
 	if _, err := cmd.prepare(pctx); err != nil {
@@ -39,12 +41,23 @@ func (cmd *KeyNewCommand) Run(pctx context.Context) error {
 			cmd.Log.Warn().Msg("seed consists with empty spaces")
 		}
 
-		i, err := types.NewMEPrivatekeyFromSeed(cmd.Seed)
+		var i base.Privatekey
+		var err error
+
+		switch cmd.Type {
+		case "ed25519":
+			i, err = types.NewEdPrivatekeyFromSeed(cmd.Seed)
+		default:
+			i, err = types.NewMEPrivatekeyFromSeed(cmd.Seed)
+		}
+
 		if err != nil {
 			return err
 		}
 		key = i
 
+	case cmd.Type == "ed25519":
+		key = types.NewEdPrivatekey()
 	default:
 		key = types.NewMEPrivatekey()
 	}
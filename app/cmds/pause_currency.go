@@ -0,0 +1,73 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/imfact-labs/currency-model/operation/currency"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type CurrencyPauseFlags struct {
+	BaseCommand
+	OperationFlags
+	Currency CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Node     AddressFlag    `arg:"" name:"node" help:"node address" required:"true"`
+	node     base.Address
+}
+
+func (cmd *CurrencyPauseFlags) run(pctx context.Context, pause bool) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Node.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid node format, %q", cmd.Node.String())
+	}
+	cmd.node = a
+
+	fact := currency.NewPauseCurrencyFact([]byte(cmd.Token), cmd.Currency.CID, pause)
+
+	op, err := currency.NewPauseCurrency(fact)
+	if err != nil {
+		return errors.Wrap(err, "create pause-currency operation")
+	}
+
+	if err := op.NodeSign(cmd.Privatekey, cmd.NetworkID.NetworkID(), cmd.node); err != nil {
+		return errors.Wrap(err, "create pause-currency operation")
+	}
+
+	if err := op.IsValid(cmd.OperationFlags.NetworkID); err != nil {
+		return errors.Wrap(err, "invalid pause-currency operation")
+	}
+
+	cmd.Log.Debug().Interface("operation", op).Msg("operation loaded")
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+type PauseCurrencyCommand struct {
+	CurrencyPauseFlags
+}
+
+func (cmd *PauseCurrencyCommand) Run(pctx context.Context) error {
+	return cmd.run(pctx, true)
+}
+
+type UnpauseCurrencyCommand struct {
+	CurrencyPauseFlags
+}
+
+func (cmd *UnpauseCurrencyCommand) Run(pctx context.Context) error {
+	return cmd.run(pctx, false)
+}
@@ -0,0 +1,100 @@
+package cmds
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/imfact-labs/currency-model/operation/currency"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type ProposeOperationCommand struct {
+	BaseCommand
+	OperationFlags
+	Account   AddressFlag    `arg:"" name:"account" help:"account, whose keys approve the proposal" required:"true"`
+	Currency  CurrencyIDFlag `arg:"" name:"currency-id" help:"fee currency id" required:"true"`
+	Operation *os.File       `arg:"" name:"operation" help:"json file of operation, whose fact is proposed"`
+	Expiry    uint64         `name:"expiry" help:"height, from which proposal can not be approved" required:"true"`
+	account   base.Address
+	fact      base.Fact
+}
+
+func (cmd *ProposeOperationCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	defer func() {
+		_ = cmd.Operation.Close()
+	}()
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *ProposeOperationCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Account.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid account format, %v", cmd.Account.String())
+	}
+	cmd.account = a
+
+	b, err := io.ReadAll(cmd.Operation)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch i, err := cmd.Encoder.Decode(b); {
+	case err != nil:
+		return errors.Wrap(err, "invalid operation")
+	default:
+		op, ok := i.(base.Operation)
+		if !ok {
+			return errors.Errorf("expected operation, not %T", i)
+		}
+
+		cmd.fact = op.Fact()
+	}
+
+	return nil
+}
+
+func (cmd *ProposeOperationCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	fact := currency.NewProposeOperationFact(
+		[]byte(cmd.Token), cmd.account, cmd.fact, base.Height(cmd.Expiry), cmd.Currency.CID,
+	)
+
+	op, err := currency.NewProposeOperation(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "create propose-operation operation")
+	}
+
+	if err := op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID()); err != nil {
+		return nil, errors.Wrapf(err, "create %T operation", op)
+	}
+
+	if err := op.IsValid(cmd.OperationFlags.NetworkID); err != nil {
+		return nil, errors.Wrapf(err, "create %T operation", op)
+	}
+
+	return op, nil
+}
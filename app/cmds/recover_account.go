@@ -0,0 +1,91 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/imfact-labs/currency-model/operation/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type RecoverAccountCommand struct {
+	BaseCommand
+	OperationFlags
+	Account   AddressFlag    `arg:"" name:"account" help:"account to recover" required:"true"`
+	Currency  CurrencyIDFlag `arg:"" name:"currency-id" help:"fee currency id" required:"true"`
+	Threshold uint           `help:"threshold for new keys (default: ${create_account_threshold})" default:"${create_account_threshold}"` // nolint
+	Key       KeyFlag        `name:"key" help:"new key of account (ex: \"<public key>,<weight>\") separator @"`
+	account   base.Address
+	keys      types.BaseAccountKeys
+}
+
+func (cmd *RecoverAccountCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *RecoverAccountCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Account.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid account format, %v", cmd.Account.String())
+	}
+	cmd.account = a
+
+	{
+		ks := make([]types.AccountKey, len(cmd.Key.Values))
+		for i := range cmd.Key.Values {
+			ks[i] = cmd.Key.Values[i]
+		}
+
+		if kys, err := types.NewBaseAccountKeys(ks, cmd.Threshold); err != nil {
+			return err
+		} else if err := kys.IsValid(nil); err != nil {
+			return err
+		} else {
+			cmd.keys = kys
+		}
+	}
+
+	return nil
+}
+
+func (cmd *RecoverAccountCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	fact := currency.NewRecoverAccountFact([]byte(cmd.Token), cmd.account, cmd.keys, cmd.Currency.CID)
+
+	op, err := currency.NewRecoverAccount(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "create recover-account operation")
+	}
+
+	if err := op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID()); err != nil {
+		return nil, errors.Wrapf(err, "create %T operation", op)
+	}
+
+	if err := op.IsValid(cmd.OperationFlags.NetworkID); err != nil {
+		return nil, errors.Wrapf(err, "create %T operation", op)
+	}
+
+	return op, nil
+}
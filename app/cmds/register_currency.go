@@ -2,7 +2,10 @@ package cmds
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
+	"github.com/imfact-labs/currency-model/common"
 	"github.com/imfact-labs/currency-model/operation/currency"
 	"github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/mitum2/base"
@@ -63,23 +66,175 @@ func (fl *CurrencyFixedItemDataSizeExecutionFeeerFlags) IsValid([]byte) error {
 	return fl.feeer.IsValid(nil)
 }
 
+type CurrencyRatioFeeerFlags struct {
+	Receiver AddressFlag `name:"receiver" help:"fee receiver account address"`
+	Ratio    uint64      `name:"ratio" help:"fee ratio in basis points, 1/10000"`
+	Min      BigFlag     `name:"min" help:"minimum fee amount" default:"0"`
+	Max      BigFlag     `name:"max" help:"maximum fee amount, -1 for unlimited" default:"-1"`
+	feeer    types.Feeer
+}
+
+func (fl *CurrencyRatioFeeerFlags) IsValid([]byte) error {
+	if len(fl.Receiver.String()) < 1 {
+		return nil
+	}
+
+	var receiver base.Address
+	if a, err := fl.Receiver.Encode(enc); err != nil {
+		return util.ErrInvalid.Errorf("Invalid receiver format, %v: %v", fl.Receiver.String(), err)
+	} else if err := a.IsValid(nil); err != nil {
+		return util.ErrInvalid.Errorf("Invalid receiver address, %v: %v", fl.Receiver.String(), err)
+	} else {
+		receiver = a
+	}
+
+	fl.feeer = types.NewRatioFeeer(receiver, fl.Ratio, fl.Min.Big, fl.Max.Big)
+	return fl.feeer.IsValid(nil)
+}
+
+type CurrencyTieredFeeerFlags struct {
+	Receiver AddressFlag `name:"receiver" help:"fee receiver account address"`
+	Brackets []string    `name:"bracket" help:"fee bracket, <min>:<max>:<fee>; max -1 for unlimited"`
+	feeer    types.Feeer
+}
+
+func (fl *CurrencyTieredFeeerFlags) IsValid([]byte) error {
+	if len(fl.Receiver.String()) < 1 {
+		return nil
+	}
+
+	var receiver base.Address
+	if a, err := fl.Receiver.Encode(enc); err != nil {
+		return util.ErrInvalid.Errorf("Invalid receiver format, %v: %v", fl.Receiver.String(), err)
+	} else if err := a.IsValid(nil); err != nil {
+		return util.ErrInvalid.Errorf("Invalid receiver address, %v: %v", fl.Receiver.String(), err)
+	} else {
+		receiver = a
+	}
+
+	brackets := make([]types.FeeBracket, len(fl.Brackets))
+	for i := range fl.Brackets {
+		sp := strings.SplitN(fl.Brackets[i], ":", 3)
+		if len(sp) != 3 {
+			return util.ErrInvalid.Errorf("Invalid fee bracket, %q", fl.Brackets[i])
+		}
+
+		var bs [3]common.Big
+		for j := range sp {
+			b, err := common.NewBigFromString(sp[j])
+			if err != nil {
+				return util.ErrInvalid.Errorf("Invalid fee bracket, %q: %v", fl.Brackets[i], err)
+			}
+
+			bs[j] = b
+		}
+
+		brackets[i] = types.NewFeeBracket(bs[0], bs[1], bs[2])
+	}
+
+	fl.feeer = types.NewTieredFeeer(receiver, brackets)
+	return fl.feeer.IsValid(nil)
+}
+
+type CurrencySplitFeeerFlags struct {
+	Shares []string `name:"share" help:"fee share, <address>:<weight>; the first must be the receiver of feeer"`
+	Burn   uint     `name:"burn" help:"weight of fee to burn from total supply"`
+}
+
+func (fl *CurrencySplitFeeerFlags) IsValid([]byte) error {
+	return nil
+}
+
+// Wrap returns SplitFeeer around feeer if fee shares or burn are given.
+func (fl *CurrencySplitFeeerFlags) Wrap(feeer types.Feeer) (types.Feeer, error) {
+	if len(fl.Shares) < 1 && fl.Burn < 1 {
+		return feeer, nil
+	}
+
+	shares := make([]types.FeeShare, len(fl.Shares))
+	for i := range fl.Shares {
+		j := strings.LastIndex(fl.Shares[i], ":")
+		if j < 1 {
+			return nil, util.ErrInvalid.Errorf("Invalid fee share, %q", fl.Shares[i])
+		}
+
+		receiver, err := base.DecodeAddress(fl.Shares[i][:j], enc)
+		if err != nil {
+			return nil, util.ErrInvalid.Errorf("Invalid fee share receiver, %q: %v", fl.Shares[i], err)
+		}
+
+		weight, err := strconv.ParseUint(fl.Shares[i][j+1:], 10, 32)
+		if err != nil {
+			return nil, util.ErrInvalid.Errorf("Invalid fee share weight, %q: %v", fl.Shares[i], err)
+		}
+
+		shares[i] = types.NewFeeShare(receiver, uint(weight))
+	}
+
+	sf := types.NewSplitFeeer(feeer, shares, fl.Burn)
+
+	return sf, sf.IsValid(nil)
+}
+
 type CurrencyPolicyFlags struct {
-	NewAccountMinBalance BigFlag `name:"new-account-min-balance" help:"minimum balance for new account"` // nolint lll
+	NewAccountMinBalance BigFlag        `name:"new-account-min-balance" help:"minimum balance for new account"` // nolint lll
+	FeeCurrency          CurrencyIDFlag `name:"fee-currency" help:"currency id, in which fee is charged"`
+	FeeNumerator         BigFlag        `name:"fee-numerator" help:"fee currency amount per fee-denominator of fee" default:"1"`             // nolint lll
+	FeeDenominator       BigFlag        `name:"fee-denominator" help:"fee amount converted to fee-numerator of fee currency" default:"1"`    // nolint lll
+	MaxTransfer          BigFlag        `name:"max-transfer" help:"max amount of single transfer, 0 is unlimited" default:"0"`               // nolint lll
+	MaxOutflow           BigFlag        `name:"max-outflow" help:"max amount sent by account in outflow window, 0 is unlimited" default:"0"` // nolint lll
+	OutflowWindow        uint64         `name:"outflow-window" help:"outflow window in blocks"`
+	MaxBalance           BigFlag        `name:"max-balance" help:"max balance of account, 0 is unlimited" default:"0"` // nolint lll
+	Authority            AddressFlag    `name:"authority" help:"authority account, which can freeze accounts"`
+	ForceTransfer        bool           `name:"force-transfer" help:"allow authority to force transfer from frozen account"` // nolint lll
+	authority            base.Address
 }
 
-func (*CurrencyPolicyFlags) IsValid([]byte) error {
+func (fl *CurrencyPolicyFlags) IsValid([]byte) error {
+	fl.authority = nil
+	if len(fl.Authority.String()) > 0 {
+		a, err := fl.Authority.Encode(enc)
+		if err != nil {
+			return util.ErrInvalid.Errorf("Invalid authority format, %q: %v", fl.Authority.String(), err)
+		}
+		fl.authority = a
+	} else if fl.ForceTransfer {
+		return util.ErrInvalid.Errorf("Empty authority for force transfer")
+	}
+
 	return nil
 }
 
+func (fl *CurrencyPolicyFlags) Policy(feeer types.Feeer) types.CurrencyPolicy {
+	po := types.NewCurrencyPolicy(fl.NewAccountMinBalance.Big, feeer)
+	if len(fl.FeeCurrency.String()) > 0 {
+		po = po.WithFeeCurrency(types.NewFeeCurrency(fl.FeeCurrency.CID, fl.FeeNumerator.Big, fl.FeeDenominator.Big))
+	}
+
+	if fl.MaxTransfer.OverZero() || fl.MaxOutflow.OverZero() || fl.MaxBalance.OverZero() {
+		po = po.WithLimits(types.NewTransferLimits(fl.MaxTransfer.Big, fl.MaxOutflow.Big, fl.OutflowWindow, fl.MaxBalance.Big))
+	}
+
+	if fl.authority != nil {
+		po = po.WithAuthority(types.NewCurrencyAuthority(fl.authority, fl.ForceTransfer))
+	}
+
+	return po
+}
+
 type CurrencyDesignFlags struct {
-	Currency                CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
-	GenesisAmount           BigFlag        `arg:"" name:"genesis-amount" help:"genesis amount" required:"true"`
-	Decimal                 BigFlag        `arg:"" name:"decimal" help:"decimal" required:"true"`
-	GenesisAccount          AddressFlag    `arg:"" name:"genesis-account" help:"genesis-account address for genesis balance" required:"true"` // nolint lll
-	CurrencyPolicyFlags     `prefix:"policy-" help:"currency policy" required:"true"`
-	FeeerString             string `name:"feeer" help:"feeer type, {nil, fixed}" required:"true"`
-	CurrencyFixedFeeerFlags `prefix:"feeer-fixed-" help:"fixed feeer"`
-	currencyDesign          types.CurrencyDesign
+	Currency                 CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	GenesisAmount            BigFlag        `arg:"" name:"genesis-amount" help:"genesis amount" required:"true"`
+	Decimal                  BigFlag        `arg:"" name:"decimal" help:"decimal" required:"true"`
+	MaxSupply                BigFlag        `name:"max-supply" help:"max supply of currency, 0 is unlimited" default:"0"`
+	GenesisAccount           AddressFlag    `arg:"" name:"genesis-account" help:"genesis-account address for genesis balance" required:"true"` // nolint lll
+	CurrencyPolicyFlags      `prefix:"policy-" help:"currency policy" required:"true"`
+	FeeerString              string `name:"feeer" help:"feeer type, {nil, fixed, ratio, tiered}" required:"true"`
+	CurrencyFixedFeeerFlags  `prefix:"feeer-fixed-" help:"fixed feeer"`
+	CurrencyRatioFeeerFlags  `prefix:"feeer-ratio-" help:"ratio feeer"`
+	CurrencyTieredFeeerFlags `prefix:"feeer-tiered-" help:"tiered feeer"`
+	CurrencySplitFeeerFlags  `prefix:"feeer-split-" help:"split fee between receivers"`
+	currencyDesign           types.CurrencyDesign
 }
 
 func (fl *CurrencyDesignFlags) IsValid([]byte) error {
@@ -87,6 +242,10 @@ func (fl *CurrencyDesignFlags) IsValid([]byte) error {
 		return err
 	} else if err := fl.CurrencyFixedFeeerFlags.IsValid(nil); err != nil {
 		return err
+	} else if err := fl.CurrencyRatioFeeerFlags.IsValid(nil); err != nil {
+		return err
+	} else if err := fl.CurrencyTieredFeeerFlags.IsValid(nil); err != nil {
+		return err
 	}
 
 	var feeer types.Feeer
@@ -95,6 +254,10 @@ func (fl *CurrencyDesignFlags) IsValid([]byte) error {
 		feeer = types.NewNilFeeer()
 	case types.FeeerFixed:
 		feeer = fl.CurrencyFixedFeeerFlags.feeer
+	case types.FeeerRatio:
+		feeer = fl.CurrencyRatioFeeerFlags.feeer
+	case types.FeeerTiered:
+		feeer = fl.CurrencyTieredFeeerFlags.feeer
 	default:
 		return util.ErrInvalid.Errorf("Unknown feeer type, %v", t)
 	}
@@ -105,7 +268,12 @@ func (fl *CurrencyDesignFlags) IsValid([]byte) error {
 		return err
 	}
 
-	po := types.NewCurrencyPolicy(fl.CurrencyPolicyFlags.NewAccountMinBalance.Big, feeer)
+	feeer, err := fl.CurrencySplitFeeerFlags.Wrap(feeer)
+	if err != nil {
+		return err
+	}
+
+	po := fl.CurrencyPolicyFlags.Policy(feeer)
 	if err := po.IsValid(nil); err != nil {
 		return err
 	}
@@ -125,6 +293,7 @@ func (fl *CurrencyDesignFlags) IsValid([]byte) error {
 	}
 
 	fl.currencyDesign = types.NewCurrencyDesign(fl.GenesisAmount.Big, fl.Currency.CID, fl.Decimal.Big, genesisAccount, po)
+	fl.currencyDesign.SetMaxSupply(fl.MaxSupply.Big)
 	return fl.currencyDesign.IsValid(nil)
 }
 
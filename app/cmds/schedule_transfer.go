@@ -0,0 +1,148 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/imfact-labs/currency-model/operation/currency"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	ccstate "github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/pkg/errors"
+
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type ScheduleTransferCommand struct {
+	BaseCommand
+	OperationFlags
+	Sender         AddressFlag        `arg:"" name:"sender" help:"sender address" required:"true"`
+	Receiver       AddressFlag        `arg:"" name:"receiver" help:"receiver address" required:"true"`
+	Amount         CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount of each run (ex: \"<currency>,<amount>\")" required:"true"`
+	Currency       CurrencyIDFlag     `arg:"" name:"currency-id" help:"fee currency id" required:"true"`
+	Start          base.Height        `name:"start" help:"block height of the first run" required:"true"`
+	Interval       uint64             `name:"interval" help:"blocks between runs; 0 runs only once" default:"0"`
+	Times          uint64             `name:"times" help:"number of runs; 0 runs until cancelled" default:"0"`
+	OnInsufficient string             `name:"on-insufficient" help:"skip or cancel, when the run can not be made" default:"skip"`
+	OperationExtensionFlags
+	EstimateFeeFlags
+	sender   base.Address
+	receiver base.Address
+}
+
+func (cmd *ScheduleTransferCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	if cmd.EstimateFee {
+		return cmd.estimate(pctx, cmd.Out, op)
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *ScheduleTransferCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %v", cmd.Sender.String())
+	}
+	cmd.sender = a
+
+	r, err := cmd.Receiver.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid receiver format, %v", cmd.Receiver.String())
+	}
+	cmd.receiver = r
+
+	err = cmd.OperationExtensionFlags.parseFlags(cmd.Encoders.JSON())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cmd *ScheduleTransferCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	am := types.NewAmount(cmd.Amount.Big, cmd.Amount.CID)
+
+	fact := currency.NewScheduleTransferFact(
+		[]byte(cmd.Token), cmd.sender, cmd.receiver, am, cmd.Start, cmd.Interval, cmd.Times,
+		ccstate.ScheduleMissPolicy(cmd.OnInsufficient), cmd.Currency.CID)
+
+	op, err := currency.NewScheduleTransfer(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "create schedule-transfer operation")
+	}
+
+	var baseAuthentication extras.OperationExtension
+	var baseSettlement extras.OperationExtension
+	var baseProxyPayer extras.OperationExtension
+	var proofData = cmd.Proof
+	if cmd.IsPrivateKey {
+		prk, err := base.DecodePrivatekeyFromString(cmd.Proof, enc)
+		if err != nil {
+			return nil, err
+		}
+
+		sig, err := prk.Sign(fact.Hash().Bytes())
+		if err != nil {
+			return nil, err
+		}
+		proofData = sig.String()
+	}
+
+	if cmd.didContract != nil && cmd.AuthenticationID != "" && cmd.Proof != "" {
+		baseAuthentication = extras.NewBaseAuthentication(cmd.didContract, cmd.AuthenticationID, proofData)
+		if err := op.AddExtension(baseAuthentication); err != nil {
+			return nil, err
+		}
+	}
+
+	if cmd.proxyPayer != nil {
+		baseProxyPayer = extras.NewBaseProxyPayer(cmd.proxyPayer)
+		if err := op.AddExtension(baseProxyPayer); err != nil {
+			return nil, err
+		}
+	}
+
+	if cmd.opSender != nil {
+		baseSettlement = extras.NewBaseSettlement(cmd.opSender)
+		if err := op.AddExtension(baseSettlement); err != nil {
+			return nil, err
+		}
+
+		err = op.HashSign(cmd.OpSenderPrivatekey, cmd.NetworkID.NetworkID())
+		if err != nil {
+			return nil, errors.Wrapf(err, "create %T operation", op)
+		}
+	} else {
+		err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+		if err != nil {
+			return nil, errors.Wrapf(err, "create %T operation", op)
+		}
+	}
+
+	if err := op.IsValid(cmd.OperationFlags.NetworkID); err != nil {
+		return nil, errors.Wrapf(err, "create %T operation", op)
+	}
+
+	return op, nil
+}
@@ -19,6 +19,7 @@ type TransferCommand struct {
 	ReceiverAmount AddressCurrencyAmountFlag `arg:"" name:"receiver-currency-amount" help:"receiver amount (ex: \"<address>,<currency>,<amount>\") separator @" required:"true"`
 	Currency       CurrencyIDFlag            `arg:"" name:"currency-id" help:"fee currency id" required:"true"`
 	OperationExtensionFlags
+	EstimateFeeFlags
 	sender base.Address
 }
 
@@ -39,6 +40,10 @@ func (cmd *TransferCommand) Run(pctx context.Context) error {
 		return err
 	}
 
+	if cmd.EstimateFee {
+		return cmd.estimate(pctx, cmd.Out, op)
+	}
+
 	PrettyPrint(cmd.Out, op)
 
 	return nil
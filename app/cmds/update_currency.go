@@ -14,10 +14,15 @@ type UpdateCurrencyCommand struct {
 	OperationFlags
 	Currency                                     CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
 	CurrencyPolicyFlags                          `prefix:"policy-" help:"currency policy" required:"true"`
-	FeeerString                                  string `name:"feeer" help:"feeer type, {nil, fixed}" required:"true"`
+	FeeerString                                  string `name:"feeer" help:"feeer type, {nil, fixed, ratio, tiered}" required:"true"`
 	CurrencyFixedFeeerFlags                      `prefix:"feeer-fixed-" help:"fixed feeer"`
+	CurrencyRatioFeeerFlags                      `prefix:"feeer-ratio-" help:"ratio feeer"`
+	CurrencyTieredFeeerFlags                     `prefix:"feeer-tiered-" help:"tiered feeer"`
+	CurrencySplitFeeerFlags                      `prefix:"feeer-split-" help:"split fee between receivers"`
 	CurrencyFixedItemDataSizeExecutionFeeerFlags `prefix:"feeer-fixed-item-data-size-execution" help:"fixed item data size execution feeer"`
 	Node                                         AddressFlag `arg:"" name:"node" help:"node address" required:"true"`
+	ActiveHeight                                 base.Height `name:"active-height" help:"block height to activate the policy; applied at once if not set"`
+	MaxSupply                                    BigFlag     `name:"max-supply" help:"new max supply of currency; not changed if not set" default:"0"` // nolint lll
 	node                                         base.Address
 	po                                           types.CurrencyPolicy
 }
@@ -61,6 +66,14 @@ func (cmd *UpdateCurrencyCommand) parseFlags() error {
 		return err
 	}
 
+	if err := cmd.CurrencyRatioFeeerFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := cmd.CurrencyTieredFeeerFlags.IsValid(nil); err != nil {
+		return err
+	}
+
 	a, err := cmd.Node.Encode(enc)
 	if err != nil {
 		return errors.Wrapf(err, "invalid node format, %q", cmd.Node.String())
@@ -73,6 +86,10 @@ func (cmd *UpdateCurrencyCommand) parseFlags() error {
 		feeer = types.NewNilFeeer()
 	case types.FeeerFixed:
 		feeer = cmd.CurrencyFixedFeeerFlags.feeer
+	case types.FeeerRatio:
+		feeer = cmd.CurrencyRatioFeeerFlags.feeer
+	case types.FeeerTiered:
+		feeer = cmd.CurrencyTieredFeeerFlags.feeer
 	default:
 		return errors.Errorf("Unknown feeer type, %q", t)
 	}
@@ -83,7 +100,12 @@ func (cmd *UpdateCurrencyCommand) parseFlags() error {
 		return err
 	}
 
-	cmd.po = types.NewCurrencyPolicy(cmd.CurrencyPolicyFlags.NewAccountMinBalance.Big, feeer)
+	feeer, err = cmd.CurrencySplitFeeerFlags.Wrap(feeer)
+	if err != nil {
+		return err
+	}
+
+	cmd.po = cmd.CurrencyPolicyFlags.Policy(feeer)
 	if err := cmd.po.IsValid(nil); err != nil {
 		return err
 	}
@@ -94,7 +116,7 @@ func (cmd *UpdateCurrencyCommand) parseFlags() error {
 }
 
 func (cmd *UpdateCurrencyCommand) createOperation() (currency.UpdateCurrency, error) {
-	fact := currency.NewUpdateCurrencyFact([]byte(cmd.Token), cmd.Currency.CID, cmd.po)
+	fact := currency.NewUpdateCurrencyFact([]byte(cmd.Token), cmd.Currency.CID, cmd.po, cmd.ActiveHeight, cmd.MaxSupply.Big)
 
 	op, err := currency.NewUpdateCurrency(fact)
 	if err != nil {
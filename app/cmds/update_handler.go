@@ -5,6 +5,7 @@ import (
 
 	"github.com/imfact-labs/currency-model/operation/extension"
 	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
 	"github.com/pkg/errors"
 
 	"github.com/imfact-labs/mitum2/base"
@@ -13,10 +14,11 @@ import (
 type UpdateHandlerCommand struct {
 	BaseCommand
 	OperationFlags
-	Sender   AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
-	Contract AddressFlag    `arg:"" name:"contract" help:"target contract account address" required:"true"`
-	Currency CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
-	Handlers []AddressFlag  `arg:"" name:"handlers" help:"handlers"`
+	Sender   AddressFlag        `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract AddressFlag        `arg:"" name:"contract" help:"target contract account address" required:"true"`
+	Currency CurrencyIDFlag     `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Handlers []AddressFlag      `arg:"" name:"handlers" help:"handlers"`
+	Scopes   []HandlerScopeFlag `name:"scope" help:"scope of handler (ex: \"<handler>@<operation hint type>,...@<currency id>:<withdraw limit>,...\")"` // nolint:lll
 	OperationExtensionFlags
 	sender base.Address
 	target base.Address
@@ -81,7 +83,18 @@ func (cmd *UpdateHandlerCommand) createOperation() (base.Operation, error) { //
 		handlers[i] = ad
 	}
 
-	fact := extension.NewUpdateHandlerFact([]byte(cmd.Token), cmd.sender, cmd.target, handlers, cmd.Currency.CID)
+	scopes := make([]types.HandlerScope, len(cmd.Scopes))
+	for i := range cmd.Scopes {
+		scope, err := cmd.Scopes[i].Encode(enc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid handler of scope, %v", cmd.Scopes[i].String())
+		}
+
+		scopes[i] = scope
+	}
+
+	fact := extension.NewUpdateHandlerFact(
+		[]byte(cmd.Token), cmd.sender, cmd.target, handlers, scopes, cmd.Currency.CID)
 
 	op, err := extension.NewUpdateHandler(fact)
 	if err != nil {
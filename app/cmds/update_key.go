@@ -18,6 +18,7 @@ type UpdateKeyCommand struct {
 	Threshold uint           `help:"threshold for keys (default: ${create_account_threshold})" default:"${create_account_threshold}"` // nolint
 	Key       KeyFlag        `name:"key" help:"key for new account (ex: \"<public key>,<weight>\") separator @"`
 	Currency  CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Grace     uint64         `name:"grace" help:"blocks the current keys are still accepted after update"`
 	OperationExtensionFlags
 	sender base.Address
 	keys   types.BaseAccountKeys
@@ -80,7 +81,7 @@ func (cmd *UpdateKeyCommand) parseFlags() error {
 }
 
 func (cmd *UpdateKeyCommand) createOperation() (base.Operation, error) { // nolint:dupl}
-	fact := currency.NewUpdateKeyFact([]byte(cmd.Token), cmd.sender, cmd.keys, cmd.Currency.CID)
+	fact := currency.NewUpdateKeyFact([]byte(cmd.Token), cmd.sender, cmd.keys, cmd.Currency.CID, cmd.Grace)
 
 	op, err := currency.NewUpdateKey(fact)
 	if err != nil {
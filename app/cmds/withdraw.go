@@ -20,6 +20,7 @@ type WithdrawCommand struct {
 	Amount   CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\")"`
 	Currency CurrencyIDFlag     `name:"currency-id" help:"fee currency id; defaults to the item currency"`
 	OperationExtensionFlags
+	EstimateFeeFlags
 	sender      base.Address
 	target      base.Address
 	didContract base.Address
@@ -41,6 +42,10 @@ func (cmd *WithdrawCommand) Run(pctx context.Context) error {
 		return err
 	}
 
+	if cmd.EstimateFee {
+		return cmd.estimate(pctx, cmd.Out, op)
+	}
+
 	PrettyPrint(cmd.Out, op)
 
 	return nil
@@ -38,10 +38,13 @@ var CLI struct { //nolint:govet //...
 		Client cmds.NetworkClientCommand `cmd:"" help:"network client"`
 	} `cmd:"" help:"network"`
 	Key struct {
-		New     cmds.KeyNewCommand     `cmd:"" help:"generate new key"`
-		Address cmds.KeyAddressCommand `cmd:"" help:"generate address from key"`
-		Load    cmds.KeyLoadCommand    `cmd:"" help:"load key"`
-		Sign    cmds.KeySignCommand    `cmd:"" help:"sign"`
+		New             cmds.KeyNewCommand             `cmd:"" help:"generate new key"`
+		Address         cmds.KeyAddressCommand         `cmd:"" help:"generate address from key"`
+		ContractAddress cmds.KeyContractAddressCommand `cmd:"" name:"contract-address" help:"derive contract account address from owner and salt"`
+		Load            cmds.KeyLoadCommand            `cmd:"" help:"load key"`
+		Sign            cmds.KeySignCommand            `cmd:"" help:"sign"`
+		Mnemonic        cmds.KeyMnemonicCommand        `cmd:"" help:"create or import mnemonic and derive keys"`
+		Derive          cmds.KeyDeriveCommand          `cmd:"" help:"derive key of account index from mnemonic"`
 	} `cmd:"" help:"key"`
 	Handover launchcmd.HandoverCommands `cmd:""`
 	Version  struct{}                   `cmd:"" help:"version"`
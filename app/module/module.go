@@ -45,6 +45,7 @@ func (Module) Register(reg *modulekit.Registry) error {
 		modulekit.APIRoute{Path: api.HandlerPathQueueSend, Methods: []string{"POST"}},
 		modulekit.APIRoute{Path: api.HandlerPathCurrencies, Methods: []string{"GET"}},
 		modulekit.APIRoute{Path: api.HandlerPathCurrency, Methods: []string{"GET"}},
+		modulekit.APIRoute{Path: api.HandlerPathOperationFee, Methods: []string{"POST"}},
 		modulekit.APIRoute{Path: api.HandlerPathManifests, Methods: []string{"GET"}},
 		modulekit.APIRoute{Path: api.HandlerPathOperations, Methods: []string{"GET"}},
 		modulekit.APIRoute{Path: api.HandlerPathOperationsByHash, Methods: []string{"GET"}},
@@ -56,6 +57,11 @@ func (Module) Register(reg *modulekit.Registry) error {
 		modulekit.APIRoute{Path: api.HandlerPathBlockByHash, Methods: []string{"GET"}},
 		modulekit.APIRoute{Path: api.HandlerPathAccount, Methods: []string{"GET"}},
 		modulekit.APIRoute{Path: api.HandlerPathAccountOperations, Methods: []string{"GET"}},
+		modulekit.APIRoute{Path: api.HandlerPathAccountTransferLocks, Methods: []string{"GET"}},
+		modulekit.APIRoute{Path: api.HandlerPathAccountProposals, Methods: []string{"GET"}},
+		modulekit.APIRoute{Path: api.HandlerPathAccountAllowances, Methods: []string{"GET"}},
+		modulekit.APIRoute{Path: api.HandlerPathAccountKeyHistory, Methods: []string{"GET"}},
+		modulekit.APIRoute{Path: api.HandlerPathAccountOwnerHistory, Methods: []string{"GET"}},
 		modulekit.APIRoute{Path: api.HandlerPathAccounts, Methods: []string{"GET"}},
 		modulekit.APIRoute{Path: api.HandlerPathDIDDesign, Methods: []string{"GET"}},
 		modulekit.APIRoute{Path: api.HandlerPathDIDData, Methods: []string{"GET"}},
@@ -36,15 +36,23 @@ var AddedHinters = []encoder.DecodeDetail{
 	{Hint: types.AmountHint, Instance: types.Amount{}},
 	{Hint: types.ContractAccountKeysHint, Instance: types.ContractAccountKeys{}},
 	{Hint: types.ContractAccountStatusHint, Instance: types.ContractAccountStatus{}},
+	{Hint: types.HandlerScopeHint, Instance: types.HandlerScope{}},
 	{Hint: types.CurrencyDesignHint, Instance: types.CurrencyDesign{}},
 	{Hint: types.CurrencyPolicyHint, Instance: types.CurrencyPolicy{}},
 	{Hint: types.FixedFeeerHint, Instance: types.FixedFeeer{}},
 	{Hint: types.FixedItemDataSizeExecutionFeeerHint, Instance: types.FixedItemDataSizeExecutionFeeer{}},
+	{Hint: types.RatioFeeerHint, Instance: types.RatioFeeer{}},
+	{Hint: types.TieredFeeerHint, Instance: types.TieredFeeer{}},
+	{Hint: types.SplitFeeerHint, Instance: types.SplitFeeer{}},
 	{Hint: types.BaseFeeReceiptHint, Instance: types.BaseFeeReceipt{}},
 	{Hint: types.FixedFeeReceiptHint, Instance: types.FixedFeeReceipt{}},
 	{Hint: types.FixedItemDataSizeExecutionFeeReceiptHint, Instance: types.FixedItemDataSizeExecutionFeeReceipt{}},
+	{Hint: types.RatioFeeReceiptHint, Instance: types.RatioFeeReceipt{}},
+	{Hint: types.ConvertedFeeReceiptHint, Instance: types.ConvertedFeeReceipt{}},
 	{Hint: types.MEPrivatekeyHint, Instance: types.MEPrivatekey{}},
 	{Hint: types.MEPublickeyHint, Instance: types.MEPublickey{}},
+	{Hint: types.EdPrivatekeyHint, Instance: types.EdPrivatekey{}},
+	{Hint: types.EdPublickeyHint, Instance: types.EdPublickey{}},
 	{Hint: types.NilFeeerHint, Instance: types.NilFeeer{}},
 	{Hint: types.CurrencyOperationReceiptHint, Instance: types.CurrencyOperationReceipt{}},
 
@@ -57,6 +65,29 @@ var AddedHinters = []encoder.DecodeDetail{
 	{Hint: currency.RegisterGenesisCurrencyFactHint, Instance: currency.RegisterGenesisCurrencyFact{}},
 	{Hint: currency.UpdateKeyHint, Instance: currency.UpdateKey{}},
 	{Hint: currency.MintHint, Instance: currency.Mint{}},
+	{Hint: currency.BurnHint, Instance: currency.Burn{}},
+	{Hint: currency.FreezeAccountHint, Instance: currency.FreezeAccount{}},
+	{Hint: currency.ForceTransferHint, Instance: currency.ForceTransfer{}},
+	{Hint: currency.CreateVestingHint, Instance: currency.CreateVesting{}},
+	{Hint: currency.ReleaseVestingHint, Instance: currency.ReleaseVesting{}},
+	{Hint: currency.LockTransferHint, Instance: currency.LockTransfer{}},
+	{Hint: currency.ClaimTransferHint, Instance: currency.ClaimTransfer{}},
+	{Hint: currency.RefundTransferHint, Instance: currency.RefundTransfer{}},
+	{Hint: currency.ScheduleTransferHint, Instance: currency.ScheduleTransfer{}},
+	{Hint: currency.CancelScheduleHint, Instance: currency.CancelSchedule{}},
+	{Hint: currency.RunScheduleHint, Instance: currency.RunSchedule{}},
+	{Hint: currency.RunScheduleFactHint, Instance: currency.RunScheduleFact{}},
+	{Hint: currency.SkipScheduleHint, Instance: currency.SkipSchedule{}},
+	{Hint: currency.SkipScheduleFactHint, Instance: currency.SkipScheduleFact{}},
+	{Hint: currency.ProposeOperationHint, Instance: currency.ProposeOperation{}},
+	{Hint: currency.ApproveOperationHint, Instance: currency.ApproveOperation{}},
+	{Hint: currency.SetGuardiansHint, Instance: currency.SetGuardians{}},
+	{Hint: currency.RecoverAccountHint, Instance: currency.RecoverAccount{}},
+	{Hint: currency.CompleteRecoveryHint, Instance: currency.CompleteRecovery{}},
+	{Hint: currency.CancelRecoveryHint, Instance: currency.CancelRecovery{}},
+	{Hint: currency.ApproveHint, Instance: currency.Approve{}},
+	{Hint: currency.TransferFromHint, Instance: currency.TransferFrom{}},
+	{Hint: currency.PauseCurrencyHint, Instance: currency.PauseCurrency{}},
 	{Hint: currency.TransferHint, Instance: currency.Transfer{}},
 	{Hint: currency.TransferItemMultiAmountsHint, Instance: currency.TransferItemMultiAmounts{}},
 	{Hint: currency.TransferItemSingleAmountHint, Instance: currency.TransferItemSingleAmount{}},
@@ -66,6 +97,10 @@ var AddedHinters = []encoder.DecodeDetail{
 	{Hint: extension.CreateContractAccountItemSingleAmountHint, Instance: extension.CreateContractAccountItemSingleAmount{}},
 	{Hint: extension.UpdateHandlerHint, Instance: extension.UpdateHandler{}},
 	{Hint: extension.UpdateRecipientHint, Instance: extension.UpdateRecipient{}},
+	{Hint: extension.UpdateOwnerHint, Instance: extension.UpdateOwner{}},
+	{Hint: extension.AcceptOwnerHint, Instance: extension.AcceptOwner{}},
+	{Hint: extension.UpdateBalanceStatusHint, Instance: extension.UpdateBalanceStatus{}},
+	{Hint: extension.UpdateActivationHint, Instance: extension.UpdateActivation{}},
 	{Hint: extension.WithdrawHint, Instance: extension.Withdraw{}},
 	{Hint: extension.WithdrawItemMultiAmountsHint, Instance: extension.WithdrawItemMultiAmounts{}},
 	{Hint: extension.WithdrawItemSingleAmountHint, Instance: extension.WithdrawItemSingleAmount{}},
@@ -88,8 +123,21 @@ var AddedHinters = []encoder.DecodeDetail{
 	{Hint: ccstate.AccountStateValueHint, Instance: ccstate.AccountStateValue{}},
 	{Hint: ccstate.BalanceStateValueHint, Instance: ccstate.BalanceStateValue{}},
 	{Hint: ccstate.DesignStateValueHint, Instance: ccstate.DesignStateValue{}},
+	{Hint: ccstate.PendingPolicyStateValueHint, Instance: ccstate.PendingPolicyStateValue{}},
+	{Hint: ccstate.OutflowStateValueHint, Instance: ccstate.OutflowStateValue{}},
+	{Hint: ccstate.FrozenStateValueHint, Instance: ccstate.FrozenStateValue{}},
+	{Hint: ccstate.VestingStateValueHint, Instance: ccstate.VestingStateValue{}},
+	{Hint: ccstate.TransferLockStateValueHint, Instance: ccstate.TransferLockStateValue{}},
+	{Hint: ccstate.AllowanceStateValueHint, Instance: ccstate.AllowanceStateValue{}},
+	{Hint: ccstate.ScheduleStateValueHint, Instance: ccstate.ScheduleStateValue{}},
+	{Hint: ccstate.ScheduleQueueStateValueHint, Instance: ccstate.ScheduleQueueStateValue{}},
+	{Hint: ccstate.ProposalStateValueHint, Instance: ccstate.ProposalStateValue{}},
+	{Hint: ccstate.GuardianStateValueHint, Instance: ccstate.GuardianStateValue{}},
+	{Hint: ccstate.RecoveryStateValueHint, Instance: ccstate.RecoveryStateValue{}},
+	{Hint: ccstate.PreviousKeysStateValueHint, Instance: ccstate.PreviousKeysStateValue{}},
 
 	{Hint: cestate.ContractAccountStateValueHint, Instance: cestate.ContractAccountStateValue{}},
+	{Hint: cestate.HandlerWithdrawnStateValueHint, Instance: cestate.HandlerWithdrawnStateValue{}},
 
 	{Hint: digest.AccountValueHint, Instance: digest.AccountValue{}},
 	{Hint: digest.OperationValueHint, Instance: digest.OperationValue{}},
@@ -115,11 +163,34 @@ var AddedSupportedHinters = []encoder.DecodeDetail{
 	{Hint: currency.RegisterCurrencyFactHint, Instance: currency.RegisterCurrencyFact{}},
 	{Hint: currency.UpdateKeyFactHint, Instance: currency.UpdateKeyFact{}},
 	{Hint: currency.MintFactHint, Instance: currency.MintFact{}},
+	{Hint: currency.BurnFactHint, Instance: currency.BurnFact{}},
+	{Hint: currency.FreezeAccountFactHint, Instance: currency.FreezeAccountFact{}},
+	{Hint: currency.ForceTransferFactHint, Instance: currency.ForceTransferFact{}},
+	{Hint: currency.CreateVestingFactHint, Instance: currency.CreateVestingFact{}},
+	{Hint: currency.ReleaseVestingFactHint, Instance: currency.ReleaseVestingFact{}},
+	{Hint: currency.LockTransferFactHint, Instance: currency.LockTransferFact{}},
+	{Hint: currency.ClaimTransferFactHint, Instance: currency.ClaimTransferFact{}},
+	{Hint: currency.RefundTransferFactHint, Instance: currency.RefundTransferFact{}},
+	{Hint: currency.ScheduleTransferFactHint, Instance: currency.ScheduleTransferFact{}},
+	{Hint: currency.CancelScheduleFactHint, Instance: currency.CancelScheduleFact{}},
+	{Hint: currency.ProposeOperationFactHint, Instance: currency.ProposeOperationFact{}},
+	{Hint: currency.ApproveOperationFactHint, Instance: currency.ApproveOperationFact{}},
+	{Hint: currency.SetGuardiansFactHint, Instance: currency.SetGuardiansFact{}},
+	{Hint: currency.RecoverAccountFactHint, Instance: currency.RecoverAccountFact{}},
+	{Hint: currency.CompleteRecoveryFactHint, Instance: currency.CompleteRecoveryFact{}},
+	{Hint: currency.CancelRecoveryFactHint, Instance: currency.CancelRecoveryFact{}},
+	{Hint: currency.ApproveFactHint, Instance: currency.ApproveFact{}},
+	{Hint: currency.TransferFromFactHint, Instance: currency.TransferFromFact{}},
+	{Hint: currency.PauseCurrencyFactHint, Instance: currency.PauseCurrencyFact{}},
 	{Hint: currency.TransferFactHint, Instance: currency.TransferFact{}},
 
 	{Hint: extension.CreateContractAccountFactHint, Instance: extension.CreateContractAccountFact{}},
 	{Hint: extension.UpdateHandlerFactHint, Instance: extension.UpdateHandlerFact{}},
 	{Hint: extension.UpdateRecipientFactHint, Instance: extension.UpdateRecipientFact{}},
+	{Hint: extension.UpdateOwnerFactHint, Instance: extension.UpdateOwnerFact{}},
+	{Hint: extension.AcceptOwnerFactHint, Instance: extension.AcceptOwnerFact{}},
+	{Hint: extension.UpdateBalanceStatusFactHint, Instance: extension.UpdateBalanceStatusFact{}},
+	{Hint: extension.UpdateActivationFactHint, Instance: extension.UpdateActivationFact{}},
 	{Hint: extension.WithdrawFactHint, Instance: extension.WithdrawFact{}},
 
 	{Hint: isaacoperation.GenesisNetworkPolicyFactHint, Instance: isaacoperation.GenesisNetworkPolicyFact{}},
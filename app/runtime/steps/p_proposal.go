@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/imfact-labs/currency-model/app/runtime/contracts"
+	"github.com/imfact-labs/currency-model/operation/processor"
 	"github.com/imfact-labs/mitum2/launch"
 
 	"github.com/imfact-labs/mitum2/base"
@@ -54,6 +55,7 @@ func newProposalProcessorFunc(pctx context.Context) (
 	var db isaac.Database
 	var oprs *hint.CompatibleSet[isaac.NewOperationProcessorInternalFunc]
 	var oprsB *hint.CompatibleSet[contracts.NewOperationProcessorInternalWithProposalFunc]
+	var opr *processor.OperationProcessor
 
 	if err := util.LoadFromContextOK(pctx,
 		launch.EncodersContextKey, &encs,
@@ -63,6 +65,7 @@ func newProposalProcessorFunc(pctx context.Context) (
 		launch.CenterDatabaseContextKey, &db,
 		launch.OperationProcessorsMapContextKey, &oprs,
 		contracts.OperationProcessorsMapBContextKey, &oprsB,
+		contracts.OperationProcessorContextKey, &opr,
 	); err != nil {
 		return nil, err
 	}
@@ -77,15 +80,18 @@ func newProposalProcessorFunc(pctx context.Context) (
 	) {
 		args := isaac.NewDefaultProposalProcessorArgs()
 		args.MaxWorkerSize = math.MaxInt16
-		args.NewWriterFunc = launch.NewBlockWriterFunc(
-			local,
-			isaacparams.NetworkID(),
-			launch.LocalFSDataDirectory(design.Storage.Base),
-			encs.JSON(),
-			encs.Default(),
-			db,
-			args.MaxWorkerSize,
-			isaacparams.StateCacheSize(),
+		args.NewWriterFunc = newScheduleBlockWriterFunc(
+			launch.NewBlockWriterFunc(
+				local,
+				isaacparams.NetworkID(),
+				launch.LocalFSDataDirectory(design.Storage.Base),
+				encs.JSON(),
+				encs.Default(),
+				db,
+				args.MaxWorkerSize,
+				isaacparams.StateCacheSize(),
+			),
+			opr,
 		)
 		args.GetStateFunc = db.State
 		args.GetOperationFunc = getProposalOperationFuncf(proposal)
@@ -73,11 +73,116 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 		currency.NewUpdateCurrencyProcessor(isaacParams.Threshold()),
 	); err != nil {
 		return pctx, err
+	} else if err := opr.SetProcessor(
+		currency.PauseCurrencyHint,
+		currency.NewPauseCurrencyProcessor(isaacParams.Threshold()),
+	); err != nil {
+		return pctx, err
 	} else if err := opr.SetProcessor(
 		currency.MintHint,
 		currency.NewMintProcessor(isaacParams.Threshold()),
 	); err != nil {
 		return pctx, err
+	} else if err := opr.SetProcessor(
+		currency.BurnHint,
+		currency.NewBurnProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		currency.FreezeAccountHint,
+		currency.NewFreezeAccountProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		currency.ForceTransferHint,
+		currency.NewForceTransferProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		currency.CreateVestingHint,
+		currency.NewCreateVestingProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		currency.ReleaseVestingHint,
+		currency.NewReleaseVestingProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		currency.LockTransferHint,
+		currency.NewLockTransferProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		currency.ClaimTransferHint,
+		currency.NewClaimTransferProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		currency.RefundTransferHint,
+		currency.NewRefundTransferProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		currency.ScheduleTransferHint,
+		currency.NewScheduleTransferProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		currency.CancelScheduleHint,
+		currency.NewCancelScheduleProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		currency.RunScheduleHint,
+		currency.NewRunScheduleProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		currency.SkipScheduleHint,
+		currency.NewSkipScheduleProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		currency.ProposeOperationHint,
+		currency.NewProposeOperationProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		currency.ApproveOperationHint,
+		currency.NewApproveOperationProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		currency.SetGuardiansHint,
+		currency.NewSetGuardiansProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		currency.RecoverAccountHint,
+		currency.NewRecoverAccountProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		currency.CompleteRecoveryHint,
+		currency.NewCompleteRecoveryProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		currency.CancelRecoveryHint,
+		currency.NewCancelRecoveryProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		currency.ApproveHint,
+		currency.NewApproveProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		currency.TransferFromHint,
+		currency.NewTransferFromProcessor(),
+	); err != nil {
+		return pctx, err
 	} else if err := opr.SetProcessor(
 		extension.CreateContractAccountHint,
 		extension.NewCreateContractAccountProcessor(),
@@ -93,6 +198,26 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 		extension.NewUpdateRecipientProcessor(),
 	); err != nil {
 		return pctx, err
+	} else if err := opr.SetProcessor(
+		extension.UpdateOwnerHint,
+		extension.NewUpdateOwnerProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		extension.AcceptOwnerHint,
+		extension.NewAcceptOwnerProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		extension.UpdateBalanceStatusHint,
+		extension.NewUpdateBalanceStatusProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		extension.UpdateActivationHint,
+		extension.NewUpdateActivationProcessor(),
+	); err != nil {
+		return pctx, err
 	} else if err := opr.SetProcessor(
 		extension.WithdrawHint,
 		extension.NewWithdrawProcessor(),
@@ -165,6 +290,16 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 			)
 		})
 
+	_ = setA.Add(currency.PauseCurrencyHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
 	_ = setA.Add(currency.MintHint,
 		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
 			return opr.New(
@@ -175,6 +310,186 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 			)
 		})
 
+	_ = setA.Add(currency.BurnHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = setA.Add(currency.FreezeAccountHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = setA.Add(currency.ForceTransferHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = setA.Add(currency.CreateVestingHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = setA.Add(currency.ReleaseVestingHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = setA.Add(currency.LockTransferHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = setA.Add(currency.ClaimTransferHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = setA.Add(currency.RefundTransferHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = setA.Add(currency.ScheduleTransferHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = setA.Add(currency.CancelScheduleHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = setA.Add(currency.ProposeOperationHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = setA.Add(currency.ApproveOperationHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = setA.Add(currency.SetGuardiansHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = setA.Add(currency.RecoverAccountHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = setA.Add(currency.CompleteRecoveryHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = setA.Add(currency.CancelRecoveryHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = setA.Add(currency.ApproveHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = setA.Add(currency.TransferFromHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
 	_ = setA.Add(extension.CreateContractAccountHint,
 		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
 			return opr.New(
@@ -205,6 +520,46 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 			)
 		})
 
+	_ = setA.Add(extension.UpdateOwnerHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = setA.Add(extension.AcceptOwnerHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = setA.Add(extension.UpdateBalanceStatusHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = setA.Add(extension.UpdateActivationHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
 	_ = setA.Add(extension.WithdrawHint,
 		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
 			return opr.New(
@@ -0,0 +1,261 @@
+package steps
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/operation/currency"
+	"github.com/imfact-labs/currency-model/operation/processor"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/isaac"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/logging"
+	"github.com/pkg/errors"
+)
+
+// scheduleRun is the processed result of due schedule.
+type scheduleRun struct {
+	op      base.Operation
+	stvs    []base.StateMergeValue
+	reason  base.OperationProcessReasonError
+	receipt base.OperationReceipt
+}
+
+// scheduleBlockWriter runs the due schedules at the start of block. The runs
+// are processed on the state of previous block, like the operations of
+// proposal, and are written at the head of block; the indices of the
+// operations of proposal are shifted by the number of runs.
+type scheduleBlockWriter struct {
+	isaac.BlockWriter
+	runs    []scheduleRun
+	err     error
+	written bool
+	sync.Mutex
+}
+
+func newScheduleBlockWriterFunc(
+	newWriterf isaac.NewBlockWriterFunc,
+	opr *processor.OperationProcessor,
+) isaac.NewBlockWriterFunc {
+	return func(proposal base.ProposalSignFact, getStateFunc base.GetStateFunc) (isaac.BlockWriter, error) {
+		writer, err := newWriterf(proposal, getStateFunc)
+		if err != nil {
+			return nil, err
+		}
+
+		switch runs, err := processDueSchedules(proposal.Point().Height(), opr, getStateFunc); {
+		case err != nil:
+			_ = writer.Cancel()
+
+			return nil, err
+		case len(runs) < 1:
+			return writer, nil
+		default:
+			return &scheduleBlockWriter{BlockWriter: writer, runs: runs}, nil
+		}
+	}
+}
+
+// processDueSchedules processes the runs of due schedules in order. The run,
+// which can not be made, is replaced by SkipSchedule with the reason; the
+// schedule, which can not be skipped either, is left for the next block. The
+// duplication keys of runs are set to opr, so the operations of proposal are
+// deduplicated against them.
+func processDueSchedules(
+	height base.Height, opr *processor.OperationProcessor, getStateFunc base.GetStateFunc,
+) ([]scheduleRun, error) {
+	e := util.StringError("process due schedules")
+
+	// NOTE the keys of the former proposal at same height are cleared.
+	opr.SetBlockDuplicated(height, nil)
+
+	ops, err := currency.DueSchedules(height, getStateFunc)
+	switch {
+	case err != nil:
+		return nil, e.Wrap(err)
+	case len(ops) < 1:
+		return nil, nil
+	}
+
+	// NOTE run and skip of the same schedule share the duplication key, so
+	// they are processed by the different processors.
+	runr, err := opr.New(height, getStateFunc, nil, nil)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	defer func() {
+		_ = runr.Close()
+	}()
+
+	skipr, err := opr.New(height, getStateFunc, nil, nil)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	defer func() {
+		_ = skipr.Close()
+	}()
+
+	runs := make([]scheduleRun, 0, len(ops))
+
+	for i := range ops {
+		fact := ops[i].Fact().(currency.RunScheduleFact) //nolint:forcetypeassert //...
+
+		var reason string
+
+		switch run, err := processScheduleOperation(runr, ops[i], getStateFunc); {
+		case err != nil:
+			reason = err.Error()
+		case run.reason != nil:
+			reason = run.reason.Error()
+		default:
+			runs = append(runs, run)
+
+			continue
+		}
+
+		skip := currency.NewSkipSchedule(
+			currency.NewSkipScheduleFact(fact.Sender(), fact.Schedule(), height, reason),
+		)
+
+		run, err := processScheduleOperation(skipr, skip, getStateFunc)
+		if err != nil {
+			opr.Log().Error().Err(err).
+				Stringer("sender", fact.Sender()).Str("schedule", fact.Schedule()).
+				Msg("failed to skip due schedule; ignored")
+
+			continue
+		}
+
+		runs = append(runs, run)
+	}
+
+	keys := map[string]struct{}{}
+
+	for _, r := range []*processor.OperationProcessor{runr, skipr} {
+		for k := range r.Duplicated {
+			keys[k] = struct{}{}
+		}
+	}
+
+	opr.SetBlockDuplicated(height, keys)
+
+	return runs, nil
+}
+
+func processScheduleOperation(
+	opr *processor.OperationProcessor, op base.Operation, getStateFunc base.GetStateFunc,
+) (scheduleRun, error) {
+	run := scheduleRun{op: op}
+
+	ctx := context.Background()
+
+	switch _, reason, err := opr.PreProcess(ctx, op, getStateFunc); {
+	case err != nil:
+		return run, err
+	case reason != nil:
+		run.reason = reason
+
+		return run, nil
+	}
+
+	switch stvs, reason, err := opr.Process(ctx, op, getStateFunc); {
+	case err != nil:
+		return run, err
+	case reason != nil:
+		run.reason = reason
+	default:
+		run.stvs = stvs
+		run.receipt = opr.OperationReceipt()
+	}
+
+	return run, nil
+}
+
+func (w *scheduleBlockWriter) SetLogging(l *logging.Logging) *logging.Logging {
+	if i, ok := w.BlockWriter.(logging.SetLogging); ok {
+		return i.SetLogging(l)
+	}
+
+	return l
+}
+
+func (w *scheduleBlockWriter) SetOperationsSize(n uint64) {
+	w.Lock()
+	defer w.Unlock()
+
+	w.BlockWriter.SetOperationsSize(n + uint64(len(w.runs)))
+	w.err = w.writeRuns(context.Background())
+}
+
+func (w *scheduleBlockWriter) SetProcessResult(
+	ctx context.Context,
+	index uint64,
+	ophash, facthash util.Hash,
+	instate bool,
+	errorreason base.OperationProcessReasonError,
+) error {
+	return w.BlockWriter.SetProcessResult(ctx, index+uint64(len(w.runs)), ophash, facthash, instate, errorreason)
+}
+
+func (w *scheduleBlockWriter) SetOperationReceipt(
+	ctx context.Context,
+	index uint64,
+	ophash, facthash util.Hash,
+	receipt base.OperationReceipt,
+) error {
+	return w.BlockWriter.SetOperationReceipt(ctx, index+uint64(len(w.runs)), ophash, facthash, receipt)
+}
+
+func (w *scheduleBlockWriter) SetStates(
+	ctx context.Context, index uint64, values []base.StateMergeValue, operation base.Operation,
+) error {
+	return w.BlockWriter.SetStates(ctx, index+uint64(len(w.runs)), values, operation)
+}
+
+func (w *scheduleBlockWriter) Manifest(ctx context.Context, previous base.Manifest) (base.Manifest, error) {
+	w.Lock()
+
+	// NOTE without the operations of proposal, SetOperationsSize is not
+	// called.
+	if !w.written {
+		w.BlockWriter.SetOperationsSize(uint64(len(w.runs)))
+		w.err = w.writeRuns(ctx)
+	}
+
+	err := w.err
+
+	w.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return w.BlockWriter.Manifest(ctx, previous)
+}
+
+func (w *scheduleBlockWriter) writeRuns(ctx context.Context) error {
+	w.written = true
+
+	for i := range w.runs {
+		r := w.runs[i]
+		index := uint64(i)
+
+		if err := w.BlockWriter.SetStates(ctx, index, r.stvs, r.op); err != nil {
+			return errors.WithMessage(err, "write schedule run")
+		}
+
+		if err := w.BlockWriter.SetProcessResult(
+			ctx, index, r.op.Hash(), r.op.Fact().Hash(), len(r.stvs) > 0, r.reason,
+		); err != nil {
+			return errors.WithMessage(err, "write schedule run")
+		}
+
+		if err := w.BlockWriter.SetOperationReceipt(
+			ctx, index, r.op.Hash(), r.op.Fact().Hash(), r.receipt,
+		); err != nil {
+			return errors.WithMessage(err, "write schedule run")
+		}
+	}
+
+	return nil
+}
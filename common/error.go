@@ -20,6 +20,7 @@ var (
 
 var (
 	ErrAccountE        = util.NewIDError(string(ErrMAccountE))
+	ErrAccountFrozen   = util.NewIDError(string(ErrMAccountFrozen))
 	ErrAccountNAth     = util.NewIDError(string(ErrMAccountNAth))
 	ErrAccountNF       = util.NewIDError(string(ErrMAccountNF))
 	ErrAccTypeInvalid  = util.NewIDError(string(ErrMAccTypeInvalid))
@@ -30,7 +31,9 @@ var (
 	ErrCAccountNF      = util.NewIDError(string(ErrMCAccountNF))
 	ErrCAccountRS      = util.NewIDError(string(ErrMCAccountRS))
 	ErrCurrencyNF      = util.NewIDError(string(ErrMCurrencyNF))
+	ErrCurrencyPaused  = util.NewIDError(string(ErrMCurrencyPaused))
 	ErrDupVal          = util.NewIDError(string(ErrMDupVal))
+	ErrLimitExceeded   = util.NewIDError(string(ErrMLimitExceeded))
 	ErrSelfTarget      = util.NewIDError(string(ErrMSelfTarget))
 	ErrServiceE        = util.NewIDError(string(ErrMServiceE))
 	ErrServiceNF       = util.NewIDError(string(ErrMServiceNF))
@@ -58,6 +61,7 @@ var (
 
 var (
 	ErrMAccountE        = ErrMessage("Account exist")
+	ErrMAccountFrozen   = ErrMessage("Account frozen")
 	ErrMAccountNAth     = ErrMessage("Account not authorized")
 	ErrMAccountNF       = ErrMessage("Account not found")
 	ErrMAccTypeInvalid  = ErrMessage("Invalid account type")
@@ -69,7 +73,9 @@ var (
 	ErrMCAccountRS      = ErrMessage("Contract account restricted")
 	ErrMCurrencyE       = ErrMessage("Currency exist")
 	ErrMCurrencyNF      = ErrMessage("Currency not found")
+	ErrMCurrencyPaused  = ErrMessage("Currency paused")
 	ErrMDupVal          = ErrMessage("Duplicated value")
+	ErrMLimitExceeded   = ErrMessage("Limit exceeded")
 	ErrMSignInvalid     = ErrMessage("Invalid signing")
 	ErrMUserSignInvalid = ErrMessage("Invalid user signing")
 	ErrMSignNE          = ErrMessage("Not enough sign")
@@ -17,6 +17,7 @@ type AccountValue struct {
 	hint.BaseHinter
 	ac                    types.Account
 	balance               []types.Amount
+	locked                []types.Amount
 	height                base.Height
 	contractAccountStatus types.ContractAccountStatus
 }
@@ -48,6 +49,12 @@ func (va AccountValue) Balance() []types.Amount {
 	return va.balance
 }
 
+// Locked returns the amounts locked in vesting, which are not part of the
+// spendable balance.
+func (va AccountValue) Locked() []types.Amount {
+	return va.locked
+}
+
 func (va AccountValue) ContractAccountStatus() types.ContractAccountStatus {
 	return va.contractAccountStatus
 }
@@ -68,6 +75,12 @@ func (va AccountValue) SetBalance(balance []types.Amount) AccountValue {
 	return va
 }
 
+func (va AccountValue) SetLocked(locked []types.Amount) AccountValue {
+	va.locked = locked
+
+	return va
+}
+
 func (va AccountValue) SetContractAccountStatus(status types.ContractAccountStatus) AccountValue {
 	va.contractAccountStatus = status
 
@@ -20,21 +20,26 @@ func (va AccountValue) MarshalBSON() ([]byte, error) {
 	//	))
 	//}
 
-	return bsonenc.Marshal(bsonenc.MergeBSONM(
-		bson.M{
-			"_hint":                   va.Hint().String(),
-			"ac":                      va.ac,
-			"balance":                 va.balance,
-			"height":                  va.height,
-			"contract_account_status": va.contractAccountStatus,
-		},
-	))
+	m := bson.M{
+		"_hint":                   va.Hint().String(),
+		"ac":                      va.ac,
+		"balance":                 va.balance,
+		"height":                  va.height,
+		"contract_account_status": va.contractAccountStatus,
+	}
+
+	if len(va.locked) > 0 {
+		m["locked"] = va.locked
+	}
+
+	return bsonenc.Marshal(bsonenc.MergeBSONM(m))
 }
 
 type AccountValueBSONUnmarshaler struct {
 	Hint                  string      `bson:"_hint"`
 	Account               bson.Raw    `bson:"ac"`
 	Balance               bson.Raw    `bson:"balance"`
+	Locked                bson.Raw    `bson:"locked,omitempty"`
 	Height                base.Height `bson:"height"`
 	ContractAccountStatus bson.Raw    `bson:"contract_account_status"`
 }
@@ -52,5 +57,5 @@ func (va *AccountValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return va.unpack(enc, ht, uva.Account, uva.Balance, uva.Height, uva.ContractAccountStatus)
+	return va.unpack(enc, ht, uva.Account, uva.Balance, uva.Locked, uva.Height, uva.ContractAccountStatus)
 }
@@ -11,7 +11,7 @@ import (
 func (va *AccountValue) unpack(
 	enc encoder.Encoder,
 	ht hint.Hint,
-	bac, bl []byte,
+	bac, bl, lk []byte,
 	height base.Height,
 	cas []byte,
 ) error {
@@ -43,6 +43,24 @@ func (va *AccountValue) unpack(
 	}
 
 	va.balance = balance
+
+	if len(lk) > 0 {
+		hlk, err := enc.DecodeSlice(lk)
+		if err != nil {
+			return err
+		}
+
+		locked := make([]types.Amount, len(hlk))
+		for i := range hlk {
+			j, ok := hlk[i].(types.Amount)
+			if !ok {
+				return errors.Errorf("expected currency.Amount, not %T", hlk[i])
+			}
+			locked[i] = j
+		}
+
+		va.locked = locked
+	}
 	va.height = height
 
 	status, err := enc.Decode(cas)
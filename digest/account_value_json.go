@@ -14,6 +14,7 @@ type AccountValueJSONMarshaler struct {
 	hint.BaseHinter
 	types.AccountJSONMarshaler
 	Balance               []types.Amount              `json:"balance,omitempty"`
+	Locked                []types.Amount              `json:"locked,omitempty"`
 	Height                base.Height                 `json:"height"`
 	ContractAccountStatus types.ContractAccountStatus `json:"contract_account_status"`
 }
@@ -23,6 +24,7 @@ func (va AccountValue) MarshalJSON() ([]byte, error) {
 		BaseHinter:            va.BaseHinter,
 		AccountJSONMarshaler:  va.ac.EncodeJSON(),
 		Balance:               va.balance,
+		Locked:                va.locked,
 		Height:                va.height,
 		ContractAccountStatus: va.contractAccountStatus,
 	})
@@ -31,6 +33,7 @@ func (va AccountValue) MarshalJSON() ([]byte, error) {
 type AccountValueJSONUnmarshaler struct {
 	Hint                  hint.Hint
 	Balance               json.RawMessage `json:"balance"`
+	Locked                json.RawMessage `json:"locked,omitempty"`
 	Height                base.Height     `json:"height"`
 	ContractAccountStatus json.RawMessage `json:"contract_account_status"`
 }
@@ -42,7 +45,7 @@ func (va *AccountValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
 	}
 
 	ac := new(types.Account)
-	if err := va.unpack(enc, uva.Hint, nil, uva.Balance, uva.Height, uva.ContractAccountStatus); err != nil {
+	if err := va.unpack(enc, uva.Hint, nil, uva.Balance, uva.Locked, uva.Height, uva.ContractAccountStatus); err != nil {
 		return err
 	} else if err := ac.DecodeJSON(b, enc); err != nil {
 		return err
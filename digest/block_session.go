@@ -383,6 +383,41 @@ func PrepareAccounts(bs *BlockSession, st base.State) (string, []mongo.WriteMode
 		}
 
 		return DefaultColNameBalance, j, nil
+	case ccstate.IsVestingStateKey(st.Key()):
+		j, err := handleVestingState(bs, st)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return DefaultColNameVesting, j, nil
+	case ccstate.IsTransferLockStateKey(st.Key()):
+		j, err := handleTransferLockState(bs, st)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return DefaultColNameTransferLock, j, nil
+	case ccstate.IsAllowanceStateKey(st.Key()):
+		j, err := handleAllowanceState(bs, st)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return DefaultColNameAllowance, j, nil
+	case ccstate.IsProposalStateKey(st.Key()):
+		j, err := handleProposalState(bs, st)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return DefaultColNameProposal, j, nil
+	case ccstate.IsPreviousKeysStateKey(st.Key()):
+		j, err := handlePreviousKeysState(bs, st)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return DefaultColNamePreviousKeys, j, nil
 	case cestate.IsStateContractAccountKey(st.Key()):
 		j, err := handleContractAccountState(bs, st)
 		if err != nil {
@@ -426,6 +461,46 @@ func handleBalanceState(bs *BlockSession, st base.State) ([]mongo.WriteModel, st
 	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, address, nil
 }
 
+func handleVestingState(bs *BlockSession, st base.State) ([]mongo.WriteModel, error) {
+	doc, err := NewVestingDoc(st, bs.st.digestDB.Encoder())
+	if err != nil {
+		return nil, err
+	}
+	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+}
+
+func handleTransferLockState(bs *BlockSession, st base.State) ([]mongo.WriteModel, error) {
+	doc, err := NewTransferLockDoc(st, bs.st.digestDB.Encoder())
+	if err != nil {
+		return nil, err
+	}
+	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+}
+
+func handleProposalState(bs *BlockSession, st base.State) ([]mongo.WriteModel, error) {
+	doc, err := NewProposalDoc(st, bs.st.digestDB.Encoder())
+	if err != nil {
+		return nil, err
+	}
+	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+}
+
+func handlePreviousKeysState(bs *BlockSession, st base.State) ([]mongo.WriteModel, error) {
+	doc, err := NewPreviousKeysDoc(st, bs.st.digestDB.Encoder())
+	if err != nil {
+		return nil, err
+	}
+	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+}
+
+func handleAllowanceState(bs *BlockSession, st base.State) ([]mongo.WriteModel, error) {
+	doc, err := NewAllowanceDoc(st, bs.st.digestDB.Encoder())
+	if err != nil {
+		return nil, err
+	}
+	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+}
+
 func handleContractAccountState(bs *BlockSession, st base.State) ([]mongo.WriteModel, error) {
 	doc, err := NewContractAccountStatusDoc(st, bs.st.digestDB.Encoder())
 	if err != nil {
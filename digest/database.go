@@ -31,6 +31,11 @@ var (
 	DefaultColNameAccount         = "digest_ac"
 	DefaultColNameContractAccount = "digest_ca"
 	DefaultColNameBalance         = "digest_bl"
+	DefaultColNameVesting         = "digest_vs"
+	DefaultColNameTransferLock    = "digest_tl"
+	DefaultColNameAllowance       = "digest_al"
+	DefaultColNameProposal        = "digest_pp"
+	DefaultColNamePreviousKeys    = "digest_pk"
 	DefaultColNameCurrency        = "digest_cr"
 	DefaultColNameOperation       = "digest_op"
 	DefaultColNameBlock           = "digest_bm"
@@ -197,6 +202,11 @@ func (db *Database) clean(ctx context.Context) error {
 	for _, col := range []string{
 		DefaultColNameAccount,
 		DefaultColNameBalance,
+		DefaultColNameVesting,
+		DefaultColNameTransferLock,
+		DefaultColNameAllowance,
+		DefaultColNameProposal,
+		DefaultColNamePreviousKeys,
 		DefaultColNameCurrency,
 		DefaultColNameOperation,
 		DefaultColNameBlock,
@@ -239,6 +249,11 @@ func (db *Database) cleanByHeight(ctx context.Context, height base.Height) error
 	for _, col := range []string{
 		DefaultColNameAccount,
 		DefaultColNameBalance,
+		DefaultColNameVesting,
+		DefaultColNameTransferLock,
+		DefaultColNameAllowance,
+		DefaultColNameProposal,
+		DefaultColNamePreviousKeys,
 		DefaultColNameCurrency,
 		DefaultColNameOperation,
 		DefaultColNameBlock,
@@ -531,6 +546,17 @@ func (db *Database) Account(a base.Address) (AccountValue, bool /* exists */, er
 		rs = rs.SetBalance(am).
 			SetHeight(lastHeight)
 	}
+	// NOTE load locked amounts of vesting
+	switch am, lastHeight, err := db.locked(a); {
+	case err != nil:
+		return rs, false, err
+	case len(am) < 1:
+	default:
+		rs = rs.SetLocked(am)
+		if lastHeight > rs.Height() {
+			rs = rs.SetHeight(lastHeight)
+		}
+	}
 	// NOTE load contract account status
 	switch status, lastHeight, err := db.contractAccountStatus(a); {
 	case err != nil:
@@ -677,6 +703,261 @@ func (db *Database) balance(a base.Address) ([]types.Amount, base.Height, error)
 	return ams, lastHeight, nil
 }
 
+func (db *Database) locked(a base.Address) ([]types.Amount, base.Height, error) {
+	lastHeight := base.NilHeight
+	var cids []string
+
+	var ams []types.Amount
+	for {
+		filter := dutil.NewBSONFilter("address", a.String())
+
+		var q bson.D
+		if len(cids) < 1 {
+			q = filter.D()
+		} else {
+			q = filter.Add("currency", bson.M{"$nin": cids}).D()
+		}
+
+		var sta base.State
+		if err := db.digestDB.Client().GetByFilter(
+			DefaultColNameVesting,
+			q,
+			func(res *mongo.SingleResult) error {
+				i, err := LoadBalance(res.Decode, db.digestDB.Encoders())
+				if err != nil {
+					return err
+				}
+				sta = i
+
+				return nil
+			},
+			options.FindOne().SetSort(dutil.NewBSONFilter("height", -1).D()),
+		); err != nil {
+			if err.Error() == util.NewIDError("mongo: no documents in result").Error() {
+				break
+			}
+
+			return nil, lastHeight, err
+		}
+
+		v, err := currency.StateVestingValue(sta)
+		if err != nil {
+			return nil, lastHeight, err
+		}
+
+		cids = append(cids, v.Amount.Currency().String())
+
+		if locked := v.Locked(); locked.OverZero() {
+			ams = append(ams, types.NewAmount(locked, v.Amount.Currency()))
+		}
+
+		if h := sta.Height(); h > lastHeight {
+			lastHeight = h
+		}
+	}
+
+	return ams, lastHeight, nil
+}
+
+// OpenTransferLocks returns the transfer locks, which are not yet claimed or
+// refunded, and the address is sender or receiver of.
+func (db *Database) OpenTransferLocks(a base.Address) ([]currency.TransferLockStateValue, error) {
+	keys := map[string]struct{}{}
+
+	var vs []currency.TransferLockStateValue
+	if err := db.digestDB.Client().Find(
+		context.Background(),
+		DefaultColNameTransferLock,
+		dutil.NewBSONFilter("addresses", a.String()).D(),
+		func(cursor *mongo.Cursor) (bool, error) {
+			st, err := LoadBalance(cursor.Decode, db.digestDB.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			// NOTE only the latest state of each lock counts
+			if _, found := keys[st.Key()]; found {
+				return true, nil
+			}
+			keys[st.Key()] = struct{}{}
+
+			v, err := currency.StateTransferLockValue(st)
+			if err != nil {
+				return false, err
+			}
+
+			if v.Status == currency.TransferLockOpen {
+				vs = append(vs, v)
+			}
+
+			return true, nil
+		},
+		options.Find().SetSort(dutil.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, err
+	}
+
+	return vs, nil
+}
+
+// PendingProposals returns the proposals of the account, which are not yet
+// executed nor expired.
+func (db *Database) PendingProposals(a base.Address) ([]currency.ProposalStateValue, error) {
+	keys := map[string]struct{}{}
+	lastBlock := db.LastBlock()
+
+	var vs []currency.ProposalStateValue
+	if err := db.digestDB.Client().Find(
+		context.Background(),
+		DefaultColNameProposal,
+		dutil.NewBSONFilter("account", a.String()).D(),
+		func(cursor *mongo.Cursor) (bool, error) {
+			st, err := LoadBalance(cursor.Decode, db.digestDB.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			// NOTE only the latest state of each proposal counts
+			if _, found := keys[st.Key()]; found {
+				return true, nil
+			}
+			keys[st.Key()] = struct{}{}
+
+			v, err := currency.StateProposalValue(st)
+			if err != nil {
+				return false, err
+			}
+
+			if v.Status == currency.ProposalPending && v.Expiry > lastBlock {
+				vs = append(vs, v)
+			}
+
+			return true, nil
+		},
+		options.Find().SetSort(dutil.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, err
+	}
+
+	return vs, nil
+}
+
+// KeyHistory returns the keys of the account with the heights, during which
+// they passed the threshold of the account, in the order of height.
+func (db *Database) KeyHistory(a base.Address) ([]KeyHistoryValue, error) {
+	var avs []AccountValue
+	if err := db.digestDB.Client().Find(
+		context.Background(),
+		DefaultColNameAccount,
+		dutil.NewBSONFilter("address", a.String()).D(),
+		func(cursor *mongo.Cursor) (bool, error) {
+			va, err := LoadAccountValue(cursor.Decode, db.digestDB.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			avs = append(avs, va)
+
+			return true, nil
+		},
+		options.Find().SetSort(dutil.NewBSONFilter("height", 1).D()),
+	); err != nil {
+		return nil, err
+	}
+
+	var psts []base.State
+	if err := db.digestDB.Client().Find(
+		context.Background(),
+		DefaultColNamePreviousKeys,
+		dutil.NewBSONFilter("account", a.String()).D(),
+		func(cursor *mongo.Cursor) (bool, error) {
+			st, err := LoadBalance(cursor.Decode, db.digestDB.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			psts = append(psts, st)
+
+			return true, nil
+		},
+		options.Find().SetSort(dutil.NewBSONFilter("height", 1).D()),
+	); err != nil {
+		return nil, err
+	}
+
+	return buildKeyHistory(avs, psts)
+}
+
+// OwnerHistory returns the owners of the contract account with the heights,
+// during which they owned the contract account.
+func (db *Database) OwnerHistory(a base.Address) ([]OwnerHistoryValue, error) {
+	filter := dutil.NewBSONFilter("address", a.String())
+	filter.Add("contract", true)
+
+	var sts []base.State
+	if err := db.digestDB.Client().Find(
+		context.Background(),
+		DefaultColNameContractAccount,
+		filter.D(),
+		func(cursor *mongo.Cursor) (bool, error) {
+			st, err := LoadContractAccountStatus(cursor.Decode, db.digestDB.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			sts = append(sts, st)
+
+			return true, nil
+		},
+		options.Find().SetSort(dutil.NewBSONFilter("height", 1).D()),
+	); err != nil {
+		return nil, err
+	}
+
+	return buildOwnerHistory(sts)
+}
+
+// Allowances returns the allowances, which the address approved or is
+// approved of. The revoked allowances are excluded.
+func (db *Database) Allowances(a base.Address) ([]currency.AllowanceStateValue, error) {
+	keys := map[string]struct{}{}
+
+	var vs []currency.AllowanceStateValue
+	if err := db.digestDB.Client().Find(
+		context.Background(),
+		DefaultColNameAllowance,
+		dutil.NewBSONFilter("addresses", a.String()).D(),
+		func(cursor *mongo.Cursor) (bool, error) {
+			st, err := LoadBalance(cursor.Decode, db.digestDB.Encoders())
+			if err != nil {
+				return false, err
+			}
+
+			// NOTE only the latest state of each allowance counts
+			if _, found := keys[st.Key()]; found {
+				return true, nil
+			}
+			keys[st.Key()] = struct{}{}
+
+			v, err := currency.StateAllowanceValue(st)
+			if err != nil {
+				return false, err
+			}
+
+			if v.Amount.Big().OverZero() {
+				vs = append(vs, v)
+			}
+
+			return true, nil
+		},
+		options.Find().SetSort(dutil.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, err
+	}
+
+	return vs, nil
+}
+
 func (db *Database) contractAccountStatus(a base.Address) (types.ContractAccountStatus, base.Height, error) {
 	lastHeight := base.NilHeight
 
@@ -878,6 +1159,22 @@ func (db *Database) Currency(cid string) (types.CurrencyDesign, base.State, erro
 	}
 }
 
+// PendingPolicy returns the state of pending currency policy.
+func (db *Database) PendingPolicy(cid string) (base.State, bool, error) {
+	switch st, found, err := db.mitumDB.State(currency.PendingPolicyStateKey(types.CurrencyID(cid))); {
+	case err != nil:
+		return nil, false, err
+	case !found:
+		return nil, false, nil
+	default:
+		if _, ok := st.Value().(currency.PendingPolicyStateValue); !ok {
+			return nil, false, errors.Errorf("expected PendingPolicyStateValue, not %T", st.Value())
+		}
+
+		return st, true, nil
+	}
+}
+
 func (db *Database) TopHeightByPublickey(pub base.Publickey) (base.Height, error) {
 	var sas []string
 	res := db.digestDB.Client().Collection(DefaultColNameAccount).Distinct(
@@ -96,6 +96,208 @@ func (doc BalanceDoc) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(m)
 }
 
+type VestingDoc struct {
+	mongodbst.BaseDoc
+	st base.State
+	v  currency.VestingStateValue
+}
+
+// NewVestingDoc gets the State of vesting
+func NewVestingDoc(st base.State, enc encoder.Encoder) (VestingDoc, error) {
+	v, err := currency.StateVestingValue(st)
+	if err != nil {
+		return VestingDoc{}, errors.Wrap(err, "VestingDoc needs vesting state")
+	}
+
+	b, err := mongodbst.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return VestingDoc{}, err
+	}
+
+	return VestingDoc{
+		BaseDoc: b,
+		st:      st,
+		v:       v,
+	}, nil
+}
+
+func (doc VestingDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	address := doc.st.Key()[:len(doc.st.Key())-len(currency.VestingStateKeySuffix)-len(doc.v.Amount.Currency())-1]
+	m["address"] = address
+	m["currency"] = doc.v.Amount.Currency().String()
+	m["height"] = doc.st.Height()
+	m["locked"] = doc.v.Locked().String()
+
+	return bsonenc.Marshal(m)
+}
+
+type TransferLockDoc struct {
+	mongodbst.BaseDoc
+	st base.State
+	v  currency.TransferLockStateValue
+}
+
+// NewTransferLockDoc gets the State of transfer lock
+func NewTransferLockDoc(st base.State, enc encoder.Encoder) (TransferLockDoc, error) {
+	v, err := currency.StateTransferLockValue(st)
+	if err != nil {
+		return TransferLockDoc{}, errors.Wrap(err, "TransferLockDoc needs transfer lock state")
+	}
+
+	b, err := mongodbst.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return TransferLockDoc{}, err
+	}
+
+	return TransferLockDoc{
+		BaseDoc: b,
+		st:      st,
+		v:       v,
+	}, nil
+}
+
+func (doc TransferLockDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["key"] = doc.st.Key()
+	m["addresses"] = []string{doc.v.Sender.String(), doc.v.Receiver.String()}
+	m["hashlock"] = doc.v.Hashlock
+	m["currency"] = doc.v.Amount.Currency().String()
+	m["status"] = string(doc.v.Status)
+	m["expiry"] = doc.v.Expiry
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type ProposalDoc struct {
+	mongodbst.BaseDoc
+	st base.State
+	v  currency.ProposalStateValue
+}
+
+// NewProposalDoc gets the State of proposal
+func NewProposalDoc(st base.State, enc encoder.Encoder) (ProposalDoc, error) {
+	v, err := currency.StateProposalValue(st)
+	if err != nil {
+		return ProposalDoc{}, errors.Wrap(err, "ProposalDoc needs proposal state")
+	}
+
+	b, err := mongodbst.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return ProposalDoc{}, err
+	}
+
+	return ProposalDoc{
+		BaseDoc: b,
+		st:      st,
+		v:       v,
+	}, nil
+}
+
+func (doc ProposalDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["key"] = doc.st.Key()
+	m["account"] = doc.v.Account.String()
+	m["status"] = string(doc.v.Status)
+	m["expiry"] = doc.v.Expiry
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type PreviousKeysDoc struct {
+	mongodbst.BaseDoc
+	st base.State
+	v  currency.PreviousKeysStateValue
+}
+
+// NewPreviousKeysDoc gets the State of previous keys
+func NewPreviousKeysDoc(st base.State, enc encoder.Encoder) (PreviousKeysDoc, error) {
+	v, err := currency.StatePreviousKeysValue(st)
+	if err != nil {
+		return PreviousKeysDoc{}, errors.Wrap(err, "PreviousKeysDoc needs previous keys state")
+	}
+
+	b, err := mongodbst.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return PreviousKeysDoc{}, err
+	}
+
+	return PreviousKeysDoc{
+		BaseDoc: b,
+		st:      st,
+		v:       v,
+	}, nil
+}
+
+func (doc PreviousKeysDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["account"] = doc.v.Account.String()
+	m["until"] = doc.v.Until
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type AllowanceDoc struct {
+	mongodbst.BaseDoc
+	st base.State
+	v  currency.AllowanceStateValue
+}
+
+// NewAllowanceDoc gets the State of allowance
+func NewAllowanceDoc(st base.State, enc encoder.Encoder) (AllowanceDoc, error) {
+	v, err := currency.StateAllowanceValue(st)
+	if err != nil {
+		return AllowanceDoc{}, errors.Wrap(err, "AllowanceDoc needs allowance state")
+	}
+
+	b, err := mongodbst.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return AllowanceDoc{}, err
+	}
+
+	return AllowanceDoc{
+		BaseDoc: b,
+		st:      st,
+		v:       v,
+	}, nil
+}
+
+func (doc AllowanceDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["key"] = doc.st.Key()
+	m["addresses"] = []string{doc.v.Owner.String(), doc.v.Spender.String()}
+	m["owner"] = doc.v.Owner.String()
+	m["spender"] = doc.v.Spender.String()
+	m["currency"] = doc.v.Amount.Currency().String()
+	m["expiry"] = doc.v.Expiry
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
 type ContractAccountStatusDoc struct {
 	mongodbst.BaseDoc
 	st  base.State
@@ -129,6 +331,9 @@ func (doc ContractAccountStatusDoc) MarshalBSON() ([]byte, error) {
 	address := doc.st.Key()[:len(doc.st.Key())-len(extension.StateKeyContractAccountSuffix)]
 	m["address"] = address
 	m["owner"] = doc.cas.Owner().String()
+	if po := doc.cas.PendingOwner(); po != nil {
+		m["pending_owner"] = po.String()
+	}
 	m["height"] = doc.st.Height()
 	m["contract"] = true
 
@@ -56,6 +56,75 @@ var BalanceIndexModels = []mongo.IndexModel{
 	//},
 }
 
+var VestingIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "address", Value: 1},
+			bson.E{Key: "currency", Value: 1},
+			bson.E{Key: "height", Value: -1},
+		},
+		Options: options.Index().
+			SetName("mitum_digest_vesting_currency"),
+	},
+}
+
+var TransferLockIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "addresses", Value: 1},
+			bson.E{Key: "height", Value: -1},
+		},
+		Options: options.Index().
+			SetName("mitum_digest_transfer_lock_addresses"),
+	},
+	{
+		Keys: bson.D{
+			bson.E{Key: "key", Value: 1},
+			bson.E{Key: "height", Value: -1},
+		},
+		Options: options.Index().
+			SetName("mitum_digest_transfer_lock_key"),
+	},
+}
+
+var ProposalIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "account", Value: 1},
+			bson.E{Key: "height", Value: -1},
+		},
+		Options: options.Index().
+			SetName("mitum_digest_proposal_account"),
+	},
+	{
+		Keys: bson.D{
+			bson.E{Key: "key", Value: 1},
+			bson.E{Key: "height", Value: -1},
+		},
+		Options: options.Index().
+			SetName("mitum_digest_proposal_key"),
+	},
+}
+
+var AllowanceIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "addresses", Value: 1},
+			bson.E{Key: "height", Value: -1},
+		},
+		Options: options.Index().
+			SetName("mitum_digest_allowance_addresses"),
+	},
+	{
+		Keys: bson.D{
+			bson.E{Key: "key", Value: 1},
+			bson.E{Key: "height", Value: -1},
+		},
+		Options: options.Index().
+			SetName("mitum_digest_allowance_key"),
+	},
+}
+
 var OperationIndexModels = []mongo.IndexModel{
 	{
 		Keys: bson.D{bson.E{Key: "addresses", Value: 1}, bson.E{Key: "height", Value: 1}, bson.E{Key: "index", Value: 1}},
@@ -106,12 +175,48 @@ var DidRegistryDocumentIndexModels = []mongo.IndexModel{
 	},
 }
 
+var PreviousKeysIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "account", Value: 1},
+			bson.E{Key: "height", Value: 1},
+		},
+		Options: options.Index().
+			SetName("mitum_digest_previous_keys_account"),
+	},
+}
+
+var ContractAccountIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "address", Value: 1},
+			bson.E{Key: "height", Value: 1},
+		},
+		Options: options.Index().
+			SetName(IndexPrefix + "contract_account_address_height"),
+	},
+	{
+		Keys: bson.D{
+			bson.E{Key: "owner", Value: 1},
+			bson.E{Key: "height", Value: -1},
+		},
+		Options: options.Index().
+			SetName(IndexPrefix + "contract_account_owner_height"),
+	},
+}
+
 var DefaultIndexes = map[string] /* collection */ []mongo.IndexModel{
-	DefaultColNameBlock:       BlockIndexModels,
-	DefaultColNameAccount:     AccountIndexModels,
-	DefaultColNameBalance:     BalanceIndexModels,
-	DefaultColNameOperation:   OperationIndexModels,
-	DefaultColNameDIDRegistry: DidRegistryIndexModels,
-	DefaultColNameDIDData:     DidRegistryDataIndexModels,
-	DefaultColNameDIDDocument: DidRegistryDocumentIndexModels,
+	DefaultColNameBlock:           BlockIndexModels,
+	DefaultColNameAccount:         AccountIndexModels,
+	DefaultColNameBalance:         BalanceIndexModels,
+	DefaultColNameVesting:         VestingIndexModels,
+	DefaultColNameTransferLock:    TransferLockIndexModels,
+	DefaultColNameAllowance:       AllowanceIndexModels,
+	DefaultColNameProposal:        ProposalIndexModels,
+	DefaultColNamePreviousKeys:    PreviousKeysIndexModels,
+	DefaultColNameContractAccount: ContractAccountIndexModels,
+	DefaultColNameOperation:       OperationIndexModels,
+	DefaultColNameDIDRegistry:     DidRegistryIndexModels,
+	DefaultColNameDIDData:         DidRegistryDataIndexModels,
+	DefaultColNameDIDDocument:     DidRegistryDocumentIndexModels,
 }
@@ -0,0 +1,85 @@
+package digest
+
+import (
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+// KeyHistoryValue is the keys of account, which pass the threshold of account
+// from Since height until Until height. Until of the current keys is
+// base.NilHeight.
+type KeyHistoryValue struct {
+	keys  types.AccountKeys
+	since base.Height
+	until base.Height
+}
+
+func NewKeyHistoryValue(keys types.AccountKeys, since, until base.Height) KeyHistoryValue {
+	return KeyHistoryValue{
+		keys:  keys,
+		since: since,
+		until: until,
+	}
+}
+
+func (va KeyHistoryValue) Keys() types.AccountKeys {
+	return va.keys
+}
+
+func (va KeyHistoryValue) Since() base.Height {
+	return va.since
+}
+
+func (va KeyHistoryValue) Until() base.Height {
+	return va.until
+}
+
+// buildKeyHistory builds the key history from the account values and the
+// previous keys states of one account; both are sorted by height.
+func buildKeyHistory(avs []AccountValue, psts []base.State) ([]KeyHistoryValue, error) {
+	vs := make([]KeyHistoryValue, len(avs))
+	for i := range avs {
+		until := base.NilHeight
+		if i+1 < len(avs) {
+			until = avs[i+1].Height()
+		}
+
+		vs[i] = NewKeyHistoryValue(avs[i].Account().Keys(), avs[i].Height(), until)
+	}
+
+	// NOTE only one previous keys can be in grace period at once; the grace
+	// period of the previous keys ends when the next previous keys are set.
+	graced := -1
+
+	for i := range psts {
+		pv, err := currency.StatePreviousKeysValue(psts[i])
+		if err != nil {
+			return nil, err
+		}
+
+		height := psts[i].Height()
+
+		if graced >= 0 && vs[graced].until > height {
+			vs[graced].until = max(height, vs[graced].since)
+		}
+
+		graced = -1
+
+		for j := len(vs) - 1; j >= 0; j-- {
+			if vs[j].since > height || vs[j].until == base.NilHeight || !vs[j].keys.Equal(pv.Keys) {
+				continue
+			}
+
+			if pv.Until > vs[j].until {
+				vs[j].until = pv.Until
+			}
+
+			graced = j
+
+			break
+		}
+	}
+
+	return vs, nil
+}
@@ -0,0 +1,21 @@
+package digest
+
+import (
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+)
+
+type KeyHistoryValueJSONMarshaler struct {
+	Keys  types.AccountKeys `json:"keys"`
+	Since base.Height       `json:"since"`
+	Until base.Height       `json:"until"`
+}
+
+func (va KeyHistoryValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(KeyHistoryValueJSONMarshaler{
+		Keys:  va.keys,
+		Since: va.since,
+		Until: va.until,
+	})
+}
@@ -0,0 +1,63 @@
+package digest
+
+import (
+	"github.com/imfact-labs/currency-model/state/extension"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+// OwnerHistoryValue is the owner of contract account from Since height until
+// Until height. Until of the current owner is base.NilHeight.
+type OwnerHistoryValue struct {
+	owner base.Address
+	since base.Height
+	until base.Height
+}
+
+func NewOwnerHistoryValue(owner base.Address, since, until base.Height) OwnerHistoryValue {
+	return OwnerHistoryValue{
+		owner: owner,
+		since: since,
+		until: until,
+	}
+}
+
+func (va OwnerHistoryValue) Owner() base.Address {
+	return va.owner
+}
+
+func (va OwnerHistoryValue) Since() base.Height {
+	return va.since
+}
+
+func (va OwnerHistoryValue) Until() base.Height {
+	return va.until
+}
+
+// buildOwnerHistory builds the owner history from the contract account states
+// of one contract account, which are sorted by height.
+func buildOwnerHistory(sts []base.State) ([]OwnerHistoryValue, error) {
+	var vs []OwnerHistoryValue
+
+	for i := range sts {
+		status, err := extension.StateContractAccountValue(sts[i])
+		if err != nil {
+			return nil, err
+		}
+
+		// NOTE the status is also updated by the other operations like
+		// update-handler; only the change of owner starts new period.
+		if len(vs) > 0 && vs[len(vs)-1].owner.Equal(status.Owner()) {
+			continue
+		}
+
+		height := sts[i].Height()
+
+		if len(vs) > 0 {
+			vs[len(vs)-1].until = height
+		}
+
+		vs = append(vs, NewOwnerHistoryValue(status.Owner(), height, base.NilHeight))
+	}
+
+	return vs, nil
+}
@@ -0,0 +1,20 @@
+package digest
+
+import (
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+)
+
+type OwnerHistoryValueJSONMarshaler struct {
+	Owner base.Address `json:"owner"`
+	Since base.Height  `json:"since"`
+	Until base.Height  `json:"until"`
+}
+
+func (va OwnerHistoryValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OwnerHistoryValueJSONMarshaler{
+		Owner: va.owner,
+		Since: va.since,
+		Until: va.until,
+	})
+}
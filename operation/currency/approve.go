@@ -0,0 +1,175 @@
+package currency
+
+import (
+	"fmt"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	ApproveFactHint = hint.MustNewHint("mitum-currency-approve-allowance-operation-fact-v0.0.1")
+	ApproveHint     = hint.MustNewHint("mitum-currency-approve-allowance-operation-v0.0.1")
+)
+
+// ApproveFact sets the allowance of spender, which spender can transfer from
+// the balance of sender by TransferFrom. It replaces the previous allowance of
+// the same currency; zero amount revokes it. Zero expiry means the allowance
+// does not expire.
+type ApproveFact struct {
+	base.BaseFact
+	sender   base.Address
+	spender  base.Address
+	amount   types.Amount
+	expiry   base.Height
+	currency types.CurrencyID
+}
+
+func NewApproveFact(
+	token []byte,
+	sender base.Address,
+	spender base.Address,
+	amount types.Amount,
+	expiry base.Height,
+	currency types.CurrencyID,
+) ApproveFact {
+	fact := ApproveFact{
+		BaseFact: base.NewBaseFact(ApproveFactHint, token),
+		sender:   sender,
+		spender:  spender,
+		amount:   amount,
+		expiry:   expiry,
+		currency: currency,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ApproveFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ApproveFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ApproveFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.spender.Bytes(),
+		fact.amount.Bytes(),
+		fact.expiry.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact ApproveFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false, fact.sender, fact.spender, fact.amount, fact.expiry, fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.spender) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("spender is same with sender, %v", fact.sender)))
+	}
+
+	if fact.amount.Big().Compare(common.ZeroBig) < 0 {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("Under zero amount of Approve")))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact ApproveFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ApproveFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ApproveFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact ApproveFact) Spender() base.Address {
+	return fact.spender
+}
+
+func (fact ApproveFact) Amount() types.Amount {
+	return fact.amount
+}
+
+func (fact ApproveFact) Expiry() base.Height {
+	return fact.expiry
+}
+
+func (fact ApproveFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact ApproveFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.spender, fact.sender}, nil
+}
+
+func (fact ApproveFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact ApproveFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact ApproveFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact ApproveFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fmt.Sprintf("%s:%s", fact.sender.String(), fact.currency.String())}
+	r[extras.DuplicationKeyTypeAllowance] = []string{
+		currency.AllowanceStateKey(fact.sender, fact.spender, fact.amount.Currency()),
+	}
+
+	return r, nil
+}
+
+type Approve struct {
+	extras.ExtendedOperation
+}
+
+func (op Approve) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewApprove(fact ApproveFact) (Approve, error) {
+	return Approve{
+		ExtendedOperation: extras.NewExtendedOperation(ApproveHint, fact),
+	}, nil
+}
@@ -0,0 +1,40 @@
+package currency
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+func (fact *ApproveFact) unpack(
+	enc encoder.Encoder, sd, sp string, bam []byte, expiry base.Height, cid string,
+) error {
+	switch ad, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.sender = ad
+	}
+
+	switch ad, err := base.DecodeAddress(sp, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.spender = ad
+	}
+
+	if hinter, err := enc.Decode(bam); err != nil {
+		return err
+	} else if am, ok := hinter.(types.Amount); !ok {
+		return common.ErrTypeMismatch.Wrap(errors.Errorf("expected Amount, not %T", hinter))
+	} else {
+		fact.amount = am
+	}
+
+	fact.expiry = expiry
+	fact.currency = types.CurrencyID(cid)
+
+	return nil
+}
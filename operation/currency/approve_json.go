@@ -0,0 +1,83 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+type ApproveFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address     `json:"sender"`
+	Spender  base.Address     `json:"spender"`
+	Amount   types.Amount     `json:"amount"`
+	Expiry   base.Height      `json:"expiry"`
+	Currency types.CurrencyID `json:"currency"`
+}
+
+func (fact ApproveFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ApproveFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Spender:               fact.spender,
+		Amount:                fact.amount,
+		Expiry:                fact.expiry,
+		Currency:              fact.currency,
+	})
+}
+
+type ApproveFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string          `json:"sender"`
+	Spender  string          `json:"spender"`
+	Amount   json.RawMessage `json:"amount"`
+	Expiry   base.Height     `json:"expiry"`
+	Currency string          `json:"currency"`
+}
+
+func (fact *ApproveFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf ApproveFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(
+		enc, uf.Sender, uf.Spender, uf.Amount, uf.Expiry, uf.Currency,
+	); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op Approve) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperationMarshaler{
+		BaseOperationJSONMarshaler:           op.BaseOperation.JSONMarshaler(),
+		BaseOperationExtensionsJSONMarshaler: op.BaseOperationExtensions.JSONMarshaler(),
+	})
+}
+
+func (op *Approve) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	var ueo extras.BaseOperationExtensions
+	if err := ueo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperationExtensions = &ueo
+
+	return nil
+}
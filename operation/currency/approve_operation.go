@@ -0,0 +1,166 @@
+package currency
+
+import (
+	"strings"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	ApproveOperationFactHint = hint.MustNewHint("mitum-currency-approve-operation-fact-v0.0.1")
+	ApproveOperationHint     = hint.MustNewHint("mitum-currency-approve-operation-v0.0.1")
+)
+
+const MaxProposalIDSize = 100
+
+func isValidProposalID(id string) error {
+	switch l := len(id); {
+	case l < 1:
+		return common.ErrValueInvalid.Wrap(errors.Errorf("empty proposal id"))
+	case l > MaxProposalIDSize:
+		return common.ErrValueInvalid.Wrap(errors.Errorf("proposal id too long, %d > %d", l, MaxProposalIDSize))
+	case strings.Contains(id, ":"):
+		return common.ErrValueInvalid.Wrap(errors.Errorf("invalid proposal id, %q", id))
+	default:
+		return nil
+	}
+}
+
+// ApproveOperationFact approves the pending proposal of account; proposal is
+// the id of the proposal, the fact hash of ProposeOperationFact. The approval,
+// which passes the threshold of account keys, executes the proposed fact.
+type ApproveOperationFact struct {
+	base.BaseFact
+	account  base.Address
+	proposal string
+	currency types.CurrencyID
+}
+
+func NewApproveOperationFact(
+	token []byte, account base.Address, proposal string, currency types.CurrencyID,
+) ApproveOperationFact {
+	fact := ApproveOperationFact{
+		BaseFact: base.NewBaseFact(ApproveOperationFactHint, token),
+		account:  account,
+		proposal: proposal,
+		currency: currency,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ApproveOperationFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ApproveOperationFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ApproveOperationFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.account.Bytes(),
+		[]byte(fact.proposal),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact ApproveOperationFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.account, fact.currency); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := isValidProposalID(fact.proposal); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact ApproveOperationFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ApproveOperationFact) Account() base.Address {
+	return fact.account
+}
+
+func (fact ApproveOperationFact) Proposal() string {
+	return fact.proposal
+}
+
+func (fact ApproveOperationFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact ApproveOperationFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.account}, nil
+}
+
+func (fact ApproveOperationFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact ApproveOperationFact) FeePayer() base.Address {
+	return fact.account
+}
+
+func (fact ApproveOperationFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeProposal] = []string{currency.ProposalStateKey(fact.account, fact.proposal)}
+
+	return r, nil
+}
+
+// ApproveOperation is signed by the keys of account, which approve the
+// proposal, so it is not extended operation.
+type ApproveOperation struct {
+	common.BaseOperation
+}
+
+func NewApproveOperation(fact ApproveOperationFact) (ApproveOperation, error) {
+	return ApproveOperation{BaseOperation: common.NewBaseOperation(ApproveOperationHint, fact)}, nil
+}
+
+// ProposedOperation carries the fact of the approved proposal under the hint
+// of its operation, so the fact is processed by the processor of the
+// operation. It is made by the approval, which passes the threshold, and is
+// processed with the authority of account instead of signs; it is never
+// encoded or broadcast.
+type ProposedOperation struct {
+	common.BaseOperation
+}
+
+func NewProposedOperation(ht hint.Hint, fact base.Fact, approval util.Hash) ProposedOperation {
+	op := ProposedOperation{BaseOperation: common.NewBaseOperation(ht, fact)}
+	op.SetHash(valuehash.NewSHA256(util.ConcatByters(approval, fact.Hash())))
+
+	return op
+}
+
+func (op ProposedOperation) IsValid(networkID []byte) error {
+	if err := util.CheckIsValiders(networkID, false, op.Hash(), op.Fact()); err != nil {
+		return common.ErrOperationInvalid.Wrap(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package currency
+
+import (
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+func (fact *ApproveOperationFact) unpack(enc encoder.Encoder, ac, proposal, cid string) error {
+	switch ad, err := base.DecodeAddress(ac, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.account = ad
+	}
+
+	fact.proposal = proposal
+	fact.currency = types.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package currency
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+type ApproveOperationFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Account  base.Address     `json:"account"`
+	Proposal string           `json:"proposal"`
+	Currency types.CurrencyID `json:"currency"`
+}
+
+func (fact ApproveOperationFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ApproveOperationFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Account:               fact.account,
+		Proposal:              fact.proposal,
+		Currency:              fact.currency,
+	})
+}
+
+type ApproveOperationFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Account  string `json:"account"`
+	Proposal string `json:"proposal"`
+	Currency string `json:"currency"`
+}
+
+func (fact *ApproveOperationFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf ApproveOperationFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, uf.Account, uf.Proposal, uf.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op ApproveOperation) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(op.BaseOperation)
+}
+
+func (op *ApproveOperation) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var approveOperationProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ApproveOperationProcessor)
+	},
+}
+
+func (ApproveOperation) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type ApproveOperationProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewApproveOperationProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("create new ApproveOperationProcessor")
+
+		nopp := approveOperationProcessorPool.Get()
+		opp, ok := nopp.(*ApproveOperationProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected %T, not %T", &ApproveOperationProcessor{}, nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *ApproveOperationProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(ApproveOperationFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", ApproveOperationFact{}, op.Fact())), nil
+	}
+
+	v, err := loadPendingProposal(fact.Account(), fact.Proposal(), getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	if v.Expiry <= opp.Height() {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("proposal, %v expired at %v", fact.Proposal(), v.Expiry)), nil
+	}
+
+	if _, rerr := loadAccountKeysOfSigners(fact.Account(), op.Signs(), getStateFunc); rerr != nil {
+		return ctx, rerr, nil
+	}
+
+	for i := range op.Signs() {
+		if signer := op.Signs()[i].Signer(); v.Approved(signer) {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+					Errorf("signer, %v already approved proposal, %v", signer, fact.Proposal())), nil
+		}
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ApproveOperationProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(ApproveOperationFact)
+
+	v, err := loadPendingProposal(fact.Account(), fact.Proposal(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	keys, rerr := loadAccountKeysOfSigners(fact.Account(), op.Signs(), getStateFunc)
+	if rerr != nil {
+		return nil, rerr, nil
+	}
+
+	v = v.Approve(signersOf(op.Signs())...)
+
+	// NOTE the proposed fact is processed by OperationProcessor with the
+	// approval, which passes the threshold.
+	if isPassedProposal(v, keys) {
+		v.Status = currency.ProposalExecuted
+	}
+
+	return []base.StateMergeValue{
+		state.NewStateMergeValue(currency.ProposalStateKey(fact.Account(), fact.Proposal()), v),
+	}, nil, nil
+}
+
+func (opp *ApproveOperationProcessor) Close() error {
+	approveOperationProcessorPool.Put(opp)
+
+	return nil
+}
+
+// ApprovedOperation returns the proposed operation when op is the approval,
+// which passes the threshold of account keys. The returned operation should
+// be processed with op without signs check.
+func ApprovedOperation(op base.Operation, getStateFunc base.GetStateFunc) (base.Operation, bool, error) {
+	fact, ok := op.Fact().(ApproveOperationFact)
+	if !ok {
+		return nil, false, nil
+	}
+
+	v, err := loadPendingProposal(fact.Account(), fact.Proposal(), getStateFunc)
+	if err != nil {
+		return nil, false, err
+	}
+
+	keys, rerr := loadAccountKeysOfSigners(fact.Account(), op.Signs(), getStateFunc)
+	if rerr != nil {
+		return nil, false, rerr
+	}
+
+	if !isPassedProposal(v.Approve(signersOf(op.Signs())...), keys) {
+		return nil, false, nil
+	}
+
+	ht, err := ProposableOperationHint(v.Fact)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return NewProposedOperation(ht, v.Fact, op.Hash()), true, nil
+}
+
+// isPassedProposal checks the approvals of proposal pass the threshold of
+// keys; the approvals of the keys, which are removed after approval, are not
+// counted.
+func isPassedProposal(v currency.ProposalStateValue, keys types.AccountKeys) bool {
+	var approvals []base.Publickey
+
+	for i := range v.Approvals {
+		if _, found := keys.Key(v.Approvals[i]); found {
+			approvals = append(approvals, v.Approvals[i])
+		}
+	}
+
+	return types.CheckSignersThreshold(approvals, keys) == nil
+}
+
+func loadPendingProposal(
+	account base.Address, id string, getStateFunc base.GetStateFunc,
+) (currency.ProposalStateValue, error) {
+	st, err := state.ExistsState(currency.ProposalStateKey(account, id), "proposal", getStateFunc)
+	if err != nil {
+		return currency.ProposalStateValue{}, common.ErrStateNF.Wrap(err)
+	}
+
+	v, err := currency.StateProposalValue(st)
+	if err != nil {
+		return currency.ProposalStateValue{}, common.ErrStateValInvalid.Wrap(err)
+	}
+
+	if v.Status != currency.ProposalPending {
+		return currency.ProposalStateValue{}, common.ErrValueInvalid.Wrap(
+			errors.Errorf("proposal, %v of account, %v is already %v", id, account, v.Status))
+	}
+
+	return v, nil
+}
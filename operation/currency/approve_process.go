@@ -0,0 +1,115 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var approveProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ApproveProcessor)
+	},
+}
+
+func (Approve) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type ApproveProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewApproveProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("create new ApproveProcessor")
+
+		nopp := approveProcessorPool.Get()
+		opp, ok := nopp.(*ApproveProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected %T, not %T", &ApproveProcessor{}, nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *ApproveProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(ApproveFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", ApproveFact{}, op.Fact())), nil
+	}
+
+	if fact.Expiry() > 0 && fact.Expiry() <= opp.Height() {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("expiry, %v should be over current height, %v", fact.Expiry(), opp.Height())), nil
+	}
+
+	if _, _, aErr, cErr := state.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).Errorf("%v", cErr)), nil
+	}
+
+	if _, err := state.ExistsAccount(fact.Spender(), "spender", true, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	if err := state.CheckExistsState(currency.DesignStateKey(fact.Amount().Currency()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id, %v", fact.Amount().Currency())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ApproveProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, _ base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(ApproveFact)
+
+	return []base.StateMergeValue{
+		state.NewStateMergeValue(
+			currency.AllowanceStateKey(fact.Sender(), fact.Spender(), fact.Amount().Currency()),
+			currency.NewAllowanceStateValue(fact.Sender(), fact.Spender(), fact.Amount(), fact.Expiry()),
+		),
+	}, nil, nil
+}
+
+func (opp *ApproveProcessor) Close() error {
+	approveProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,228 @@
+package currency_test
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/currency"
+	operationtest "github.com/imfact-labs/currency-model/operation/test"
+	ccstate "github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+)
+
+type testAllowance struct {
+	tp          *operationtest.TestProcessor
+	owner       base.Address
+	ownerPriv   base.Privatekey
+	spender     base.Address
+	spenderPriv base.Privatekey
+	receiver    base.Address
+}
+
+// newTestAllowance approves 100 of owner, which has balance 1000, to spender
+// until height 20.
+func newTestAllowance(t *testing.T) testAllowance {
+	t.Helper()
+
+	tp := newTestProcessor(t, nilFeePolicy())
+
+	a := testAllowance{tp: tp}
+	a.owner, _, a.ownerPriv = tp.NewTestAccountState(tp.NewPrivateKey("owner-allowance"), true)
+	tp.NewTestBalanceState(a.owner, tp.GenesisCurrency, 1000, true)
+	a.spender, _, a.spenderPriv = tp.NewTestAccountState(tp.NewPrivateKey("spender-allowance"), true)
+	a.receiver, _, _ = tp.NewTestAccountState(tp.NewPrivateKey("receiver-allowance"), true)
+
+	_, reason, err := tp.ProcessAt(currency.NewApproveProcessor(), base.Height(10), a.approve(t, "approve", a.spender, 100, 20))
+	requireNoReason(t, reason, err)
+
+	return a
+}
+
+func (a testAllowance) approve(t *testing.T, token string, spender base.Address, n int64, expiry base.Height) currency.Approve {
+	t.Helper()
+
+	op, err := currency.NewApprove(currency.NewApproveFact(
+		[]byte(token), a.owner, spender, amount(a.tp, n), expiry, a.tp.GenesisCurrency))
+	if err != nil {
+		t.Fatalf("new approve: %v", err)
+	}
+
+	sign(t, a.tp, &op, a.ownerPriv)
+
+	return op
+}
+
+func (a testAllowance) transferFrom(
+	t *testing.T, token string, sender base.Address, priv base.Privatekey, n int64,
+) currency.TransferFrom {
+	t.Helper()
+
+	op, err := currency.NewTransferFrom(currency.NewTransferFromFact(
+		[]byte(token), sender, a.owner, a.receiver, amount(a.tp, n), a.tp.GenesisCurrency))
+	if err != nil {
+		t.Fatalf("new transfer from: %v", err)
+	}
+
+	sign(t, a.tp, &op, priv)
+
+	return op
+}
+
+func (a testAllowance) allowance(t *testing.T) ccstate.AllowanceStateValue {
+	t.Helper()
+
+	st, found, err := a.tp.GetStateFunc(ccstate.AllowanceStateKey(a.owner, a.spender, a.tp.GenesisCurrency))
+	if err != nil || !found {
+		t.Fatalf("expected allowance state, %v", err)
+	}
+
+	return st.Value().(ccstate.AllowanceStateValue)
+}
+
+func TestApproveValidation(t *testing.T) {
+	a := newTestAllowance(t)
+
+	if err := a.approve(t, "approve-self", a.owner, 100, 20).IsValid(a.tp.NetworkID); err == nil {
+		t.Fatal("expected approve to self invalid")
+	}
+
+	if err := a.transferFrom(t, "transfer-from-self", a.owner, a.ownerPriv, 10).IsValid(a.tp.NetworkID); err == nil {
+		t.Fatal("expected transfer from by owner invalid")
+	}
+
+	a.receiver = a.owner
+
+	if err := a.transferFrom(t, "transfer-from-to-owner", a.spender, a.spenderPriv, 10).IsValid(a.tp.NetworkID); err == nil {
+		t.Fatal("expected transfer from to owner invalid")
+	}
+}
+
+func TestApproveRejections(t *testing.T) {
+	a := newTestAllowance(t)
+
+	reason, err := a.tp.PreProcessAt(currency.NewApproveProcessor(), base.Height(20), a.approve(t, "expired", a.spender, 100, 20))
+	requireReason(t, reason, err, "should be over current height")
+
+	unknown, _, _ := a.tp.NewTestAccountState(a.tp.NewPrivateKey("unknown-spender"), false)
+
+	reason, err = a.tp.PreProcessAt(currency.NewApproveProcessor(), base.Height(10), a.approve(t, "unknown-spender", unknown, 100, 20))
+	requireReason(t, reason, err, "spender")
+
+	op, err := currency.NewApprove(currency.NewApproveFact([]byte("unknown-currency"), a.owner, a.spender,
+		types.NewAmount(common.NewBig(100), types.CurrencyID("XYZ")), 20, a.tp.GenesisCurrency))
+	if err != nil {
+		t.Fatalf("new approve: %v", err)
+	}
+
+	sign(t, a.tp, &op, a.ownerPriv)
+
+	reason, err = a.tp.PreProcessAt(currency.NewApproveProcessor(), base.Height(10), op)
+	requireReason(t, reason, err, "currency id")
+
+	a.owner, a.ownerPriv = a.tp.NewTestContractAccountState(a.tp.GenesisAddr, a.tp.NewPrivateKey("contract-allowance"), true)
+
+	reason, err = a.tp.PreProcessAt(currency.NewApproveProcessor(), base.Height(10), a.approve(t, "contract-owner", a.spender, 100, 20))
+	requireReason(t, reason, err, "Contract account not allowed")
+}
+
+func TestTransferFrom(t *testing.T) {
+	a := newTestAllowance(t)
+
+	reason, err := a.tp.PreProcessAt(currency.NewTransferFromProcessor(), base.Height(10),
+		a.transferFrom(t, "over-allowance", a.spender, a.spenderPriv, 150))
+	requireReason(t, reason, err, "over allowance")
+
+	stranger, _, strangerPriv := a.tp.NewTestAccountState(a.tp.NewPrivateKey("stranger-allowance"), true)
+
+	reason, err = a.tp.PreProcessAt(currency.NewTransferFromProcessor(), base.Height(10),
+		a.transferFrom(t, "without-allowance", stranger, strangerPriv, 10))
+	requireReason(t, reason, err, "not found")
+
+	reason, err = a.tp.PreProcessAt(currency.NewTransferFromProcessor(), base.Height(20),
+		a.transferFrom(t, "after-expiry", a.spender, a.spenderPriv, 10))
+	requireReason(t, reason, err, "expired")
+
+	_, reason, err = a.tp.ProcessAt(currency.NewTransferFromProcessor(), base.Height(10),
+		a.transferFrom(t, "transfer-from", a.spender, a.spenderPriv, 60))
+	requireNoReason(t, reason, err)
+
+	switch {
+	case !a.tp.Balance(a.owner, a.tp.GenesisCurrency).Equal(common.NewBig(940)):
+		t.Fatalf("expected owner balance 940, not %v", a.tp.Balance(a.owner, a.tp.GenesisCurrency))
+	case !a.tp.Balance(a.receiver, a.tp.GenesisCurrency).Equal(common.NewBig(60)):
+		t.Fatalf("expected receiver balance 60, not %v", a.tp.Balance(a.receiver, a.tp.GenesisCurrency))
+	case !a.allowance(t).Amount.Big().Equal(common.NewBig(40)):
+		t.Fatalf("expected allowance 40 left, not %v", a.allowance(t).Amount.Big())
+	}
+
+	// NOTE zero amount revokes the allowance.
+	_, reason, err = a.tp.ProcessAt(currency.NewApproveProcessor(), base.Height(11), a.approve(t, "revoke", a.spender, 0, 20))
+	requireNoReason(t, reason, err)
+
+	reason, err = a.tp.PreProcessAt(currency.NewTransferFromProcessor(), base.Height(11),
+		a.transferFrom(t, "after-revoke", a.spender, a.spenderPriv, 10))
+	requireReason(t, reason, err, "over allowance")
+}
+
+func TestTransferFromRejections(t *testing.T) {
+	a := newTestAllowance(t)
+
+	// NOTE the allowance can be over the balance of owner.
+	a.tp.NewTestBalanceState(a.owner, a.tp.GenesisCurrency, 50, true)
+
+	reason, err := a.tp.PreProcessAt(currency.NewTransferFromProcessor(), base.Height(10),
+		a.transferFrom(t, "insufficient", a.spender, a.spenderPriv, 60))
+	requireReason(t, reason, err, "insufficient balance")
+
+	setBalanceStatus(a.tp, a.receiver, a.owner, types.DepositBlocked)
+
+	reason, err = a.tp.PreProcessAt(currency.NewTransferFromProcessor(), base.Height(10),
+		a.transferFrom(t, "deposit-blocked", a.spender, a.spenderPriv, 10))
+	requireReason(t, reason, err, "not allowed to deposit")
+
+	a.tp.SetState(common.NewBaseState(base.Height(1), ccstate.FrozenStateKey(a.owner, a.tp.GenesisCurrency),
+		ccstate.NewFrozenStateValue(true), nil, []util.Hash{}), true)
+
+	reason, err = a.tp.PreProcessAt(currency.NewTransferFromProcessor(), base.Height(10),
+		a.transferFrom(t, "frozen-owner", a.spender, a.spenderPriv, 10))
+	requireReason(t, reason, err, string(common.ErrMAccountFrozen))
+}
+
+func TestAllowanceRoundTrip(t *testing.T) {
+	a := newTestAllowance(t)
+
+	facts := []base.Fact{
+		a.approve(t, "approve-round-trip", a.spender, 100, 20).Fact(),
+		a.approve(t, "approve-without-expiry", a.spender, 100, 0).Fact(),
+		a.transferFrom(t, "transfer-from-round-trip", a.spender, a.spenderPriv, 10).Fact(),
+	}
+
+	for i := range facts {
+		j, b := roundTrip(t, facts[i])
+
+		for _, got := range []base.Fact{j, b} {
+			if err := got.IsValid(nil); err != nil {
+				t.Fatalf("invalid decoded %T: %v", got, err)
+			}
+
+			if !got.Hash().Equal(facts[i].Hash()) {
+				t.Fatalf("decoded %T not matched", got)
+			}
+		}
+	}
+
+	v := a.allowance(t)
+
+	j, b := roundTrip(t, v)
+	for _, got := range []ccstate.AllowanceStateValue{j, b} {
+		if err := got.IsValid(nil); err != nil {
+			t.Fatalf("invalid decoded allowance: %v", err)
+		}
+
+		if !got.Amount.Equal(v.Amount) || got.Expiry != v.Expiry || !got.Spender.Equal(v.Spender) {
+			t.Fatalf("decoded allowance not matched, %+v", got)
+		}
+	}
+}
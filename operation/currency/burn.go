@@ -0,0 +1,167 @@
+package currency
+
+import (
+	"fmt"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	BurnFactHint = hint.MustNewHint("mitum-currency-burn-operation-fact-v0.0.1")
+	BurnHint     = hint.MustNewHint("mitum-currency-burn-operation-v0.0.1")
+)
+
+// BurnFact removes amount from the balance of target and from the total
+// supply of currency. The target is sender itself or any account, when sender
+// is the genesis account of currency.
+type BurnFact struct {
+	base.BaseFact
+	sender   base.Address
+	target   base.Address
+	amount   types.Amount
+	currency types.CurrencyID
+}
+
+func NewBurnFact(
+	token []byte,
+	sender base.Address,
+	target base.Address,
+	amount types.Amount,
+	currency types.CurrencyID,
+) BurnFact {
+	fact := BurnFact{
+		BaseFact: base.NewBaseFact(BurnFactHint, token),
+		sender:   sender,
+		target:   target,
+		amount:   amount,
+		currency: currency,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact BurnFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact BurnFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact BurnFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.target.Bytes(),
+		fact.amount.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact BurnFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.target, fact.amount, fact.currency); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if !fact.amount.Big().OverZero() {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("Under zero amount of Burn")))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact BurnFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact BurnFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact BurnFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact BurnFact) Target() base.Address {
+	return fact.target
+}
+
+func (fact BurnFact) Amount() types.Amount {
+	return fact.amount
+}
+
+func (fact BurnFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact BurnFact) Addresses() ([]base.Address, error) {
+	if fact.sender.Equal(fact.target) {
+		return []base.Address{fact.sender}, nil
+	}
+
+	return []base.Address{fact.target, fact.sender}, nil
+}
+
+func (fact BurnFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact BurnFact) FeeAmounts() []common.Big {
+	if fact.amount.Currency() != fact.currency {
+		return []common.Big{common.ZeroBig}
+	}
+
+	return []common.Big{fact.amount.Big()}
+}
+
+func (fact BurnFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact BurnFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact BurnFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fmt.Sprintf("%s:%s", fact.sender.String(), fact.currency.String())}
+
+	return r, nil
+}
+
+type Burn struct {
+	extras.ExtendedOperation
+}
+
+func (op Burn) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewBurn(fact BurnFact) (Burn, error) {
+	return Burn{
+		ExtendedOperation: extras.NewExtendedOperation(BurnHint, fact),
+	}, nil
+}
@@ -0,0 +1,171 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var burnProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(BurnProcessor)
+	},
+}
+
+func (Burn) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type BurnProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewBurnProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("create new BurnProcessor")
+
+		nopp := burnProcessorPool.Get()
+		opp, ok := nopp.(*BurnProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected %T, not %T", &BurnProcessor{}, nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *BurnProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(BurnFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMTypeMismatch).Errorf("expected %T, not %T", BurnFact{}, op.Fact())), nil
+	}
+
+	if _, _, aErr, cErr := state.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).Errorf("%v", cErr)), nil
+	}
+
+	cid := fact.Amount().Currency()
+
+	if err := state.CheckAccountNotFrozen(fact.Sender(), []types.CurrencyID{cid}, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	st, err := state.ExistsState(currency.DesignStateKey(cid), "currency design", getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("%v", err)), nil
+	}
+
+	de, err := currency.GetDesignFromState(st)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).Errorf("%v", err)), nil
+	}
+
+	if !fact.Sender().Equal(fact.Target()) {
+		if ga := de.GenesisAccount(); ga == nil || !ga.Equal(fact.Sender()) {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+					Errorf("sender %v is neither target nor genesis account of currency, %v", fact.Sender(), cid)), nil
+		}
+
+		if _, _, aErr, cErr := state.ExistsCAccount(fact.Target(), "target", true, false, getStateFunc); aErr != nil {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Errorf("%v", aErr)), nil
+		} else if cErr != nil {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).Errorf("%v", cErr)), nil
+		}
+	}
+
+	bst, err := state.ExistsState(currency.BalanceStateKey(fact.Target(), cid), "target balance", getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateNF).Errorf("%v", err)), nil
+	}
+
+	balance, err := currency.StateBalanceValue(bst)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).Errorf("%v", err)), nil
+	}
+
+	if balance.Big().Compare(fact.Amount().Big()) < 0 {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("insufficient balance of currency, %v of target, %v", cid, fact.Target())), nil
+	}
+
+	if de.TotalSupply().Compare(fact.Amount().Big()) <= 0 {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValOOR).
+				Errorf("amount to burn, %v over total supply of currency, %v", fact.Amount().Big(), cid)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *BurnProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, _ base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(BurnFact)
+
+	am := fact.Amount()
+	bk := currency.BalanceStateKey(fact.Target(), am.Currency())
+	dk := currency.DesignStateKey(am.Currency())
+
+	return []base.StateMergeValue{
+		common.NewBaseStateMergeValue(
+			bk,
+			currency.NewDeductBalanceStateValue(am),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return currency.NewBalanceStateValueMerger(height, bk, am.Currency(), st)
+			},
+		),
+		common.NewBaseStateMergeValue(
+			dk,
+			currency.NewBurnTotalSupplyStateValue(am),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return currency.NewDesignStateValueMerger(height, dk, st)
+			},
+		),
+	}, nil, nil
+}
+
+func (opp *BurnProcessor) Close() error {
+	burnProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package currency_test
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/currency"
+	operationtest "github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+func newTestBurn(
+	t *testing.T, tp *operationtest.TestProcessor, token string,
+	sender, target base.Address, priv base.Privatekey, n int64,
+) currency.Burn {
+	t.Helper()
+
+	op, err := currency.NewBurn(currency.NewBurnFact([]byte(token), sender, target, amount(tp, n), tp.GenesisCurrency))
+	if err != nil {
+		t.Fatalf("new burn: %v", err)
+	}
+
+	sign(t, tp, &op, priv)
+
+	return op
+}
+
+func TestBurn(t *testing.T) {
+	tp := newTestProcessor(t, nilFeePolicy())
+
+	holder, _, priv := tp.NewTestAccountState(tp.NewPrivateKey("holder-burn"), true)
+	tp.NewTestBalanceState(holder, tp.GenesisCurrency, 1000, true)
+
+	_, reason, err := tp.ProcessAt(currency.NewBurnProcessor(), base.Height(10),
+		newTestBurn(t, tp, "burn-holder", holder, holder, priv, 300))
+	requireNoReason(t, reason, err)
+
+	// NOTE genesis account of currency can burn the balance of others
+	_, reason, err = tp.ProcessAt(currency.NewBurnProcessor(), base.Height(10),
+		newTestBurn(t, tp, "burn-genesis", tp.GenesisAddr, holder, tp.GenesisPriv, 200))
+	requireNoReason(t, reason, err)
+
+	if b := tp.Balance(holder, tp.GenesisCurrency); !b.Equal(common.NewBig(500)) {
+		t.Fatalf("expected balance 500 after burn, not %v", b)
+	}
+
+	if ts := currencyDesign(t, tp, tp.GenesisCurrency).TotalSupply(); !ts.Equal(common.NewBig(99500)) {
+		t.Fatalf("expected total supply 99500 after burn, not %v", ts)
+	}
+}
+
+func TestBurnRejections(t *testing.T) {
+	tp := newTestProcessor(t, nilFeePolicy())
+
+	holder, _, holderPriv := tp.NewTestAccountState(tp.NewPrivateKey("holder-burn-rejections"), true)
+	tp.NewTestBalanceState(holder, tp.GenesisCurrency, 1000, true)
+	other, _, otherPriv := tp.NewTestAccountState(tp.NewPrivateKey("other-burn-rejections"), true)
+	tp.NewTestBalanceState(other, tp.GenesisCurrency, 1000, true)
+
+	reason, err := tp.PreProcessAt(currency.NewBurnProcessor(), base.Height(10),
+		newTestBurn(t, tp, "burn-other", other, holder, otherPriv, 300))
+	requireReason(t, reason, err, string(common.ErrMAccountNAth))
+
+	reason, err = tp.PreProcessAt(currency.NewBurnProcessor(), base.Height(10),
+		newTestBurn(t, tp, "burn-over-balance", holder, holder, holderPriv, 1001))
+	requireReason(t, reason, err, "insufficient balance")
+
+	// NOTE total supply can not be burned to zero
+	tp.NewTestBalanceState(holder, tp.GenesisCurrency, 200000, true)
+
+	reason, err = tp.PreProcessAt(currency.NewBurnProcessor(), base.Height(10),
+		newTestBurn(t, tp, "burn-total-supply", holder, holder, holderPriv, 100000))
+	requireReason(t, reason, err, "over total supply")
+
+	unknown, _, unknownPriv := tp.NewTestAccountState(tp.NewPrivateKey("unknown-burn-rejections"), false)
+
+	reason, err = tp.PreProcessAt(currency.NewBurnProcessor(), base.Height(10),
+		newTestBurn(t, tp, "burn-unknown", unknown, unknown, unknownPriv, 1))
+	requireReason(t, reason, err, "sender")
+
+	if err := newTestBurn(t, tp, "burn-zero", holder, holder, holderPriv, 0).IsValid(tp.NetworkID); err == nil {
+		t.Fatal("expected burn of zero amount invalid")
+	}
+}
+
+func TestBurnFactRoundTrip(t *testing.T) {
+	tp := newTestProcessor(t, nilFeePolicy())
+
+	holder, _, priv := tp.NewTestAccountState(tp.NewPrivateKey("holder-burn-round-trip"), true)
+	fact := newTestBurn(t, tp, "burn-round-trip", holder, holder, priv, 300).Fact()
+
+	j, b := roundTrip(t, fact)
+	for _, got := range []base.Fact{j, b} {
+		if err := got.IsValid(nil); err != nil {
+			t.Fatalf("invalid decoded %T: %v", got, err)
+		}
+
+		if !got.Hash().Equal(fact.Hash()) {
+			t.Fatalf("decoded %T not matched", got)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package currency
+
+import (
+	"fmt"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+)
+
+var (
+	CancelRecoveryFactHint = hint.MustNewHint("mitum-currency-cancel-recovery-operation-fact-v0.0.1")
+	CancelRecoveryHint     = hint.MustNewHint("mitum-currency-cancel-recovery-operation-v0.0.1")
+)
+
+// CancelRecoveryFact cancels the pending recovery of sender; it is signed by
+// the current keys of sender.
+type CancelRecoveryFact struct {
+	base.BaseFact
+	sender   base.Address
+	currency types.CurrencyID
+}
+
+func NewCancelRecoveryFact(token []byte, sender base.Address, currency types.CurrencyID) CancelRecoveryFact {
+	fact := CancelRecoveryFact{
+		BaseFact: base.NewBaseFact(CancelRecoveryFactHint, token),
+		sender:   sender,
+		currency: currency,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact CancelRecoveryFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact CancelRecoveryFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CancelRecoveryFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact CancelRecoveryFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.currency); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact CancelRecoveryFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact CancelRecoveryFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact CancelRecoveryFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact CancelRecoveryFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact CancelRecoveryFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+func (fact CancelRecoveryFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact CancelRecoveryFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact CancelRecoveryFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact CancelRecoveryFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fmt.Sprintf("%s:%s", fact.sender.String(), fact.currency.String())}
+	r[extras.DuplicationKeyTypeRecovery] = []string{currency.RecoveryStateKey(fact.sender)}
+
+	return r, nil
+}
+
+type CancelRecovery struct {
+	extras.ExtendedOperation
+}
+
+func (op CancelRecovery) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewCancelRecovery(fact CancelRecoveryFact) (CancelRecovery, error) {
+	return CancelRecovery{
+		ExtendedOperation: extras.NewExtendedOperation(CancelRecoveryHint, fact),
+	}, nil
+}
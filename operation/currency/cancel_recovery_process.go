@@ -0,0 +1,106 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var cancelRecoveryProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(CancelRecoveryProcessor)
+	},
+}
+
+func (CancelRecovery) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type CancelRecoveryProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewCancelRecoveryProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("create new CancelRecoveryProcessor")
+
+		nopp := cancelRecoveryProcessorPool.Get()
+		opp, ok := nopp.(*CancelRecoveryProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected %T, not %T", &CancelRecoveryProcessor{}, nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *CancelRecoveryProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(CancelRecoveryFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", CancelRecoveryFact{}, op.Fact())), nil
+	}
+
+	if _, err := state.ExistsAccount(fact.Sender(), "sender", true, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	if _, err := loadPendingRecovery(fact.Sender(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *CancelRecoveryProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(CancelRecoveryFact)
+
+	v, err := loadPendingRecovery(fact.Sender(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	v.Status = currency.RecoveryCancelled
+
+	return []base.StateMergeValue{
+		state.NewStateMergeValue(currency.RecoveryStateKey(fact.Sender()), v),
+	}, nil, nil
+}
+
+func (opp *CancelRecoveryProcessor) Close() error {
+	cancelRecoveryProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package currency
+
+import (
+	"fmt"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+)
+
+var (
+	CancelScheduleFactHint = hint.MustNewHint("mitum-currency-cancel-schedule-operation-fact-v0.0.1")
+	CancelScheduleHint     = hint.MustNewHint("mitum-currency-cancel-schedule-operation-v0.0.1")
+)
+
+// CancelScheduleFact cancels the active schedule of sender; schedule is the id
+// of the schedule, the fact hash of ScheduleTransferFact.
+type CancelScheduleFact struct {
+	base.BaseFact
+	sender   base.Address
+	schedule string
+	currency types.CurrencyID
+}
+
+func NewCancelScheduleFact(
+	token []byte, sender base.Address, schedule string, currency types.CurrencyID,
+) CancelScheduleFact {
+	fact := CancelScheduleFact{
+		BaseFact: base.NewBaseFact(CancelScheduleFactHint, token),
+		sender:   sender,
+		schedule: schedule,
+		currency: currency,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact CancelScheduleFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact CancelScheduleFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CancelScheduleFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		[]byte(fact.schedule),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact CancelScheduleFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.currency); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := isValidScheduleID(fact.schedule); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact CancelScheduleFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact CancelScheduleFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact CancelScheduleFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact CancelScheduleFact) Schedule() string {
+	return fact.schedule
+}
+
+func (fact CancelScheduleFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact CancelScheduleFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+func (fact CancelScheduleFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact CancelScheduleFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact CancelScheduleFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact CancelScheduleFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fmt.Sprintf("%s:%s", fact.sender.String(), fact.currency.String())}
+	r[extras.DuplicationKeyTypeSchedule] = []string{currency.ScheduleStateKey(fact.sender, fact.schedule)}
+
+	return r, nil
+}
+
+type CancelSchedule struct {
+	extras.ExtendedOperation
+}
+
+func (op CancelSchedule) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewCancelSchedule(fact CancelScheduleFact) (CancelSchedule, error) {
+	return CancelSchedule{
+		ExtendedOperation: extras.NewExtendedOperation(CancelScheduleHint, fact),
+	}, nil
+}
@@ -0,0 +1,21 @@
+package currency
+
+import (
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+func (fact *CancelScheduleFact) unpack(enc encoder.Encoder, sd, schedule, cid string) error {
+	switch ad, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.sender = ad
+	}
+
+	fact.schedule = schedule
+	fact.currency = types.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var cancelScheduleProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(CancelScheduleProcessor)
+	},
+}
+
+func (CancelSchedule) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type CancelScheduleProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewCancelScheduleProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("create new CancelScheduleProcessor")
+
+		nopp := cancelScheduleProcessorPool.Get()
+		opp, ok := nopp.(*CancelScheduleProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected %T, not %T", &CancelScheduleProcessor{}, nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *CancelScheduleProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(CancelScheduleFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", CancelScheduleFact{}, op.Fact())), nil
+	}
+
+	if _, err := state.ExistsAccount(fact.Sender(), "sender", true, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	if _, rerr := loadActiveSchedule(fact.Sender(), fact.Schedule(), getStateFunc); rerr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", rerr)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *CancelScheduleProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, _ base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(CancelScheduleFact)
+
+	// NOTE the queued runs of cancelled schedule are ignored when they are due.
+	return []base.StateMergeValue{
+		newScheduleStateMergeValue(
+			currency.ScheduleStateKey(fact.Sender(), fact.Schedule()),
+			currency.NewCancelScheduleStateValue(),
+		),
+	}, nil, nil
+}
+
+func (opp *CancelScheduleProcessor) Close() error {
+	cancelScheduleProcessorPool.Put(opp)
+
+	return nil
+}
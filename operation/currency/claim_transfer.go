@@ -0,0 +1,177 @@
+package currency
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	ClaimTransferFactHint = hint.MustNewHint("mitum-currency-claim-transfer-operation-fact-v0.0.1")
+	ClaimTransferHint     = hint.MustNewHint("mitum-currency-claim-transfer-operation-v0.0.1")
+)
+
+const MaxTransferPreimageSize = 64
+
+// ClaimTransferFact moves the amount of transfer lock, which was locked by
+// locker under hashlock, to the balance of sender, the receiver of lock.
+// preimage is the hex encoded value, which is hashed into hashlock.
+type ClaimTransferFact struct {
+	base.BaseFact
+	sender   base.Address
+	locker   base.Address
+	hashlock string
+	preimage string
+	currency types.CurrencyID
+}
+
+func NewClaimTransferFact(
+	token []byte,
+	sender base.Address,
+	locker base.Address,
+	hashlock string,
+	preimage string,
+	currency types.CurrencyID,
+) ClaimTransferFact {
+	fact := ClaimTransferFact{
+		BaseFact: base.NewBaseFact(ClaimTransferFactHint, token),
+		sender:   sender,
+		locker:   locker,
+		hashlock: hashlock,
+		preimage: preimage,
+		currency: currency,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ClaimTransferFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ClaimTransferFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ClaimTransferFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.locker.Bytes(),
+		[]byte(fact.hashlock),
+		[]byte(fact.preimage),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact ClaimTransferFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.locker, fact.currency); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := isValidTransferHashlock(fact.hashlock); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	switch p, err := hex.DecodeString(fact.preimage); {
+	case err != nil:
+		return common.ErrFactInvalid.Wrap(
+			common.ErrValueInvalid.Wrap(errors.Errorf("invalid preimage, %q: %v", fact.preimage, err)))
+	case len(p) < 1 || len(p) > MaxTransferPreimageSize:
+		return common.ErrFactInvalid.Wrap(
+			common.ErrValOOR.Wrap(errors.Errorf("preimage size should be 1 to %d bytes", MaxTransferPreimageSize)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact ClaimTransferFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ClaimTransferFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ClaimTransferFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact ClaimTransferFact) Locker() base.Address {
+	return fact.locker
+}
+
+func (fact ClaimTransferFact) Hashlock() string {
+	return fact.hashlock
+}
+
+func (fact ClaimTransferFact) Preimage() string {
+	return fact.preimage
+}
+
+func (fact ClaimTransferFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact ClaimTransferFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.locker}, nil
+}
+
+func (fact ClaimTransferFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact ClaimTransferFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact ClaimTransferFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact ClaimTransferFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fmt.Sprintf("%s:%s", fact.sender.String(), fact.currency.String())}
+	r[extras.DuplicationKeyTypeTransferLock] = []string{currency.TransferLockStateKey(fact.locker, fact.hashlock)}
+
+	return r, nil
+}
+
+type ClaimTransfer struct {
+	extras.ExtendedOperation
+}
+
+func (op ClaimTransfer) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewClaimTransfer(fact ClaimTransferFact) (ClaimTransfer, error) {
+	return ClaimTransfer{
+		ExtendedOperation: extras.NewExtendedOperation(ClaimTransferHint, fact),
+	}, nil
+}
@@ -0,0 +1,29 @@
+package currency
+
+import (
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+func (fact *ClaimTransferFact) unpack(enc encoder.Encoder, sd, lk, hashlock, preimage, cid string) error {
+	switch ad, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.sender = ad
+	}
+
+	switch ad, err := base.DecodeAddress(lk, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.locker = ad
+	}
+
+	fact.hashlock = hashlock
+	fact.preimage = preimage
+	fact.currency = types.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package currency
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+type ClaimTransferFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address     `json:"sender"`
+	Locker   base.Address     `json:"locker"`
+	Hashlock string           `json:"hashlock"`
+	Preimage string           `json:"preimage"`
+	Currency types.CurrencyID `json:"currency"`
+}
+
+func (fact ClaimTransferFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ClaimTransferFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Locker:                fact.locker,
+		Hashlock:              fact.hashlock,
+		Preimage:              fact.preimage,
+		Currency:              fact.currency,
+	})
+}
+
+type ClaimTransferFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string `json:"sender"`
+	Locker   string `json:"locker"`
+	Hashlock string `json:"hashlock"`
+	Preimage string `json:"preimage"`
+	Currency string `json:"currency"`
+}
+
+func (fact *ClaimTransferFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf ClaimTransferFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, uf.Sender, uf.Locker, uf.Hashlock, uf.Preimage, uf.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op ClaimTransfer) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperationMarshaler{
+		BaseOperationJSONMarshaler:           op.BaseOperation.JSONMarshaler(),
+		BaseOperationExtensionsJSONMarshaler: op.BaseOperationExtensions.JSONMarshaler(),
+	})
+}
+
+func (op *ClaimTransfer) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	var ueo extras.BaseOperationExtensions
+	if err := ueo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperationExtensions = &ueo
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package currency
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/state/extension"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var claimTransferProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ClaimTransferProcessor)
+	},
+}
+
+func (ClaimTransfer) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type ClaimTransferProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewClaimTransferProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("create new ClaimTransferProcessor")
+
+		nopp := claimTransferProcessorPool.Get()
+		opp, ok := nopp.(*ClaimTransferProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected %T, not %T", &ClaimTransferProcessor{}, nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *ClaimTransferProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(ClaimTransferFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", ClaimTransferFact{}, op.Fact())), nil
+	}
+
+	if _, err := state.ExistsAccount(fact.Sender(), "sender", true, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	if err := extension.CheckDepositAllowed(fact.Sender(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	v, rerr := loadOpenTransferLock(fact.Locker(), fact.Hashlock(), getStateFunc)
+	if rerr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", rerr)), nil
+	}
+
+	if !v.Receiver.Equal(fact.Sender()) {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+				Errorf("sender, %v is not receiver of transfer lock, %v", fact.Sender(), v.Receiver)), nil
+	}
+
+	if opp.Height() >= v.Expiry {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("transfer lock expired at height, %v", v.Expiry)), nil
+	}
+
+	preimage, _ := hex.DecodeString(fact.Preimage())
+	if TransferHashlock(preimage) != v.Hashlock {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("preimage does not match with hashlock, %v", v.Hashlock)), nil
+	}
+
+	if err := state.CheckCurrencyNotPaused(v.Amount.Currency(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	totals := NewReceiverTotals()
+	totals.Add(v.Receiver, v.Amount)
+
+	if err := totals.CheckMaxBalance(getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ClaimTransferProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(ClaimTransferFact)
+
+	v, rerr := loadOpenTransferLock(fact.Locker(), fact.Hashlock(), getStateFunc)
+	if rerr != nil {
+		return nil, rerr, nil
+	}
+
+	bk := currency.BalanceStateKey(v.Receiver, v.Amount.Currency())
+
+	return []base.StateMergeValue{
+		common.NewBaseStateMergeValue(
+			bk,
+			currency.NewAddBalanceStateValue(v.Amount),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return currency.NewBalanceStateValueMerger(height, bk, v.Amount.Currency(), st)
+			},
+		),
+		state.NewStateMergeValue(
+			currency.TransferLockStateKey(v.Sender, v.Hashlock),
+			currency.NewTransferLockStateValue(
+				v.Sender, v.Receiver, v.Amount, v.Hashlock, v.Expiry, currency.TransferLockClaimed, fact.Preimage()),
+		),
+	}, nil, nil
+}
+
+func (opp *ClaimTransferProcessor) Close() error {
+	claimTransferProcessorPool.Put(opp)
+
+	return nil
+}
+
+func loadOpenTransferLock(
+	sender base.Address, hashlock string, getStateFunc base.GetStateFunc,
+) (currency.TransferLockStateValue, base.OperationProcessReasonError) {
+	st, err := state.ExistsState(currency.TransferLockStateKey(sender, hashlock), "transfer lock", getStateFunc)
+	if err != nil {
+		return currency.TransferLockStateValue{}, base.NewBaseOperationProcessReasonError(
+			common.ErrMStateNF.Errorf("%v", err))
+	}
+
+	v, err := currency.StateTransferLockValue(st)
+	if err != nil {
+		return currency.TransferLockStateValue{}, base.NewBaseOperationProcessReasonError(
+			common.ErrMStateValInvalid.Errorf("%v", err))
+	}
+
+	if v.Status != currency.TransferLockOpen {
+		return currency.TransferLockStateValue{}, base.NewBaseOperationProcessReasonError(
+			common.ErrMValueInvalid.Errorf("transfer lock of hashlock, %v already %v", hashlock, v.Status))
+	}
+
+	return v, nil
+}
@@ -0,0 +1,110 @@
+package currency
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+)
+
+var (
+	CompleteRecoveryFactHint = hint.MustNewHint("mitum-currency-complete-recovery-operation-fact-v0.0.1")
+	CompleteRecoveryHint     = hint.MustNewHint("mitum-currency-complete-recovery-operation-v0.0.1")
+)
+
+// CompleteRecoveryFact replaces the keys of account by the keys of the pending
+// recovery, whose delay is over.
+type CompleteRecoveryFact struct {
+	base.BaseFact
+	account  base.Address
+	currency types.CurrencyID
+}
+
+func NewCompleteRecoveryFact(token []byte, account base.Address, currency types.CurrencyID) CompleteRecoveryFact {
+	fact := CompleteRecoveryFact{
+		BaseFact: base.NewBaseFact(CompleteRecoveryFactHint, token),
+		account:  account,
+		currency: currency,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact CompleteRecoveryFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact CompleteRecoveryFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CompleteRecoveryFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.account.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact CompleteRecoveryFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.account, fact.currency); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact CompleteRecoveryFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact CompleteRecoveryFact) Account() base.Address {
+	return fact.account
+}
+
+func (fact CompleteRecoveryFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact CompleteRecoveryFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.account}, nil
+}
+
+func (fact CompleteRecoveryFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact CompleteRecoveryFact) FeePayer() base.Address {
+	return fact.account
+}
+
+func (fact CompleteRecoveryFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeRecovery] = []string{currency.RecoveryStateKey(fact.account)}
+
+	return r, nil
+}
+
+// CompleteRecovery is signed by one of the guardians of account, so it is not
+// extended operation.
+type CompleteRecovery struct {
+	common.BaseOperation
+}
+
+func NewCompleteRecovery(fact CompleteRecoveryFact) (CompleteRecovery, error) {
+	return CompleteRecovery{BaseOperation: common.NewBaseOperation(CompleteRecoveryHint, fact)}, nil
+}
@@ -0,0 +1,20 @@
+package currency
+
+import (
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+func (fact *CompleteRecoveryFact) unpack(enc encoder.Encoder, ac, cid string) error {
+	switch ad, err := base.DecodeAddress(ac, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.account = ad
+	}
+
+	fact.currency = types.CurrencyID(cid)
+
+	return nil
+}
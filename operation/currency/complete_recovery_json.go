@@ -0,0 +1,59 @@
+package currency
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+type CompleteRecoveryFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Account  base.Address     `json:"account"`
+	Currency types.CurrencyID `json:"currency"`
+}
+
+func (fact CompleteRecoveryFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CompleteRecoveryFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Account:               fact.account,
+		Currency:              fact.currency,
+	})
+}
+
+type CompleteRecoveryFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Account  string `json:"account"`
+	Currency string `json:"currency"`
+}
+
+func (fact *CompleteRecoveryFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf CompleteRecoveryFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, uf.Account, uf.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op CompleteRecovery) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(op.BaseOperation)
+}
+
+func (op *CompleteRecovery) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var completeRecoveryProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(CompleteRecoveryProcessor)
+	},
+}
+
+func (CompleteRecovery) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type CompleteRecoveryProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewCompleteRecoveryProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("create new CompleteRecoveryProcessor")
+
+		nopp := completeRecoveryProcessorPool.Get()
+		opp, ok := nopp.(*CompleteRecoveryProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected %T, not %T", &CompleteRecoveryProcessor{}, nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *CompleteRecoveryProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(CompleteRecoveryFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", CompleteRecoveryFact{}, op.Fact())), nil
+	}
+
+	if _, rerr := loadRecoverableAccount(fact.Account(), getStateFunc); rerr != nil {
+		return ctx, rerr, nil
+	}
+
+	g, err := loadGuardian(fact.Account(), getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	// NOTE the recovery was already agreed by the guardians, so one of them
+	// can complete it.
+	if err := checkGuardianSigns(op.Signs(), g.Guardians, false); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMSignInvalid).Errorf("%v", err)), nil
+	}
+
+	v, err := loadPendingRecovery(fact.Account(), getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	if opp.Height() < v.Ready {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("recovery of account, %v is not ready until %v", fact.Account(), v.Ready)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *CompleteRecoveryProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(CompleteRecoveryFact)
+
+	v, err := loadPendingRecovery(fact.Account(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	ast, err := state.ExistsState(currency.AccountStateKey(fact.Account()), "account", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("account not found, %v; %w", fact.Account(), err), nil
+	}
+
+	ac, err := currency.LoadAccountStateValue(ast)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	uac, err := ac.SetKeys(v.Keys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v.Status = currency.RecoveryCompleted
+
+	stmvs := []base.StateMergeValue{
+		state.NewStateMergeValue(ast.Key(), currency.NewAccountStateValue(uac)),
+		state.NewStateMergeValue(currency.RecoveryStateKey(fact.Account()), v),
+	}
+
+	// NOTE the keys replaced by the earlier update in grace period are
+	// recovered from, so they are no longer accepted.
+	switch pst, found, err := getStateFunc(currency.PreviousKeysStateKey(fact.Account())); {
+	case err != nil:
+		return nil, nil, err
+	case found:
+		stv, err := expirePreviousKeys(pst, opp.Height())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		stmvs = append(stmvs, stv)
+	}
+
+	return stmvs, nil, nil
+}
+
+func (opp *CompleteRecoveryProcessor) Close() error {
+	completeRecoveryProcessorPool.Put(opp)
+
+	return nil
+}
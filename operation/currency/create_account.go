@@ -5,6 +5,7 @@ import (
 
 	"github.com/imfact-labs/currency-model/common"
 	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/state"
 	"github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util"
@@ -32,6 +33,63 @@ type AmountsItem interface {
 	Amounts() []types.Amount
 }
 
+// AmountsItemsFeeAmounts returns the amount of currency, cid moved by each item; an item without cid has zero amount.
+func AmountsItemsFeeAmounts(cid types.CurrencyID, items []AmountsItem) []common.Big {
+	amounts := make([]common.Big, len(items))
+	for i := range items {
+		amounts[i] = common.ZeroBig
+
+		ams := items[i].Amounts()
+		for j := range ams {
+			if ams[j].Currency() == cid {
+				amounts[i] = ams[j].Big()
+
+				break
+			}
+		}
+	}
+
+	return amounts
+}
+
+// CheckAmountsItemsFeeCurrency checks the currencies moved by items with the
+// fact currency, cid. The fee by amount is charged only for the amounts of cid,
+// so the other currency can not be moved when its policy charges the fee by
+// amount, like RatioFeeer and TieredFeeer.
+func CheckAmountsItemsFeeCurrency(cid types.CurrencyID, items []AmountsItem, getStateFunc base.GetStateFunc) error {
+	checked := map[types.CurrencyID]struct{}{cid: {}}
+
+	for i := range items {
+		ams := items[i].Amounts()
+		for j := range ams {
+			acid := ams[j].Currency()
+			if _, found := checked[acid]; found {
+				continue
+			}
+
+			checked[acid] = struct{}{}
+
+			policy, err := state.ExistsCurrencyPolicy(acid, getStateFunc)
+			if err != nil {
+				return err
+			}
+
+			feeer := policy.Feeer()
+			if sf, ok := feeer.(types.SplitFeeer); ok {
+				feeer = sf.Feeer()
+			}
+
+			if _, ok := feeer.(types.AmountFeeer); ok {
+				return common.ErrValueInvalid.Wrap(
+					errors.Errorf(
+						"currency, %v charges fee by amount; it can not be moved by fact of currency, %v", acid, cid))
+			}
+		}
+	}
+
+	return nil
+}
+
 type CreateAccountItem interface {
 	hint.Hinter
 	util.IsValider
@@ -201,6 +259,15 @@ func (fact CreateAccountFact) FeeBase() (types.CurrencyID, int, int, bool) {
 	return fact.Currency(), len(fact.items), len(fact.Bytes()), extras.HasItem
 }
 
+func (fact CreateAccountFact) FeeAmounts() []common.Big {
+	items := make([]AmountsItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return AmountsItemsFeeAmounts(fact.currency, items)
+}
+
 func (fact CreateAccountFact) FeePayer() base.Address {
 	return fact.sender
 }
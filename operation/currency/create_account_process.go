@@ -64,6 +64,10 @@ func (opp *CreateAccountItemProcessor) PreProcess(
 			return e.Wrap(err)
 		}
 
+		if err := state.CheckCurrencyNotPaused(cid, getStateFunc); err != nil {
+			return e.Wrap(err)
+		}
+
 		if am.Big().Compare(policy.MinBalance()) < 0 {
 			return e.Wrap(
 				common.ErrValOOR.Wrap(
@@ -78,6 +82,10 @@ func (opp *CreateAccountItemProcessor) PreProcess(
 			return e.Wrap(common.ErrAccountE.Wrap(errors.Errorf("target balance already exists, %v", target)))
 		default:
 		}
+
+		if err := CheckTransferLimits(target, am, getStateFunc); err != nil {
+			return e.Wrap(err)
+		}
 	}
 
 	return nil
@@ -208,12 +216,45 @@ func (opp *CreateAccountProcessor) PreProcess(
 		c.Close()
 	}
 
+	items := make([]AmountsItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	if err := CheckAmountsItemsFeeCurrency(fact.currency, items, getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	totals := NewReceiverTotals()
+	for i := range fact.items {
+		target, err := fact.items[i].Address()
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Errorf("%v", err)), nil
+		}
+
+		for _, am := range fact.items[i].Amounts() {
+			totals.Add(target, am)
+		}
+	}
+
+	if err := totals.CheckMaxBalance(getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
 	for cid := range currencyID {
 		if err := state.CheckExistsState(currency.BalanceStateKey(fact.Sender(), cid), getStateFunc); err != nil {
 			return nil, base.NewBaseOperationProcessReasonError(
 					common.ErrMStateNF.Errorf("balance of currency, %v of account, %v", cid, fact.Sender())),
 				nil
 		}
+
+		if err := state.CheckAccountNotFrozen(fact.Sender(), []types.CurrencyID{cid}, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Errorf("%v", err)), nil
+		}
 	}
 
 	return ctx, nil, nil
@@ -282,6 +323,18 @@ func (opp *CreateAccountProcessor) Process( // nolint:dupl
 		)
 	}
 
+	outflows := make([]types.Amount, 0, len(totalAmounts))
+	for key := range totalAmounts {
+		outflows = append(outflows, totalAmounts[key])
+	}
+
+	outflowValues, err := PrepareOutflowLimits(fact.Sender(), outflows, opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	stateMergeValues = append(stateMergeValues, outflowValues...)
+
 	return stateMergeValues, nil, nil
 }
 
@@ -0,0 +1,198 @@
+package currency
+
+import (
+	"fmt"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	CreateVestingFactHint = hint.MustNewHint("mitum-currency-create-vesting-operation-fact-v0.0.1")
+	CreateVestingHint     = hint.MustNewHint("mitum-currency-create-vesting-operation-v0.0.1")
+)
+
+// CreateVestingFact moves amount from the balance of sender into the vesting
+// schedule of receiver. Nothing is vested before cliff; after cliff, amount is
+// vested linearly from start to end.
+type CreateVestingFact struct {
+	base.BaseFact
+	sender   base.Address
+	receiver base.Address
+	amount   types.Amount
+	start    base.Height
+	cliff    base.Height
+	end      base.Height
+	currency types.CurrencyID
+}
+
+func NewCreateVestingFact(
+	token []byte,
+	sender base.Address,
+	receiver base.Address,
+	amount types.Amount,
+	start, cliff, end base.Height,
+	currency types.CurrencyID,
+) CreateVestingFact {
+	fact := CreateVestingFact{
+		BaseFact: base.NewBaseFact(CreateVestingFactHint, token),
+		sender:   sender,
+		receiver: receiver,
+		amount:   amount,
+		start:    start,
+		cliff:    cliff,
+		end:      end,
+		currency: currency,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact CreateVestingFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact CreateVestingFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CreateVestingFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.receiver.Bytes(),
+		fact.amount.Bytes(),
+		fact.start.Bytes(),
+		fact.cliff.Bytes(),
+		fact.end.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact CreateVestingFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false, fact.sender, fact.receiver, fact.amount, fact.start, fact.cliff, fact.end, fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if !fact.amount.Big().OverZero() {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("Under zero amount of CreateVesting")))
+	}
+
+	if fact.start > fact.cliff || fact.cliff > fact.end || fact.start >= fact.end {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(
+			errors.Errorf("invalid vesting range, start=%v cliff=%v end=%v", fact.start, fact.cliff, fact.end)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact CreateVestingFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact CreateVestingFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact CreateVestingFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact CreateVestingFact) Receiver() base.Address {
+	return fact.receiver
+}
+
+func (fact CreateVestingFact) Amount() types.Amount {
+	return fact.amount
+}
+
+func (fact CreateVestingFact) Start() base.Height {
+	return fact.start
+}
+
+func (fact CreateVestingFact) Cliff() base.Height {
+	return fact.cliff
+}
+
+func (fact CreateVestingFact) End() base.Height {
+	return fact.end
+}
+
+func (fact CreateVestingFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact CreateVestingFact) Addresses() ([]base.Address, error) {
+	if fact.sender.Equal(fact.receiver) {
+		return []base.Address{fact.sender}, nil
+	}
+
+	return []base.Address{fact.receiver, fact.sender}, nil
+}
+
+func (fact CreateVestingFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact CreateVestingFact) FeeAmounts() []common.Big {
+	if fact.amount.Currency() != fact.currency {
+		return []common.Big{common.ZeroBig}
+	}
+
+	return []common.Big{fact.amount.Big()}
+}
+
+func (fact CreateVestingFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact CreateVestingFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact CreateVestingFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fmt.Sprintf("%s:%s", fact.sender.String(), fact.currency.String())}
+	r[extras.DuplicationKeyTypeVesting] = []string{currency.VestingStateKey(fact.receiver, fact.amount.Currency())}
+
+	return r, nil
+}
+
+type CreateVesting struct {
+	extras.ExtendedOperation
+}
+
+func (op CreateVesting) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewCreateVesting(fact CreateVestingFact) (CreateVesting, error) {
+	return CreateVesting{
+		ExtendedOperation: extras.NewExtendedOperation(CreateVestingHint, fact),
+	}, nil
+}
@@ -0,0 +1,89 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+type CreateVestingFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address     `json:"sender"`
+	Receiver base.Address     `json:"receiver"`
+	Amount   types.Amount     `json:"amount"`
+	Start    base.Height      `json:"start"`
+	Cliff    base.Height      `json:"cliff"`
+	End      base.Height      `json:"end"`
+	Currency types.CurrencyID `json:"currency"`
+}
+
+func (fact CreateVestingFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CreateVestingFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Receiver:              fact.receiver,
+		Amount:                fact.amount,
+		Start:                 fact.start,
+		Cliff:                 fact.cliff,
+		End:                   fact.end,
+		Currency:              fact.currency,
+	})
+}
+
+type CreateVestingFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string          `json:"sender"`
+	Receiver string          `json:"receiver"`
+	Amount   json.RawMessage `json:"amount"`
+	Start    base.Height     `json:"start"`
+	Cliff    base.Height     `json:"cliff"`
+	End      base.Height     `json:"end"`
+	Currency string          `json:"currency"`
+}
+
+func (fact *CreateVestingFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf CreateVestingFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(
+		enc, uf.Sender, uf.Receiver, uf.Amount, uf.Start, uf.Cliff, uf.End, uf.Currency,
+	); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op CreateVesting) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperationMarshaler{
+		BaseOperationJSONMarshaler:           op.BaseOperation.JSONMarshaler(),
+		BaseOperationExtensionsJSONMarshaler: op.BaseOperationExtensions.JSONMarshaler(),
+	})
+}
+
+func (op *CreateVesting) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	var ueo extras.BaseOperationExtensions
+	if err := ueo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperationExtensions = &ueo
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var createVestingProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(CreateVestingProcessor)
+	},
+}
+
+func (CreateVesting) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type CreateVestingProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewCreateVestingProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("create new CreateVestingProcessor")
+
+		nopp := createVestingProcessorPool.Get()
+		opp, ok := nopp.(*CreateVestingProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected %T, not %T", &CreateVestingProcessor{}, nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *CreateVestingProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(CreateVestingFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", CreateVestingFact{}, op.Fact())), nil
+	}
+
+	if _, _, aErr, cErr := state.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).Errorf("%v", cErr)), nil
+	}
+
+	cid := fact.Amount().Currency()
+
+	if err := state.CheckCurrencyNotPaused(cid, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	if err := state.CheckAccountNotFrozen(fact.Sender(), []types.CurrencyID{cid}, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	if _, err := state.ExistsAccount(fact.Receiver(), "receiver", true, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	// NOTE the max balance of receiver is checked when the receiver releases.
+	if err := CheckMaxTransfer(fact.Amount(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	switch st, found, err := getStateFunc(currency.VestingStateKey(fact.Receiver(), cid)); {
+	case err != nil:
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateInvalid).Errorf("%v", err)), nil
+	case found:
+		v, err := currency.StateVestingValue(st)
+		if err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).Errorf("%v", err)), nil
+		}
+
+		if v.Locked().OverZero() {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+					Errorf("vesting of currency, %v of receiver, %v not yet released", cid, fact.Receiver())), nil
+		}
+	}
+
+	bst, err := state.ExistsState(currency.BalanceStateKey(fact.Sender(), cid), "sender balance", getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateNF).Errorf("%v", err)), nil
+	}
+
+	balance, err := currency.StateBalanceValue(bst)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).Errorf("%v", err)), nil
+	}
+
+	if balance.Big().Compare(fact.Amount().Big()) < 0 {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("insufficient balance of currency, %v of sender, %v", cid, fact.Sender())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *CreateVestingProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(CreateVestingFact)
+
+	am := fact.Amount()
+	bk := currency.BalanceStateKey(fact.Sender(), am.Currency())
+
+	// NOTE the vested amount is counted as the outflow of sender.
+	outflowValues, err := PrepareOutflowLimits(fact.Sender(), []types.Amount{am}, opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	stvs := []base.StateMergeValue{
+		common.NewBaseStateMergeValue(
+			bk,
+			currency.NewDeductBalanceStateValue(am),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return currency.NewBalanceStateValueMerger(height, bk, am.Currency(), st)
+			},
+		),
+		state.NewStateMergeValue(
+			currency.VestingStateKey(fact.Receiver(), am.Currency()),
+			currency.NewVestingStateValue(am, common.ZeroBig, fact.Start(), fact.Cliff(), fact.End()),
+		),
+	}
+
+	return append(stvs, outflowValues...), nil, nil
+}
+
+func (opp *CreateVestingProcessor) Close() error {
+	createVestingProcessorPool.Put(opp)
+
+	return nil
+}
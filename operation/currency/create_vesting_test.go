@@ -0,0 +1,241 @@
+package currency_test
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/currency"
+	operationtest "github.com/imfact-labs/currency-model/operation/test"
+	ccstate "github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type testVesting struct {
+	tp           *operationtest.TestProcessor
+	sender       base.Address
+	senderPriv   base.Privatekey
+	receiver     base.Address
+	receiverPriv base.Privatekey
+}
+
+func newTestVesting(t *testing.T) testVesting {
+	t.Helper()
+
+	tp := newTestProcessor(t, nilFeePolicy())
+
+	v := testVesting{tp: tp}
+	v.sender, _, v.senderPriv = tp.NewTestAccountState(tp.NewPrivateKey("sender-vesting"), true)
+	tp.NewTestBalanceState(v.sender, tp.GenesisCurrency, 2000, true)
+	v.receiver, _, v.receiverPriv = tp.NewTestAccountState(tp.NewPrivateKey("receiver-vesting"), true)
+
+	return v
+}
+
+func (v testVesting) create(
+	t *testing.T, token string, n int64, start, cliff, end base.Height,
+) currency.CreateVesting {
+	t.Helper()
+
+	op, err := currency.NewCreateVesting(currency.NewCreateVestingFact(
+		[]byte(token), v.sender, v.receiver, amount(v.tp, n), start, cliff, end, v.tp.GenesisCurrency))
+	if err != nil {
+		t.Fatalf("new create vesting: %v", err)
+	}
+
+	sign(t, v.tp, &op, v.senderPriv)
+
+	return op
+}
+
+func (v testVesting) release(t *testing.T, token string) currency.ReleaseVesting {
+	t.Helper()
+
+	op, err := currency.NewReleaseVesting(currency.NewReleaseVestingFact([]byte(token), v.receiver, v.tp.GenesisCurrency))
+	if err != nil {
+		t.Fatalf("new release vesting: %v", err)
+	}
+
+	sign(t, v.tp, &op, v.receiverPriv)
+
+	return op
+}
+
+// vest locks 1000 for receiver from height 10 until height 110 with cliff
+// at height 20.
+func (v testVesting) vest(t *testing.T) {
+	t.Helper()
+
+	_, reason, err := v.tp.ProcessAt(currency.NewCreateVestingProcessor(), base.Height(5),
+		v.create(t, "create-vesting", 1000, 10, 20, 110))
+	requireNoReason(t, reason, err)
+}
+
+func (v testVesting) state(t *testing.T) ccstate.VestingStateValue {
+	t.Helper()
+
+	st, found, err := v.tp.GetStateFunc(ccstate.VestingStateKey(v.receiver, v.tp.GenesisCurrency))
+	if err != nil || !found {
+		t.Fatalf("expected vesting state, %v", err)
+	}
+
+	return st.Value().(ccstate.VestingStateValue)
+}
+
+func TestCreateVestingValidation(t *testing.T) {
+	v := newTestVesting(t)
+
+	cases := []struct {
+		name              string
+		n                 int64
+		start, cliff, end base.Height
+		valid             bool
+	}{
+		{"valid", 1000, 10, 20, 110, true},
+		{"cliff-at-start", 1000, 10, 10, 110, true},
+		{"cliff-at-end", 1000, 10, 110, 110, true},
+		{"zero-amount", 0, 10, 20, 110, false},
+		{"cliff-before-start", 1000, 10, 9, 110, false},
+		{"cliff-after-end", 1000, 10, 111, 110, false},
+		{"empty-range", 1000, 10, 10, 10, false},
+	}
+
+	for _, c := range cases {
+		if err := v.create(t, c.name, c.n, c.start, c.cliff, c.end).IsValid(v.tp.NetworkID); (err == nil) != c.valid {
+			t.Fatalf("%s: expected valid %v, not %v", c.name, c.valid, err)
+		}
+	}
+}
+
+func TestCreateVestingRejections(t *testing.T) {
+	v := newTestVesting(t)
+
+	reason, err := v.tp.PreProcessAt(currency.NewCreateVestingProcessor(), base.Height(5),
+		v.create(t, "create-over-balance", 2001, 10, 20, 110))
+	requireReason(t, reason, err, "insufficient balance")
+
+	v.vest(t)
+
+	if b := v.tp.Balance(v.sender, v.tp.GenesisCurrency); !b.Equal(common.NewBig(1000)) {
+		t.Fatalf("expected vested amount deducted from sender, not %v", b)
+	}
+
+	reason, err = v.tp.PreProcessAt(currency.NewCreateVestingProcessor(), base.Height(5),
+		v.create(t, "create-not-released", 100, 10, 20, 110))
+	requireReason(t, reason, err, "not yet released")
+
+	v.receiver, _, _ = v.tp.NewTestAccountState(v.tp.NewPrivateKey("unknown-receiver-vesting"), false)
+
+	reason, err = v.tp.PreProcessAt(currency.NewCreateVestingProcessor(), base.Height(5),
+		v.create(t, "create-unknown-receiver", 100, 10, 20, 110))
+	requireReason(t, reason, err, "receiver")
+}
+
+func TestReleaseVesting(t *testing.T) {
+	v := newTestVesting(t)
+
+	reason, err := v.tp.PreProcessAt(currency.NewReleaseVestingProcessor(), base.Height(5), v.release(t, "release-not-vested"))
+	requireReason(t, reason, err, "vesting")
+
+	v.vest(t)
+
+	reason, err = v.tp.PreProcessAt(currency.NewReleaseVestingProcessor(), base.Height(19), v.release(t, "release-before-cliff"))
+	requireReason(t, reason, err, "nothing to release")
+
+	_, reason, err = v.tp.ProcessAt(currency.NewReleaseVestingProcessor(), base.Height(60), v.release(t, "release-half"))
+	requireNoReason(t, reason, err)
+
+	if b := v.tp.Balance(v.receiver, v.tp.GenesisCurrency); !b.Equal(common.NewBig(500)) {
+		t.Fatalf("expected half released at height 60, not %v", b)
+	}
+
+	reason, err = v.tp.PreProcessAt(currency.NewReleaseVestingProcessor(), base.Height(60), v.release(t, "release-again"))
+	requireReason(t, reason, err, "nothing to release")
+
+	_, reason, err = v.tp.ProcessAt(currency.NewReleaseVestingProcessor(), base.Height(200), v.release(t, "release-rest"))
+	requireNoReason(t, reason, err)
+
+	if s := v.state(t); !s.Locked().IsZero() {
+		t.Fatalf("expected nothing locked after end, not %v", s.Locked())
+	}
+
+	if b := v.tp.Balance(v.receiver, v.tp.GenesisCurrency); !b.Equal(common.NewBig(1000)) {
+		t.Fatalf("expected whole released after end, not %v", b)
+	}
+
+	// NOTE new vesting can be created after released
+	_, reason, err = v.tp.ProcessAt(currency.NewCreateVestingProcessor(), base.Height(200),
+		v.create(t, "create-after-released", 100, 200, 200, 300))
+	requireNoReason(t, reason, err)
+}
+
+func TestReleaseVestingRejectsDepositBlockedContract(t *testing.T) {
+	v := newTestVesting(t)
+	v.vest(t)
+
+	setBalanceStatus(v.tp, v.receiver, v.sender, types.DepositBlocked)
+
+	reason, err := v.tp.PreProcessAt(currency.NewReleaseVestingProcessor(), base.Height(60), v.release(t, "release-deposit-blocked"))
+	requireReason(t, reason, err, "not allowed to deposit")
+}
+
+func TestVestingLimits(t *testing.T) {
+	v := newTestVesting(t)
+
+	// NOTE max transfer 1000, max outflow 1500 per 10 blocks and max balance 400
+	updateCurrencyDesign(t, v.tp, v.tp.GenesisCurrency, func(de *types.CurrencyDesign) {
+		de.SetPolicy(nilFeePolicy().WithLimits(
+			types.NewTransferLimits(common.NewBig(1000), common.NewBig(1500), 10, common.NewBig(400))))
+	})
+
+	reason, err := v.tp.PreProcessAt(currency.NewCreateVestingProcessor(), base.Height(5),
+		v.create(t, "create-over-max-transfer", 1001, 10, 20, 110))
+	requireReason(t, reason, err, "over max transfer")
+
+	v.vest(t)
+
+	// NOTE the half of vested 1000 is over the max balance of receiver.
+	reason, err = v.tp.PreProcessAt(currency.NewReleaseVestingProcessor(), base.Height(60), v.release(t, "release-over-max-balance"))
+	requireReason(t, reason, err, "over max balance")
+
+	v.receiver, _, v.receiverPriv = v.tp.NewTestAccountState(v.tp.NewPrivateKey("other-receiver-vesting"), true)
+
+	_, reason, err = v.tp.ProcessAt(currency.NewCreateVestingProcessor(), base.Height(6),
+		v.create(t, "create-over-max-outflow", 501, 10, 20, 110))
+	requireReason(t, reason, err, "over max outflow")
+}
+
+func TestReleaseVestingRejectsPausedCurrency(t *testing.T) {
+	v := newTestVesting(t)
+	v.vest(t)
+
+	updateCurrencyDesign(t, v.tp, v.tp.GenesisCurrency, func(de *types.CurrencyDesign) {
+		de.SetPaused(true)
+	})
+
+	reason, err := v.tp.PreProcessAt(currency.NewReleaseVestingProcessor(), base.Height(60), v.release(t, "release-paused"))
+	requireReason(t, reason, err, string(common.ErrMCurrencyPaused))
+}
+
+func TestVestingFactsRoundTrip(t *testing.T) {
+	v := newTestVesting(t)
+
+	facts := []base.Fact{
+		v.create(t, "create-round-trip", 1000, 10, 20, 110).Fact(),
+		v.release(t, "release-round-trip").Fact(),
+	}
+
+	for i := range facts {
+		j, b := roundTrip(t, facts[i])
+
+		for _, got := range []base.Fact{j, b} {
+			if err := got.IsValid(nil); err != nil {
+				t.Fatalf("invalid decoded %T: %v", got, err)
+			}
+
+			if !got.Hash().Equal(facts[i].Hash()) {
+				t.Fatalf("decoded %T not matched", got)
+			}
+		}
+	}
+}
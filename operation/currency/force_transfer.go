@@ -0,0 +1,183 @@
+package currency
+
+import (
+	"fmt"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	ForceTransferFactHint = hint.MustNewHint("mitum-currency-force-transfer-operation-fact-v0.0.1")
+	ForceTransferHint     = hint.MustNewHint("mitum-currency-force-transfer-operation-v0.0.1")
+)
+
+// ForceTransferFact moves amount from the balance of the frozen target to
+// receiver. The sender should be the authority in the policy of currency,
+// which is allowed to force transfer.
+type ForceTransferFact struct {
+	base.BaseFact
+	sender   base.Address
+	target   base.Address
+	receiver base.Address
+	amount   types.Amount
+	currency types.CurrencyID
+}
+
+func NewForceTransferFact(
+	token []byte,
+	sender base.Address,
+	target base.Address,
+	receiver base.Address,
+	amount types.Amount,
+	currency types.CurrencyID,
+) ForceTransferFact {
+	fact := ForceTransferFact{
+		BaseFact: base.NewBaseFact(ForceTransferFactHint, token),
+		sender:   sender,
+		target:   target,
+		receiver: receiver,
+		amount:   amount,
+		currency: currency,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ForceTransferFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ForceTransferFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ForceTransferFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.target.Bytes(),
+		fact.receiver.Bytes(),
+		fact.amount.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact ForceTransferFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false, fact.sender, fact.target, fact.receiver, fact.amount, fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if !fact.amount.Big().OverZero() {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("Under zero amount of ForceTransfer")))
+	}
+
+	if fact.target.Equal(fact.receiver) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("target %v is same with receiver", fact.target)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact ForceTransferFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ForceTransferFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ForceTransferFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact ForceTransferFact) Target() base.Address {
+	return fact.target
+}
+
+func (fact ForceTransferFact) Receiver() base.Address {
+	return fact.receiver
+}
+
+func (fact ForceTransferFact) Amount() types.Amount {
+	return fact.amount
+}
+
+func (fact ForceTransferFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact ForceTransferFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{fact.target, fact.receiver}
+	if !fact.sender.Equal(fact.receiver) {
+		as = append(as, fact.sender)
+	}
+
+	return as, nil
+}
+
+func (fact ForceTransferFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact ForceTransferFact) FeeAmounts() []common.Big {
+	if fact.amount.Currency() != fact.currency {
+		return []common.Big{common.ZeroBig}
+	}
+
+	return []common.Big{fact.amount.Big()}
+}
+
+func (fact ForceTransferFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact ForceTransferFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact ForceTransferFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fmt.Sprintf("%s:%s", fact.sender.String(), fact.currency.String())}
+
+	return r, nil
+}
+
+type ForceTransfer struct {
+	extras.ExtendedOperation
+}
+
+func (op ForceTransfer) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewForceTransfer(fact ForceTransferFact) (ForceTransfer, error) {
+	return ForceTransfer{
+		ExtendedOperation: extras.NewExtendedOperation(ForceTransferHint, fact),
+	}, nil
+}
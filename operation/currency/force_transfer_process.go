@@ -0,0 +1,166 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/state/extension"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var forceTransferProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ForceTransferProcessor)
+	},
+}
+
+func (ForceTransfer) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type ForceTransferProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewForceTransferProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("create new ForceTransferProcessor")
+
+		nopp := forceTransferProcessorPool.Get()
+		opp, ok := nopp.(*ForceTransferProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected %T, not %T", &ForceTransferProcessor{}, nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *ForceTransferProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(ForceTransferFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", ForceTransferFact{}, op.Fact())), nil
+	}
+
+	if _, _, aErr, cErr := state.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).Errorf("%v", cErr)), nil
+	}
+
+	cid := fact.Amount().Currency()
+
+	if err := checkCurrencyAuthority(fact.Sender(), cid, true, getStateFunc); err != nil {
+		return ctx, err, nil
+	}
+
+	if _, _, aErr, cErr := state.ExistsCAccount(fact.Target(), "target", true, false, getStateFunc); aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).Errorf("%v", cErr)), nil
+	}
+
+	switch err := state.CheckAccountNotFrozen(fact.Target(), []types.CurrencyID{cid}, getStateFunc); {
+	case err == nil:
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("target %v not frozen in currency, %v", fact.Target(), cid)), nil
+	case !errors.Is(err, common.ErrAccountFrozen):
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).Errorf("%v", err)), nil
+	}
+
+	if _, err := state.ExistsAccount(fact.Receiver(), "receiver", true, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	if err := extension.CheckDepositAllowed(fact.Receiver(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	bst, err := state.ExistsState(currency.BalanceStateKey(fact.Target(), cid), "target balance", getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateNF).Errorf("%v", err)), nil
+	}
+
+	balance, err := currency.StateBalanceValue(bst)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).Errorf("%v", err)), nil
+	}
+
+	if balance.Big().Compare(fact.Amount().Big()) < 0 {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("insufficient balance of currency, %v of target, %v", cid, fact.Target())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ForceTransferProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, _ base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(ForceTransferFact)
+
+	am := fact.Amount()
+	tk := currency.BalanceStateKey(fact.Target(), am.Currency())
+	rk := currency.BalanceStateKey(fact.Receiver(), am.Currency())
+
+	return []base.StateMergeValue{
+		common.NewBaseStateMergeValue(
+			tk,
+			currency.NewDeductBalanceStateValue(am),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return currency.NewBalanceStateValueMerger(height, tk, am.Currency(), st)
+			},
+		),
+		common.NewBaseStateMergeValue(
+			rk,
+			currency.NewAddBalanceStateValue(am),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return currency.NewBalanceStateValueMerger(height, rk, am.Currency(), st)
+			},
+		),
+	}, nil, nil
+}
+
+func (opp *ForceTransferProcessor) Close() error {
+	forceTransferProcessorPool.Put(opp)
+
+	return nil
+}
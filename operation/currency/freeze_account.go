@@ -0,0 +1,155 @@
+package currency
+
+import (
+	"fmt"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	FreezeAccountFactHint = hint.MustNewHint("mitum-currency-freeze-account-operation-fact-v0.0.1")
+	FreezeAccountHint     = hint.MustNewHint("mitum-currency-freeze-account-operation-v0.0.1")
+)
+
+// FreezeAccountFact freezes or unfreezes target in currency. The sender should
+// be the authority in the policy of currency.
+type FreezeAccountFact struct {
+	base.BaseFact
+	sender   base.Address
+	target   base.Address
+	currency types.CurrencyID
+	freeze   bool
+}
+
+func NewFreezeAccountFact(
+	token []byte,
+	sender base.Address,
+	target base.Address,
+	currency types.CurrencyID,
+	freeze bool,
+) FreezeAccountFact {
+	fact := FreezeAccountFact{
+		BaseFact: base.NewBaseFact(FreezeAccountFactHint, token),
+		sender:   sender,
+		target:   target,
+		currency: currency,
+		freeze:   freeze,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact FreezeAccountFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact FreezeAccountFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact FreezeAccountFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.target.Bytes(),
+		fact.currency.Bytes(),
+		util.BoolToBytes(fact.freeze),
+	)
+}
+
+func (fact FreezeAccountFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.target, fact.currency); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.target) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with target", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact FreezeAccountFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact FreezeAccountFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact FreezeAccountFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact FreezeAccountFact) Target() base.Address {
+	return fact.target
+}
+
+func (fact FreezeAccountFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact FreezeAccountFact) Freeze() bool {
+	return fact.freeze
+}
+
+func (fact FreezeAccountFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.target, fact.sender}, nil
+}
+
+func (fact FreezeAccountFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact FreezeAccountFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact FreezeAccountFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact FreezeAccountFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fmt.Sprintf("%s:%s", fact.sender.String(), fact.currency.String())}
+
+	return r, nil
+}
+
+type FreezeAccount struct {
+	extras.ExtendedOperation
+}
+
+func (op FreezeAccount) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewFreezeAccount(fact FreezeAccountFact) (FreezeAccount, error) {
+	return FreezeAccount{
+		ExtendedOperation: extras.NewExtendedOperation(FreezeAccountHint, fact),
+	}, nil
+}
@@ -0,0 +1,28 @@
+package currency
+
+import (
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+func (fact *FreezeAccountFact) unpack(enc encoder.Encoder, sd, tg, cid string, freeze bool) error {
+	switch ad, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.sender = ad
+	}
+
+	switch ad, err := base.DecodeAddress(tg, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.target = ad
+	}
+
+	fact.currency = types.CurrencyID(cid)
+	fact.freeze = freeze
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var freezeAccountProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(FreezeAccountProcessor)
+	},
+}
+
+func (FreezeAccount) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type FreezeAccountProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewFreezeAccountProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("create new FreezeAccountProcessor")
+
+		nopp := freezeAccountProcessorPool.Get()
+		opp, ok := nopp.(*FreezeAccountProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected %T, not %T", &FreezeAccountProcessor{}, nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *FreezeAccountProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(FreezeAccountFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", FreezeAccountFact{}, op.Fact())), nil
+	}
+
+	if _, _, aErr, cErr := state.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).Errorf("%v", cErr)), nil
+	}
+
+	if err := checkCurrencyAuthority(fact.Sender(), fact.Currency(), false, getStateFunc); err != nil {
+		return ctx, err, nil
+	}
+
+	if _, _, aErr, cErr := state.ExistsCAccount(fact.Target(), "target", true, false, getStateFunc); aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).Errorf("%v", cErr)), nil
+	}
+
+	err := state.CheckAccountNotFrozen(fact.Target(), []types.CurrencyID{fact.Currency()}, getStateFunc)
+	switch {
+	case err == nil && !fact.Freeze():
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("target %v not frozen in currency, %v", fact.Target(), fact.Currency())), nil
+	case err != nil && !errors.Is(err, common.ErrAccountFrozen):
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).Errorf("%v", err)), nil
+	case err != nil && fact.Freeze():
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("target %v already frozen in currency, %v", fact.Target(), fact.Currency())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *FreezeAccountProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, _ base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(FreezeAccountFact)
+
+	return []base.StateMergeValue{
+		state.NewStateMergeValue(
+			currency.FrozenStateKey(fact.Target(), fact.Currency()),
+			currency.NewFrozenStateValue(fact.Freeze()),
+		),
+	}, nil, nil
+}
+
+func (opp *FreezeAccountProcessor) Close() error {
+	freezeAccountProcessorPool.Put(opp)
+
+	return nil
+}
+
+// checkCurrencyAuthority checks whether the sender is the authority in the
+// policy of currency. If forceTransfer is true, the authority should be
+// allowed to force transfer.
+func checkCurrencyAuthority(
+	sender base.Address, cid types.CurrencyID, forceTransfer bool, getStateFunc base.GetStateFunc,
+) base.OperationProcessReasonError {
+	policy, err := state.ExistsCurrencyPolicy(cid, getStateFunc)
+	if err != nil {
+		return base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("%v", err))
+	}
+
+	au, found := policy.Authority()
+	switch {
+	case !found:
+		return base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+				Errorf("no authority in policy of currency, %v", cid))
+	case !au.Account().Equal(sender):
+		return base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+				Errorf("sender %v is not authority of currency, %v", sender, cid))
+	case forceTransfer && !au.ForceTransfer():
+		return base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMAccountNAth).
+				Errorf("force transfer not allowed in currency, %v", cid))
+	}
+
+	return nil
+}
@@ -0,0 +1,190 @@
+package currency_test
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/currency"
+	operationtest "github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type testFreeze struct {
+	tp            *operationtest.TestProcessor
+	authority     base.Address
+	authorityPriv base.Privatekey
+	holder        base.Address
+	holderPriv    base.Privatekey
+	receiver      base.Address
+}
+
+// newTestFreeze sets the authority of the genesis currency and the holder
+// with balance 1000.
+func newTestFreeze(t *testing.T, forceTransfer bool) testFreeze {
+	t.Helper()
+
+	tp := newTestProcessor(t, nilFeePolicy())
+
+	f := testFreeze{tp: tp}
+	f.authority, _, f.authorityPriv = tp.NewTestAccountState(tp.NewPrivateKey("authority-freeze"), true)
+	f.holder, _, f.holderPriv = tp.NewTestAccountState(tp.NewPrivateKey("holder-freeze"), true)
+	tp.NewTestBalanceState(f.holder, tp.GenesisCurrency, 1000, true)
+	f.receiver, _, _ = tp.NewTestAccountState(tp.NewPrivateKey("receiver-freeze"), true)
+
+	tp.NewTestCurrencyDesignState(types.NewCurrencyDesign(
+		common.NewBig(100000), tp.GenesisCurrency, common.NewBig(9), tp.GenesisAddr,
+		nilFeePolicy().WithAuthority(types.NewCurrencyAuthority(f.authority, forceTransfer)),
+	), true)
+
+	return f
+}
+
+func (f testFreeze) freeze(
+	t *testing.T, token string, sender base.Address, priv base.Privatekey, freeze bool,
+) currency.FreezeAccount {
+	t.Helper()
+
+	op, err := currency.NewFreezeAccount(currency.NewFreezeAccountFact(
+		[]byte(token), sender, f.holder, f.tp.GenesisCurrency, freeze))
+	if err != nil {
+		t.Fatalf("new freeze account: %v", err)
+	}
+
+	sign(t, f.tp, &op, priv)
+
+	return op
+}
+
+func (f testFreeze) forceTransfer(t *testing.T, token string, sender base.Address, priv base.Privatekey, n int64) currency.ForceTransfer {
+	t.Helper()
+
+	op, err := currency.NewForceTransfer(currency.NewForceTransferFact(
+		[]byte(token), sender, f.holder, f.receiver, amount(f.tp, n), f.tp.GenesisCurrency))
+	if err != nil {
+		t.Fatalf("new force transfer: %v", err)
+	}
+
+	sign(t, f.tp, &op, priv)
+
+	return op
+}
+
+func (f testFreeze) transfer(t *testing.T, token string) currency.Transfer {
+	t.Helper()
+
+	return newTestTransfer(t, f.tp, token, f.holder, f.holderPriv,
+		currency.NewTransferItemMultiAmounts(f.receiver, []types.Amount{amount(f.tp, 100)}))
+}
+
+func TestFreezeAccount(t *testing.T) {
+	f := newTestFreeze(t, false)
+
+	reason, err := f.tp.PreProcessAt(currency.NewFreezeAccountProcessor(), base.Height(10),
+		f.freeze(t, "freeze-by-holder", f.holder, f.holderPriv, true))
+	requireReason(t, reason, err, string(common.ErrMAccountNAth))
+
+	reason, err = f.tp.PreProcessAt(currency.NewFreezeAccountProcessor(), base.Height(10),
+		f.freeze(t, "unfreeze-not-frozen", f.authority, f.authorityPriv, false))
+	requireReason(t, reason, err, "not frozen")
+
+	_, reason, err = f.tp.ProcessAt(currency.NewFreezeAccountProcessor(), base.Height(10),
+		f.freeze(t, "freeze", f.authority, f.authorityPriv, true))
+	requireNoReason(t, reason, err)
+
+	reason, err = f.tp.PreProcessAt(currency.NewTransferProcessor(), base.Height(11), f.transfer(t, "transfer-frozen"))
+	requireReason(t, reason, err, string(common.ErrMAccountFrozen))
+
+	reason, err = f.tp.PreProcessAt(currency.NewBurnProcessor(), base.Height(11),
+		newTestBurn(t, f.tp, "burn-frozen", f.holder, f.holder, f.holderPriv, 100))
+	requireReason(t, reason, err, string(common.ErrMAccountFrozen))
+
+	reason, err = f.tp.PreProcessAt(currency.NewFreezeAccountProcessor(), base.Height(11),
+		f.freeze(t, "freeze-again", f.authority, f.authorityPriv, true))
+	requireReason(t, reason, err, "already frozen")
+
+	_, reason, err = f.tp.ProcessAt(currency.NewFreezeAccountProcessor(), base.Height(12),
+		f.freeze(t, "unfreeze", f.authority, f.authorityPriv, false))
+	requireNoReason(t, reason, err)
+
+	_, reason, err = f.tp.ProcessAt(currency.NewTransferProcessor(), base.Height(13), f.transfer(t, "transfer-unfrozen"))
+	requireNoReason(t, reason, err)
+}
+
+func TestFreezeAccountWithoutAuthority(t *testing.T) {
+	f := newTestFreeze(t, false)
+	f.tp.NewTestCurrencyDesignState(types.NewCurrencyDesign(
+		common.NewBig(100000), f.tp.GenesisCurrency, common.NewBig(9), f.tp.GenesisAddr, nilFeePolicy(),
+	), true)
+
+	reason, err := f.tp.PreProcessAt(currency.NewFreezeAccountProcessor(), base.Height(10),
+		f.freeze(t, "freeze-without-authority", f.authority, f.authorityPriv, true))
+	requireReason(t, reason, err, "no authority")
+}
+
+func TestForceTransfer(t *testing.T) {
+	f := newTestFreeze(t, true)
+
+	reason, err := f.tp.PreProcessAt(currency.NewForceTransferProcessor(), base.Height(10),
+		f.forceTransfer(t, "force-not-frozen", f.authority, f.authorityPriv, 400))
+	requireReason(t, reason, err, "not frozen")
+
+	_, reason, err = f.tp.ProcessAt(currency.NewFreezeAccountProcessor(), base.Height(10),
+		f.freeze(t, "freeze", f.authority, f.authorityPriv, true))
+	requireNoReason(t, reason, err)
+
+	reason, err = f.tp.PreProcessAt(currency.NewForceTransferProcessor(), base.Height(11),
+		f.forceTransfer(t, "force-by-holder", f.holder, f.holderPriv, 400))
+	requireReason(t, reason, err, string(common.ErrMAccountNAth))
+
+	reason, err = f.tp.PreProcessAt(currency.NewForceTransferProcessor(), base.Height(11),
+		f.forceTransfer(t, "force-over-balance", f.authority, f.authorityPriv, 1001))
+	requireReason(t, reason, err, "insufficient balance")
+
+	_, reason, err = f.tp.ProcessAt(currency.NewForceTransferProcessor(), base.Height(11),
+		f.forceTransfer(t, "force", f.authority, f.authorityPriv, 400))
+	requireNoReason(t, reason, err)
+
+	if b := f.tp.Balance(f.holder, f.tp.GenesisCurrency); !b.Equal(common.NewBig(600)) {
+		t.Fatalf("expected balance 600 of frozen account, not %v", b)
+	}
+
+	if b := f.tp.Balance(f.receiver, f.tp.GenesisCurrency); !b.Equal(common.NewBig(400)) {
+		t.Fatalf("expected balance 400 of receiver, not %v", b)
+	}
+}
+
+func TestForceTransferNotAllowedByAuthority(t *testing.T) {
+	f := newTestFreeze(t, false)
+
+	_, reason, err := f.tp.ProcessAt(currency.NewFreezeAccountProcessor(), base.Height(10),
+		f.freeze(t, "freeze", f.authority, f.authorityPriv, true))
+	requireNoReason(t, reason, err)
+
+	reason, err = f.tp.PreProcessAt(currency.NewForceTransferProcessor(), base.Height(11),
+		f.forceTransfer(t, "force-not-allowed", f.authority, f.authorityPriv, 400))
+	requireReason(t, reason, err, "force transfer not allowed")
+}
+
+func TestFreezeAccountFactsRoundTrip(t *testing.T) {
+	f := newTestFreeze(t, true)
+
+	facts := []base.Fact{
+		f.freeze(t, "freeze-round-trip", f.authority, f.authorityPriv, true).Fact(),
+		f.forceTransfer(t, "force-round-trip", f.authority, f.authorityPriv, 400).Fact(),
+	}
+
+	for i := range facts {
+		j, b := roundTrip(t, facts[i])
+
+		for _, got := range []base.Fact{j, b} {
+			if err := got.IsValid(nil); err != nil {
+				t.Fatalf("invalid decoded %T: %v", got, err)
+			}
+
+			if !got.Hash().Equal(facts[i].Hash()) {
+				t.Fatalf("decoded %T not matched", got)
+			}
+		}
+	}
+}
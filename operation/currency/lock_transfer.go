@@ -0,0 +1,219 @@
+package currency
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	LockTransferFactHint = hint.MustNewHint("mitum-currency-lock-transfer-operation-fact-v0.0.1")
+	LockTransferHint     = hint.MustNewHint("mitum-currency-lock-transfer-operation-v0.0.1")
+)
+
+// TransferHashlock returns the hashlock of preimage; the hex encoded sha256
+// digest, which is also used by the hash time-locked contracts of EVM chains.
+func TransferHashlock(preimage []byte) string {
+	h := sha256.Sum256(preimage)
+
+	return hex.EncodeToString(h[:])
+}
+
+// isValidTransferHashlock allows only the lowercase hex like TransferHashlock;
+// the hashlock is compared and keyed as it is, so the other case of same
+// digest could never be claimed.
+func isValidTransferHashlock(hashlock string) error {
+	switch b, err := hex.DecodeString(hashlock); {
+	case err != nil:
+		return common.ErrValueInvalid.Wrap(errors.Errorf("invalid hashlock, %q: %v", hashlock, err))
+	case len(b) != sha256.Size:
+		return common.ErrValueInvalid.Wrap(errors.Errorf("hashlock should be sha256 digest, not %d bytes", len(b)))
+	case hashlock != strings.ToLower(hashlock):
+		return common.ErrValueInvalid.Wrap(errors.Errorf("hashlock should be lowercase hex, %q", hashlock))
+	default:
+		return nil
+	}
+}
+
+// LockTransferFact moves amount from the balance of sender into escrow under
+// hashlock. receiver can claim it with the preimage of hashlock before expiry;
+// after expiry, sender can refund it.
+type LockTransferFact struct {
+	base.BaseFact
+	sender   base.Address
+	receiver base.Address
+	amount   types.Amount
+	hashlock string
+	expiry   base.Height
+	currency types.CurrencyID
+}
+
+func NewLockTransferFact(
+	token []byte,
+	sender base.Address,
+	receiver base.Address,
+	amount types.Amount,
+	hashlock string,
+	expiry base.Height,
+	currency types.CurrencyID,
+) LockTransferFact {
+	fact := LockTransferFact{
+		BaseFact: base.NewBaseFact(LockTransferFactHint, token),
+		sender:   sender,
+		receiver: receiver,
+		amount:   amount,
+		hashlock: hashlock,
+		expiry:   expiry,
+		currency: currency,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact LockTransferFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact LockTransferFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact LockTransferFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.receiver.Bytes(),
+		fact.amount.Bytes(),
+		[]byte(fact.hashlock),
+		fact.expiry.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact LockTransferFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false, fact.sender, fact.receiver, fact.amount, fact.expiry, fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.receiver) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("receiver is same with sender, %v", fact.sender)))
+	}
+
+	if !fact.amount.Big().OverZero() {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("Under zero amount of LockTransfer")))
+	}
+
+	if err := isValidTransferHashlock(fact.hashlock); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact LockTransferFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact LockTransferFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact LockTransferFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact LockTransferFact) Receiver() base.Address {
+	return fact.receiver
+}
+
+func (fact LockTransferFact) Amount() types.Amount {
+	return fact.amount
+}
+
+func (fact LockTransferFact) Hashlock() string {
+	return fact.hashlock
+}
+
+func (fact LockTransferFact) Expiry() base.Height {
+	return fact.expiry
+}
+
+func (fact LockTransferFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact LockTransferFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.receiver, fact.sender}, nil
+}
+
+func (fact LockTransferFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact LockTransferFact) FeeAmounts() []common.Big {
+	if fact.amount.Currency() != fact.currency {
+		return []common.Big{common.ZeroBig}
+	}
+
+	return []common.Big{fact.amount.Big()}
+}
+
+func (fact LockTransferFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact LockTransferFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact LockTransferFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fmt.Sprintf("%s:%s", fact.sender.String(), fact.currency.String())}
+	r[extras.DuplicationKeyTypeTransferLock] = []string{currency.TransferLockStateKey(fact.sender, fact.hashlock)}
+
+	return r, nil
+}
+
+type LockTransfer struct {
+	extras.ExtendedOperation
+}
+
+func (op LockTransfer) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewLockTransfer(fact LockTransferFact) (LockTransfer, error) {
+	return LockTransfer{
+		ExtendedOperation: extras.NewExtendedOperation(LockTransferHint, fact),
+	}, nil
+}
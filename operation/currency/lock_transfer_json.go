@@ -0,0 +1,86 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+type LockTransferFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address     `json:"sender"`
+	Receiver base.Address     `json:"receiver"`
+	Amount   types.Amount     `json:"amount"`
+	Hashlock string           `json:"hashlock"`
+	Expiry   base.Height      `json:"expiry"`
+	Currency types.CurrencyID `json:"currency"`
+}
+
+func (fact LockTransferFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(LockTransferFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Receiver:              fact.receiver,
+		Amount:                fact.amount,
+		Hashlock:              fact.hashlock,
+		Expiry:                fact.expiry,
+		Currency:              fact.currency,
+	})
+}
+
+type LockTransferFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string          `json:"sender"`
+	Receiver string          `json:"receiver"`
+	Amount   json.RawMessage `json:"amount"`
+	Hashlock string          `json:"hashlock"`
+	Expiry   base.Height     `json:"expiry"`
+	Currency string          `json:"currency"`
+}
+
+func (fact *LockTransferFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf LockTransferFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(
+		enc, uf.Sender, uf.Receiver, uf.Amount, uf.Hashlock, uf.Expiry, uf.Currency,
+	); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op LockTransfer) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperationMarshaler{
+		BaseOperationJSONMarshaler:           op.BaseOperation.JSONMarshaler(),
+		BaseOperationExtensionsJSONMarshaler: op.BaseOperationExtensions.JSONMarshaler(),
+	})
+}
+
+func (op *LockTransfer) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	var ueo extras.BaseOperationExtensions
+	if err := ueo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperationExtensions = &ueo
+
+	return nil
+}
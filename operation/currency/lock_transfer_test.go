@@ -0,0 +1,295 @@
+package currency_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/currency"
+	operationtest "github.com/imfact-labs/currency-model/operation/test"
+	ccstate "github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type testTransferLock struct {
+	tp           *operationtest.TestProcessor
+	sender       base.Address
+	senderPriv   base.Privatekey
+	receiver     base.Address
+	receiverPriv base.Privatekey
+	preimage     []byte
+	hashlock     string
+}
+
+// newTestTransferLock locks 300 of sender for receiver until height 20.
+func newTestTransferLock(t *testing.T) testTransferLock {
+	t.Helper()
+
+	tp := newTestProcessor(t, nilFeePolicy())
+
+	l := testTransferLock{tp: tp, preimage: []byte("atomic-swap-secret")}
+	l.hashlock = currency.TransferHashlock(l.preimage)
+	l.sender, _, l.senderPriv = tp.NewTestAccountState(tp.NewPrivateKey("sender-lock"), true)
+	tp.NewTestBalanceState(l.sender, tp.GenesisCurrency, 1000, true)
+	l.receiver, _, l.receiverPriv = tp.NewTestAccountState(tp.NewPrivateKey("receiver-lock"), true)
+
+	_, reason, err := tp.ProcessAt(currency.NewLockTransferProcessor(), base.Height(10), l.lock(t, "lock", 300, 20))
+	requireNoReason(t, reason, err)
+
+	return l
+}
+
+func (l testTransferLock) lock(t *testing.T, token string, n int64, expiry base.Height) currency.LockTransfer {
+	t.Helper()
+
+	op, err := currency.NewLockTransfer(currency.NewLockTransferFact(
+		[]byte(token), l.sender, l.receiver, amount(l.tp, n), l.hashlock, expiry, l.tp.GenesisCurrency))
+	if err != nil {
+		t.Fatalf("new lock transfer: %v", err)
+	}
+
+	sign(t, l.tp, &op, l.senderPriv)
+
+	return op
+}
+
+func (l testTransferLock) claim(
+	t *testing.T, token string, sender base.Address, priv base.Privatekey, preimage []byte,
+) currency.ClaimTransfer {
+	t.Helper()
+
+	op, err := currency.NewClaimTransfer(currency.NewClaimTransferFact(
+		[]byte(token), sender, l.sender, l.hashlock, hex.EncodeToString(preimage), l.tp.GenesisCurrency))
+	if err != nil {
+		t.Fatalf("new claim transfer: %v", err)
+	}
+
+	sign(t, l.tp, &op, priv)
+
+	return op
+}
+
+func (l testTransferLock) refund(t *testing.T, token string) currency.RefundTransfer {
+	t.Helper()
+
+	op, err := currency.NewRefundTransfer(currency.NewRefundTransferFact(
+		[]byte(token), l.sender, l.hashlock, l.tp.GenesisCurrency))
+	if err != nil {
+		t.Fatalf("new refund transfer: %v", err)
+	}
+
+	sign(t, l.tp, &op, l.senderPriv)
+
+	return op
+}
+
+func (l testTransferLock) status(t *testing.T) ccstate.TransferLockStatus {
+	t.Helper()
+
+	st, found, err := l.tp.GetStateFunc(ccstate.TransferLockStateKey(l.sender, l.hashlock))
+	if err != nil || !found {
+		t.Fatalf("expected transfer lock state, %v", err)
+	}
+
+	return st.Value().(ccstate.TransferLockStateValue).Status
+}
+
+func TestTransferHashlockValidation(t *testing.T) {
+	tp := newTestProcessor(t, nilFeePolicy())
+	sender, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("sender-hashlock"), true)
+	receiver, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("receiver-hashlock"), true)
+
+	hashlock := currency.TransferHashlock([]byte("secret"))
+
+	cases := []struct {
+		name     string
+		hashlock string
+		valid    bool
+	}{
+		{"lowercase", hashlock, true},
+		{"uppercase", strings.ToUpper(hashlock), false},
+		{"not-hex", strings.Repeat("z", len(hashlock)), false},
+		{"not-sha256", hashlock[:32], false},
+	}
+
+	for _, c := range cases {
+		facts := map[string]base.Fact{
+			"lock": currency.NewLockTransferFact(
+				[]byte(c.name), sender, receiver, amount(tp, 1), c.hashlock, 20, tp.GenesisCurrency),
+			"claim": currency.NewClaimTransferFact(
+				[]byte(c.name), receiver, sender, c.hashlock, hex.EncodeToString([]byte("secret")), tp.GenesisCurrency),
+			"refund": currency.NewRefundTransferFact([]byte(c.name), sender, c.hashlock, tp.GenesisCurrency),
+		}
+
+		for kind, fact := range facts {
+			if err := fact.IsValid(nil); (err == nil) != c.valid {
+				t.Fatalf("%s hashlock of %s: expected valid %v, not %v", c.name, kind, c.valid, err)
+			}
+		}
+	}
+}
+
+func TestLockTransferRejections(t *testing.T) {
+	l := newTestTransferLock(t)
+
+	if b := l.tp.Balance(l.sender, l.tp.GenesisCurrency); !b.Equal(common.NewBig(700)) {
+		t.Fatalf("expected locked amount deducted from sender, not %v", b)
+	}
+
+	if s := l.status(t); s != ccstate.TransferLockOpen {
+		t.Fatalf("expected open transfer lock, not %v", s)
+	}
+
+	reason, err := l.tp.PreProcessAt(currency.NewLockTransferProcessor(), base.Height(10), l.lock(t, "same-hashlock", 1, 20))
+	requireReason(t, reason, err, "transfer lock of hashlock")
+
+	l.hashlock = currency.TransferHashlock([]byte("other-secret"))
+
+	reason, err = l.tp.PreProcessAt(currency.NewLockTransferProcessor(), base.Height(20), l.lock(t, "expired", 1, 20))
+	requireReason(t, reason, err, "should be over current height")
+
+	reason, err = l.tp.PreProcessAt(currency.NewLockTransferProcessor(), base.Height(10), l.lock(t, "insufficient", 701, 20))
+	requireReason(t, reason, err, "insufficient balance")
+
+	l.receiver, _, _ = l.tp.NewTestAccountState(l.tp.NewPrivateKey("unknown-receiver-lock"), false)
+
+	reason, err = l.tp.PreProcessAt(currency.NewLockTransferProcessor(), base.Height(10), l.lock(t, "unknown-receiver", 1, 20))
+	requireReason(t, reason, err, "receiver")
+}
+
+func TestClaimTransfer(t *testing.T) {
+	l := newTestTransferLock(t)
+
+	reason, err := l.tp.PreProcessAt(currency.NewClaimTransferProcessor(), base.Height(15),
+		l.claim(t, "wrong-preimage", l.receiver, l.receiverPriv, []byte("wrong")))
+	requireReason(t, reason, err, "preimage does not match")
+
+	stranger, _, strangerPriv := l.tp.NewTestAccountState(l.tp.NewPrivateKey("stranger-lock"), true)
+
+	reason, err = l.tp.PreProcessAt(currency.NewClaimTransferProcessor(), base.Height(15),
+		l.claim(t, "not-receiver", stranger, strangerPriv, l.preimage))
+	requireReason(t, reason, err, "is not receiver")
+
+	reason, err = l.tp.PreProcessAt(currency.NewClaimTransferProcessor(), base.Height(20),
+		l.claim(t, "after-expiry", l.receiver, l.receiverPriv, l.preimage))
+	requireReason(t, reason, err, "expired")
+
+	reason, err = l.tp.PreProcessAt(currency.NewRefundTransferProcessor(), base.Height(15), l.refund(t, "refund-before-expiry"))
+	requireReason(t, reason, err, "not expired")
+
+	_, reason, err = l.tp.ProcessAt(currency.NewClaimTransferProcessor(), base.Height(15),
+		l.claim(t, "claim", l.receiver, l.receiverPriv, l.preimage))
+	requireNoReason(t, reason, err)
+
+	if b := l.tp.Balance(l.receiver, l.tp.GenesisCurrency); !b.Equal(common.NewBig(300)) {
+		t.Fatalf("expected locked amount claimed by receiver, not %v", b)
+	}
+
+	if s := l.status(t); s != ccstate.TransferLockClaimed {
+		t.Fatalf("expected claimed transfer lock, not %v", s)
+	}
+
+	reason, err = l.tp.PreProcessAt(currency.NewClaimTransferProcessor(), base.Height(15),
+		l.claim(t, "claim-again", l.receiver, l.receiverPriv, l.preimage))
+	requireReason(t, reason, err, "already claimed")
+
+	reason, err = l.tp.PreProcessAt(currency.NewRefundTransferProcessor(), base.Height(20), l.refund(t, "refund-claimed"))
+	requireReason(t, reason, err, "already claimed")
+}
+
+func TestClaimTransferRejectsDepositBlockedContract(t *testing.T) {
+	l := newTestTransferLock(t)
+
+	setBalanceStatus(l.tp, l.receiver, l.sender, types.DepositBlocked)
+
+	reason, err := l.tp.PreProcessAt(currency.NewClaimTransferProcessor(), base.Height(15),
+		l.claim(t, "claim-deposit-blocked", l.receiver, l.receiverPriv, l.preimage))
+	requireReason(t, reason, err, "not allowed to deposit")
+}
+
+func TestTransferLockLimits(t *testing.T) {
+	l := newTestTransferLock(t)
+
+	// NOTE max transfer 200, max outflow 300 per 10 blocks and max balance 250
+	updateCurrencyDesign(t, l.tp, l.tp.GenesisCurrency, func(de *types.CurrencyDesign) {
+		de.SetPolicy(nilFeePolicy().WithLimits(
+			types.NewTransferLimits(common.NewBig(200), common.NewBig(300), 10, common.NewBig(250))))
+	})
+
+	reason, err := l.tp.PreProcessAt(currency.NewLockTransferProcessor(), base.Height(11), l.lock(t, "over-max-transfer", 201, 30))
+	requireReason(t, reason, err, "over max transfer")
+
+	l.hashlock = currency.TransferHashlock([]byte("other-secret"))
+
+	_, reason, err = l.tp.ProcessAt(currency.NewLockTransferProcessor(), base.Height(11), l.lock(t, "lock-outflow", 200, 30))
+	requireNoReason(t, reason, err)
+
+	l.hashlock = currency.TransferHashlock([]byte("next-secret"))
+
+	_, reason, err = l.tp.ProcessAt(currency.NewLockTransferProcessor(), base.Height(12), l.lock(t, "over-max-outflow", 150, 30))
+	requireReason(t, reason, err, "over max outflow")
+
+	// NOTE the locked 300 is over the max balance of receiver.
+	l.hashlock = currency.TransferHashlock(l.preimage)
+
+	reason, err = l.tp.PreProcessAt(currency.NewClaimTransferProcessor(), base.Height(15),
+		l.claim(t, "claim-over-max-balance", l.receiver, l.receiverPriv, l.preimage))
+	requireReason(t, reason, err, "over max balance")
+}
+
+func TestClaimTransferRejectsPausedCurrency(t *testing.T) {
+	l := newTestTransferLock(t)
+
+	updateCurrencyDesign(t, l.tp, l.tp.GenesisCurrency, func(de *types.CurrencyDesign) {
+		de.SetPaused(true)
+	})
+
+	reason, err := l.tp.PreProcessAt(currency.NewClaimTransferProcessor(), base.Height(15),
+		l.claim(t, "claim-paused", l.receiver, l.receiverPriv, l.preimage))
+	requireReason(t, reason, err, string(common.ErrMCurrencyPaused))
+}
+
+func TestRefundTransfer(t *testing.T) {
+	l := newTestTransferLock(t)
+
+	_, reason, err := l.tp.ProcessAt(currency.NewRefundTransferProcessor(), base.Height(20), l.refund(t, "refund"))
+	requireNoReason(t, reason, err)
+
+	if b := l.tp.Balance(l.sender, l.tp.GenesisCurrency); !b.Equal(common.NewBig(1000)) {
+		t.Fatalf("expected locked amount refunded to sender, not %v", b)
+	}
+
+	if s := l.status(t); s != ccstate.TransferLockRefunded {
+		t.Fatalf("expected refunded transfer lock, not %v", s)
+	}
+
+	reason, err = l.tp.PreProcessAt(currency.NewClaimTransferProcessor(), base.Height(15),
+		l.claim(t, "claim-refunded", l.receiver, l.receiverPriv, l.preimage))
+	requireReason(t, reason, err, "already refunded")
+}
+
+func TestTransferLockFactsRoundTrip(t *testing.T) {
+	l := newTestTransferLock(t)
+
+	facts := []base.Fact{
+		l.lock(t, "lock-round-trip", 1, 20).Fact(),
+		l.claim(t, "claim-round-trip", l.receiver, l.receiverPriv, l.preimage).Fact(),
+		l.refund(t, "refund-round-trip").Fact(),
+	}
+
+	for i := range facts {
+		j, b := roundTrip(t, facts[i])
+
+		for _, got := range []base.Fact{j, b} {
+			if err := got.IsValid(nil); err != nil {
+				t.Fatalf("invalid decoded %T: %v", got, err)
+			}
+
+			if !got.Hash().Equal(facts[i].Hash()) {
+				t.Fatalf("decoded %T not matched", got)
+			}
+		}
+	}
+}
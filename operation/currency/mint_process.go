@@ -110,6 +110,25 @@ func (opp *MintProcessor) PreProcess(
 		), nil
 	}
 
+	st, err := state.ExistsState(currency.DesignStateKey(fact.Amount().Currency()), "currency design", getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("%v", err)), nil
+	}
+
+	de, err := currency.GetDesignFromState(st)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).Errorf("%v", err)), nil
+	}
+
+	if ms, ok := de.MaxSupply(); ok && de.TotalSupply().Add(fact.Amount().Big()).Compare(ms) > 0 {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValOOR).Errorf(
+				"total supply, %v with mint amount, %v over max supply, %v of currency, %v",
+				de.TotalSupply(), fact.Amount().Big(), ms, fact.Amount().Currency())), nil
+	}
+
 	if _, _, _, cErr := state.ExistsCAccount(
 		fact.Receiver(), "receiver", true, false, getStateFunc); cErr != nil {
 		return ctx, base.NewBaseOperationProcessReasonError(
@@ -189,7 +208,13 @@ func (opp *MintProcessor) Process(
 		return nil, base.NewBaseOperationProcessReasonError("add aggregate, %v: %w", cid, err), nil
 	}
 
-	sts = append(sts, state.NewStateMergeValue(k, currency.NewCurrencyDesignStateValue(ade)))
+	sts = append(sts, common.NewBaseStateMergeValue(
+		k,
+		currency.NewCurrencyDesignStateValue(ade),
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return currency.NewDesignStateValueMerger(height, k, st)
+		},
+	))
 
 	return sts, nil, nil
 }
@@ -0,0 +1,69 @@
+package currency_test
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/currency"
+	operationtest "github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+// newMaxSupplyTestProcessor sets the genesis currency with total supply 1000
+// and max supply 1200.
+func newMaxSupplyTestProcessor(t *testing.T) *operationtest.TestProcessor {
+	t.Helper()
+
+	tp := newTestProcessor(t, nilFeePolicy())
+
+	design := types.NewCurrencyDesign(
+		common.NewBig(1000), tp.GenesisCurrency, common.NewBig(9), tp.GenesisAddr, nilFeePolicy())
+	design.SetMaxSupply(common.NewBig(1200))
+	tp.NewTestCurrencyDesignState(design, true)
+
+	return tp
+}
+
+func newTestMint(t *testing.T, tp *operationtest.TestProcessor, token string, n int64) currency.Mint {
+	t.Helper()
+
+	op, err := currency.NewMint(currency.NewMintFact([]byte(token), tp.GenesisAddr, amount(tp, n)))
+	if err != nil {
+		t.Fatalf("new mint: %v", err)
+	}
+
+	if err := op.NodeSign(tp.NodePriv, tp.NetworkID, tp.NodeAddr); err != nil {
+		t.Fatalf("sign mint: %v", err)
+	}
+
+	return op
+}
+
+func TestMintRejectsOverMaxSupply(t *testing.T) {
+	tp := newMaxSupplyTestProcessor(t)
+
+	reason, err := tp.PreProcessAt(currency.NewMintProcessor(base.MaxThreshold), base.Height(10),
+		newTestMint(t, tp, "mint-over-max-supply", 201))
+	requireReason(t, reason, err, "over max supply")
+
+	_, reason, err = tp.ProcessAt(currency.NewMintProcessor(base.MaxThreshold), base.Height(10),
+		newTestMint(t, tp, "mint-max-supply", 200))
+	requireNoReason(t, reason, err)
+
+	if ts := currencyDesign(t, tp, tp.GenesisCurrency).TotalSupply(); !ts.Equal(common.NewBig(1200)) {
+		t.Fatalf("expected total supply 1200, not %v", ts)
+	}
+
+	reason, err = tp.PreProcessAt(currency.NewMintProcessor(base.MaxThreshold), base.Height(11),
+		newTestMint(t, tp, "mint-after-max-supply", 1))
+	requireReason(t, reason, err, "over max supply")
+}
+
+func TestMintWithoutMaxSupply(t *testing.T) {
+	tp := newTestProcessor(t, nilFeePolicy())
+
+	_, reason, err := tp.ProcessAt(currency.NewMintProcessor(base.MaxThreshold), base.Height(10),
+		newTestMint(t, tp, "mint-without-max-supply", 1000000))
+	requireNoReason(t, reason, err)
+}
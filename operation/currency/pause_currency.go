@@ -0,0 +1,97 @@
+package currency
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+)
+
+var (
+	PauseCurrencyFactHint = hint.MustNewHint("mitum-currency-pause-currency-operation-fact-v0.0.1")
+	PauseCurrencyHint     = hint.MustNewHint("mitum-currency-pause-currency-operation-v0.0.1")
+)
+
+// PauseCurrencyFact halts the transfers of currency if pause is true;
+// otherwise the transfers are resumed.
+type PauseCurrencyFact struct {
+	base.BaseFact
+	currency types.CurrencyID
+	pause    bool
+}
+
+func NewPauseCurrencyFact(token []byte, currency types.CurrencyID, pause bool) PauseCurrencyFact {
+	fact := PauseCurrencyFact{
+		BaseFact: base.NewBaseFact(PauseCurrencyFactHint, token),
+		currency: currency,
+		pause:    pause,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact PauseCurrencyFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact PauseCurrencyFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.currency.Bytes(),
+		util.BoolToBytes(fact.pause),
+	)
+}
+
+func (fact PauseCurrencyFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.currency); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact PauseCurrencyFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact PauseCurrencyFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact PauseCurrencyFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact PauseCurrencyFact) Pause() bool {
+	return fact.pause
+}
+
+func (fact PauseCurrencyFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeCurrency] = []string{fact.Currency().String()}
+
+	return r, nil
+}
+
+type PauseCurrency struct {
+	common.BaseNodeOperation
+}
+
+func NewPauseCurrency(fact PauseCurrencyFact) (PauseCurrency, error) {
+	return PauseCurrency{
+		BaseNodeOperation: common.NewBaseNodeOperation(PauseCurrencyHint, fact),
+	}, nil
+}
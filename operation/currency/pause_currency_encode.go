@@ -0,0 +1,12 @@
+package currency
+
+import (
+	"github.com/imfact-labs/currency-model/types"
+)
+
+func (fact *PauseCurrencyFact) unpack(cid string, pause bool) error {
+	fact.currency = types.CurrencyID(cid)
+	fact.pause = pause
+
+	return nil
+}
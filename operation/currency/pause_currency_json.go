@@ -0,0 +1,55 @@
+package currency
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+type PauseCurrencyFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Currency types.CurrencyID `json:"currency"`
+	Pause    bool             `json:"pause"`
+}
+
+func (fact PauseCurrencyFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(PauseCurrencyFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Currency:              fact.currency,
+		Pause:                 fact.pause,
+	})
+}
+
+type PauseCurrencyFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Currency string `json:"currency"`
+	Pause    bool   `json:"pause"`
+}
+
+func (fact *PauseCurrencyFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf PauseCurrencyFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(uf.Currency, uf.Pause); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op *PauseCurrency) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseNodeOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseNodeOperation = ubo
+
+	return nil
+}
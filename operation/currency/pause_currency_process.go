@@ -0,0 +1,159 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state"
+	ccstate "github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/isaac"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var pauseCurrencyProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(PauseCurrencyProcessor)
+	},
+}
+
+func (PauseCurrency) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type PauseCurrencyProcessor struct {
+	*base.BaseOperationProcessor
+	suffrage  base.Suffrage
+	threshold base.Threshold
+}
+
+func NewPauseCurrencyProcessor(threshold base.Threshold) types.GetNewProcessor {
+	return func(height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("create new PauseCurrencyProcessor")
+
+		nopp := pauseCurrencyProcessorPool.Get()
+		opp, ok := nopp.(*PauseCurrencyProcessor)
+		if !ok {
+			return nil, e.Wrap(errors.Errorf("expected %T, not %T", &PauseCurrencyProcessor{}, nopp))
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.threshold = threshold
+
+		switch i, found, err := getStateFunc(isaac.SuffrageStateKey); {
+		case err != nil:
+			return nil, e.Wrap(err)
+		case !found, i == nil:
+			return nil, e.Errorf("Empty state")
+		default:
+			sufstv := i.Value().(base.SuffrageNodesStateValue) //nolint:forcetypeassert //...
+
+			suf, err := sufstv.Suffrage()
+			if err != nil {
+				return nil, e.Errorf("get suffrage from state")
+			}
+
+			opp.suffrage = suf
+		}
+
+		return opp, nil
+	}
+}
+
+func (opp *PauseCurrencyProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	nop, ok := op.(PauseCurrency)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMTypeMismatch).Errorf("expected %T, not %T", PauseCurrency{}, op)), nil
+	}
+
+	if err := base.CheckFactSignsBySuffrage(opp.suffrage, opp.threshold, nop.NodeSigns()); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMSignInvalid).Errorf("%v", common.ErrSignNE)), nil
+	}
+
+	fact, ok := op.Fact().(PauseCurrencyFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMTypeMismatch).Errorf("expected %T, not %T", PauseCurrencyFact{}, op.Fact())), nil
+	}
+
+	st, err := state.ExistsState(ccstate.DesignStateKey(fact.Currency()), "currency design", getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id %q", fact.Currency())), nil
+	}
+
+	de, err := ccstate.GetDesignFromState(st)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).Errorf("%v", err)), nil
+	}
+
+	if de.Paused() == fact.Pause() {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).Errorf(
+				"currency id %q, already paused=%v", fact.Currency(), de.Paused())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *PauseCurrencyProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, ok := op.Fact().(PauseCurrencyFact)
+	if !ok {
+		return nil, nil, errors.Errorf("expected %T, not %T", PauseCurrencyFact{}, op.Fact())
+	}
+
+	st, err := state.ExistsState(ccstate.DesignStateKey(fact.Currency()), "currency design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("check existence of currency id %q; %w", fact.Currency(), err), nil
+	}
+
+	de, err := ccstate.GetDesignFromState(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("get currency design of %v; %w", fact.Currency(), err), nil
+	}
+
+	de.SetPaused(fact.Pause())
+
+	return []base.StateMergeValue{
+		common.NewBaseStateMergeValue(
+			st.Key(),
+			ccstate.NewCurrencyDesignStateValue(de),
+			func(height base.Height, nst base.State) base.StateValueMerger {
+				return ccstate.NewDesignStateValueMerger(height, st.Key(), nst)
+			},
+		),
+	}, nil, nil
+}
+
+func (opp *PauseCurrencyProcessor) Close() error {
+	opp.suffrage = nil
+	opp.threshold = 0
+
+	pauseCurrencyProcessorPool.Put(opp)
+
+	return nil
+}
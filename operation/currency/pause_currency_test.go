@@ -0,0 +1,97 @@
+package currency_test
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/currency"
+	operationtest "github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+func newTestPauseCurrency(
+	t *testing.T, tp *operationtest.TestProcessor, token string, cid types.CurrencyID, pause bool,
+) currency.PauseCurrency {
+	t.Helper()
+
+	op, err := currency.NewPauseCurrency(currency.NewPauseCurrencyFact([]byte(token), cid, pause))
+	if err != nil {
+		t.Fatalf("new pause currency: %v", err)
+	}
+
+	if err := op.NodeSign(tp.NodePriv, tp.NetworkID, tp.NodeAddr); err != nil {
+		t.Fatalf("sign pause currency: %v", err)
+	}
+
+	return op
+}
+
+func TestPauseCurrency(t *testing.T) {
+	tp := newTestProcessor(t, nilFeePolicy())
+
+	sender, _, priv := tp.NewTestAccountState(tp.NewPrivateKey("sender-paused"), true)
+	tp.NewTestBalanceState(sender, tp.GenesisCurrency, 1000, true)
+	receiver, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("receiver-paused"), true)
+
+	_, reason, err := tp.ProcessAt(currency.NewPauseCurrencyProcessor(base.MaxThreshold), base.Height(10),
+		newTestPauseCurrency(t, tp, "pause", tp.GenesisCurrency, true))
+	requireNoReason(t, reason, err)
+
+	if !currencyDesign(t, tp, tp.GenesisCurrency).Paused() {
+		t.Fatal("expected paused currency")
+	}
+
+	reason, err = tp.PreProcessAt(currency.NewTransferProcessor(), base.Height(11), newTestTransfer(t, tp, "transfer-paused",
+		sender, priv, currency.NewTransferItemMultiAmounts(receiver, []types.Amount{amount(tp, 100)})))
+	requireReason(t, reason, err, string(common.ErrMCurrencyPaused))
+
+	reason, err = tp.PreProcessAt(currency.NewPauseCurrencyProcessor(base.MaxThreshold), base.Height(11),
+		newTestPauseCurrency(t, tp, "pause-again", tp.GenesisCurrency, true))
+	requireReason(t, reason, err, "already paused")
+
+	_, reason, err = tp.ProcessAt(currency.NewPauseCurrencyProcessor(base.MaxThreshold), base.Height(12),
+		newTestPauseCurrency(t, tp, "unpause", tp.GenesisCurrency, false))
+	requireNoReason(t, reason, err)
+
+	_, reason, err = tp.ProcessAt(currency.NewTransferProcessor(), base.Height(13), newTestTransfer(t, tp, "transfer-unpaused",
+		sender, priv, currency.NewTransferItemMultiAmounts(receiver, []types.Amount{amount(tp, 100)})))
+	requireNoReason(t, reason, err)
+}
+
+func TestPauseCurrencyRejections(t *testing.T) {
+	tp := newTestProcessor(t, nilFeePolicy())
+
+	reason, err := tp.PreProcessAt(currency.NewPauseCurrencyProcessor(base.MaxThreshold), base.Height(10),
+		newTestPauseCurrency(t, tp, "unpause-not-paused", tp.GenesisCurrency, false))
+	requireReason(t, reason, err, "already paused")
+
+	reason, err = tp.PreProcessAt(currency.NewPauseCurrencyProcessor(base.MaxThreshold), base.Height(10),
+		newTestPauseCurrency(t, tp, "pause-unknown", types.CurrencyID("USD"), true))
+	requireReason(t, reason, err, string(common.ErrMCurrencyNF))
+
+	op, err := currency.NewPauseCurrency(currency.NewPauseCurrencyFact([]byte("pause-not-signed"), tp.GenesisCurrency, true))
+	if err != nil {
+		t.Fatalf("new pause currency: %v", err)
+	}
+
+	reason, err = tp.PreProcessAt(currency.NewPauseCurrencyProcessor(base.MaxThreshold), base.Height(10), op)
+	requireReason(t, reason, err, string(common.ErrMSignInvalid))
+}
+
+func TestPauseCurrencyFactRoundTrip(t *testing.T) {
+	tp := newTestProcessor(t, nilFeePolicy())
+
+	fact := newTestPauseCurrency(t, tp, "pause-round-trip", tp.GenesisCurrency, true).Fact()
+
+	j, b := roundTrip(t, fact)
+	for _, got := range []base.Fact{j, b} {
+		if err := got.IsValid(nil); err != nil {
+			t.Fatalf("invalid decoded %T: %v", got, err)
+		}
+
+		if !got.Hash().Equal(fact.Hash()) || !got.(currency.PauseCurrencyFact).Pause() {
+			t.Fatalf("decoded %T not matched", got)
+		}
+	}
+}
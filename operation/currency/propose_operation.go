@@ -0,0 +1,213 @@
+package currency
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	ProposeOperationFactHint = hint.MustNewHint("mitum-currency-propose-operation-fact-v0.0.1")
+	ProposeOperationHint     = hint.MustNewHint("mitum-currency-propose-operation-v0.0.1")
+)
+
+// proposableOperationHints maps the fact of the operations, which can be
+// proposed, to the operation hint. The operations of node and block processing
+// are not proposable.
+var proposableOperationHints = map[hint.Type]hint.Hint{
+	TransferFactHint.Type():         TransferHint,
+	CreateAccountFactHint.Type():    CreateAccountHint,
+	UpdateKeyFactHint.Type():        UpdateKeyHint,
+	BurnFactHint.Type():             BurnHint,
+	FreezeAccountFactHint.Type():    FreezeAccountHint,
+	ForceTransferFactHint.Type():    ForceTransferHint,
+	CreateVestingFactHint.Type():    CreateVestingHint,
+	ReleaseVestingFactHint.Type():   ReleaseVestingHint,
+	LockTransferFactHint.Type():     LockTransferHint,
+	ClaimTransferFactHint.Type():    ClaimTransferHint,
+	RefundTransferFactHint.Type():   RefundTransferHint,
+	ScheduleTransferFactHint.Type(): ScheduleTransferHint,
+	CancelScheduleFactHint.Type():   CancelScheduleHint,
+	SetGuardiansFactHint.Type():     SetGuardiansHint,
+	CancelRecoveryFactHint.Type():   CancelRecoveryHint,
+}
+
+// ProposableOperationHint returns the operation hint of the proposed fact.
+func ProposableOperationHint(fact base.Fact) (hint.Hint, error) {
+	hinter, ok := fact.(hint.Hinter)
+	if !ok {
+		return hint.Hint{}, errors.Errorf("expected hinted fact, not %T", fact)
+	}
+
+	ht, found := proposableOperationHints[hinter.Hint().Type()]
+	if !found {
+		return hint.Hint{}, errors.Errorf("not proposable fact, %v", hinter.Hint())
+	}
+
+	return ht, nil
+}
+
+// ProposeOperationFact proposes fact, which is executed with the authority of
+// account when the approvals of the account keys pass the threshold before
+// expiry. The id of proposal is the fact hash of ProposeOperationFact.
+type ProposeOperationFact struct {
+	base.BaseFact
+	account  base.Address
+	fact     base.Fact
+	expiry   base.Height
+	currency types.CurrencyID
+}
+
+func NewProposeOperationFact(
+	token []byte,
+	account base.Address,
+	fact base.Fact,
+	expiry base.Height,
+	currency types.CurrencyID,
+) ProposeOperationFact {
+	pfact := ProposeOperationFact{
+		BaseFact: base.NewBaseFact(ProposeOperationFactHint, token),
+		account:  account,
+		fact:     fact,
+		expiry:   expiry,
+		currency: currency,
+	}
+
+	pfact.SetHash(pfact.GenerateHash())
+
+	return pfact
+}
+
+func (fact ProposeOperationFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ProposeOperationFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ProposeOperationFact) Bytes() []byte {
+	var fh []byte
+	if fact.fact != nil && fact.fact.Hash() != nil {
+		fh = fact.fact.Hash().Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.account.Bytes(),
+		fh,
+		fact.expiry.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact ProposeOperationFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.account, fact.expiry, fact.currency); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.fact == nil {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("empty proposed fact")))
+	}
+
+	if _, err := ProposableOperationHint(fact.fact); err != nil {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(err))
+	}
+
+	switch i, ok := fact.fact.(Signer); {
+	case !ok:
+		return common.ErrFactInvalid.Wrap(
+			common.ErrTypeMismatch.Wrap(errors.Errorf("expected Signer, not %T", fact.fact)))
+	case !i.Signer().Equal(fact.account):
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(
+			errors.Errorf("signer of proposed fact, %v is not account, %v", i.Signer(), fact.account)))
+	}
+
+	if err := fact.fact.IsValid(b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact ProposeOperationFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ProposeOperationFact) Account() base.Address {
+	return fact.account
+}
+
+func (fact ProposeOperationFact) Fact() base.Fact {
+	return fact.fact
+}
+
+func (fact ProposeOperationFact) Expiry() base.Height {
+	return fact.expiry
+}
+
+func (fact ProposeOperationFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact ProposeOperationFact) ProposalID() string {
+	return fact.Hash().String()
+}
+
+func (fact ProposeOperationFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{fact.account}
+
+	if i, ok := fact.fact.(types.Addresses); ok {
+		bs, err := i.Addresses()
+		if err != nil {
+			return nil, err
+		}
+
+		for j := range bs {
+			if !bs[j].Equal(fact.account) {
+				as = append(as, bs[j])
+			}
+		}
+	}
+
+	return as, nil
+}
+
+func (fact ProposeOperationFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact ProposeOperationFact) FeePayer() base.Address {
+	return fact.account
+}
+
+func (fact ProposeOperationFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeProposal] = []string{currency.ProposalStateKey(fact.account, fact.ProposalID())}
+
+	return r, nil
+}
+
+// ProposeOperation is signed by the keys of account instead of the threshold
+// of account keys, so it is not extended operation.
+type ProposeOperation struct {
+	common.BaseOperation
+}
+
+func NewProposeOperation(fact ProposeOperationFact) (ProposeOperation, error) {
+	return ProposeOperation{BaseOperation: common.NewBaseOperation(ProposeOperationHint, fact)}, nil
+}
@@ -0,0 +1,27 @@
+package currency
+
+import (
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+func (fact *ProposeOperationFact) unpack(
+	enc encoder.Encoder, ac string, bf []byte, expiry base.Height, cid string,
+) error {
+	switch ad, err := base.DecodeAddress(ac, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.account = ad
+	}
+
+	if err := encoder.Decode(enc, bf, &fact.fact); err != nil {
+		return err
+	}
+
+	fact.expiry = expiry
+	fact.currency = types.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+type ProposeOperationFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Account  base.Address     `json:"account"`
+	Fact     base.Fact        `json:"fact"`
+	Expiry   base.Height      `json:"expiry"`
+	Currency types.CurrencyID `json:"currency"`
+}
+
+func (fact ProposeOperationFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ProposeOperationFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Account:               fact.account,
+		Fact:                  fact.fact,
+		Expiry:                fact.expiry,
+		Currency:              fact.currency,
+	})
+}
+
+type ProposeOperationFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Account  string          `json:"account"`
+	Fact     json.RawMessage `json:"fact"`
+	Expiry   base.Height     `json:"expiry"`
+	Currency string          `json:"currency"`
+}
+
+func (fact *ProposeOperationFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf ProposeOperationFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, uf.Account, uf.Fact, uf.Expiry, uf.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op ProposeOperation) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(op.BaseOperation)
+}
+
+func (op *ProposeOperation) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
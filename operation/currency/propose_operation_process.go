@@ -0,0 +1,162 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var proposeOperationProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ProposeOperationProcessor)
+	},
+}
+
+func (ProposeOperation) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type ProposeOperationProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewProposeOperationProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("create new ProposeOperationProcessor")
+
+		nopp := proposeOperationProcessorPool.Get()
+		opp, ok := nopp.(*ProposeOperationProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected %T, not %T", &ProposeOperationProcessor{}, nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *ProposeOperationProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(ProposeOperationFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", ProposeOperationFact{}, op.Fact())), nil
+	}
+
+	if fact.Expiry() <= opp.Height() {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("expiry, %v should be over current height, %v", fact.Expiry(), opp.Height())), nil
+	}
+
+	if _, rerr := loadAccountKeysOfSigners(fact.Account(), op.Signs(), getStateFunc); rerr != nil {
+		return ctx, rerr, nil
+	}
+
+	if found, _ := state.CheckNotExistsState(
+		currency.ProposalStateKey(fact.Account(), fact.ProposalID()), getStateFunc,
+	); found {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateE).
+				Errorf("proposal, %v of account, %v", fact.ProposalID(), fact.Account())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ProposeOperationProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, _ base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(ProposeOperationFact)
+
+	// NOTE the proposer approves the proposal by proposing.
+	return []base.StateMergeValue{
+		state.NewStateMergeValue(
+			currency.ProposalStateKey(fact.Account(), fact.ProposalID()),
+			currency.NewProposalStateValue(
+				fact.Account(),
+				fact.ProposalID(),
+				fact.Fact(),
+				fact.Expiry(),
+				signersOf(op.Signs()),
+				currency.ProposalPending,
+			),
+		),
+	}, nil, nil
+}
+
+func (opp *ProposeOperationProcessor) Close() error {
+	proposeOperationProcessorPool.Put(opp)
+
+	return nil
+}
+
+// loadAccountKeysOfSigners returns the keys of account; every signer should be
+// one of the keys.
+func loadAccountKeysOfSigners(
+	account base.Address, signs []base.Sign, getStateFunc base.GetStateFunc,
+) (types.AccountKeys, base.OperationProcessReasonError) {
+	ast, _, aErr, cErr := state.ExistsCAccount(account, "account", true, false, getStateFunc)
+	switch {
+	case aErr != nil:
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", aErr))
+	case cErr != nil:
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).Errorf("%v", cErr))
+	}
+
+	keys, err := currency.GetAccountKeysFromState(ast)
+	switch {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).Errorf("keys of account, %v: %v", account, err))
+	case keys == nil:
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).Errorf("empty keys of account, %v", account))
+	}
+
+	for i := range signs {
+		if _, found := keys.Key(signs[i].Signer()); !found {
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMSignInvalid).
+					Errorf("signer, %v is not key of account, %v", signs[i].Signer(), account))
+		}
+	}
+
+	return keys, nil
+}
+
+func signersOf(signs []base.Sign) []base.Publickey {
+	pubs := make([]base.Publickey, len(signs))
+	for i := range signs {
+		pubs[i] = signs[i].Signer()
+	}
+
+	return pubs
+}
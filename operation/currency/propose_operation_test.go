@@ -0,0 +1,243 @@
+package currency_test
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/currency"
+	operationtest "github.com/imfact-labs/currency-model/operation/test"
+	ccstate "github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+)
+
+// newMultisigAccountState sets the account of keys by seeds with weight and
+// threshold.
+func newMultisigAccountState(
+	t *testing.T, tp *operationtest.TestProcessor, weight, threshold uint, seeds ...string,
+) (base.Address, []base.Privatekey) {
+	t.Helper()
+
+	ks, privs := newTestKeys(t, tp, weight, threshold, seeds...)
+
+	account, err := types.NewAccountFromKeys(ks)
+	if err != nil {
+		t.Fatalf("new account: %v", err)
+	}
+
+	tp.SetState(common.NewBaseState(base.Height(1), ccstate.AccountStateKey(account.Address()),
+		ccstate.NewAccountStateValue(account), nil, []util.Hash{}), true)
+
+	return account.Address(), privs
+}
+
+type testProposal struct {
+	tp       *operationtest.TestProcessor
+	account  base.Address
+	privs    []base.Privatekey
+	receiver base.Address
+	fact     currency.ProposeOperationFact
+}
+
+// newTestProposal proposes the transfer of 300 from the account of 3 keys,
+// which needs 3 approvals, until height 20.
+func newTestProposal(t *testing.T) testProposal {
+	t.Helper()
+
+	tp := newTestProcessor(t, nilFeePolicy())
+
+	p := testProposal{tp: tp}
+	p.account, p.privs = newMultisigAccountState(t, tp, 40, 100, "proposal-key-a", "proposal-key-b", "proposal-key-c")
+	tp.NewTestBalanceState(p.account, tp.GenesisCurrency, 1000, true)
+	p.receiver, _, _ = tp.NewTestAccountState(tp.NewPrivateKey("receiver-proposal"), true)
+
+	p.fact = p.propose(t, "propose", p.account, base.Height(20))
+
+	return p
+}
+
+func (p testProposal) propose(
+	t *testing.T, token string, sender base.Address, expiry base.Height,
+) currency.ProposeOperationFact {
+	t.Helper()
+
+	return currency.NewProposeOperationFact([]byte(token), p.account, currency.NewTransferFact(
+		[]byte(token), sender,
+		[]currency.TransferItem{currency.NewTransferItemMultiAmounts(p.receiver, []types.Amount{amount(p.tp, 300)})},
+		p.tp.GenesisCurrency,
+	), expiry, p.tp.GenesisCurrency)
+}
+
+func (p testProposal) signed(t *testing.T, fact currency.ProposeOperationFact, priv base.Privatekey) currency.ProposeOperation {
+	t.Helper()
+
+	op, err := currency.NewProposeOperation(fact)
+	if err != nil {
+		t.Fatalf("new propose operation: %v", err)
+	}
+
+	sign(t, p.tp, &op, priv)
+
+	return op
+}
+
+func (p testProposal) approve(t *testing.T, token string, priv base.Privatekey) currency.ApproveOperation {
+	t.Helper()
+
+	op, err := currency.NewApproveOperation(currency.NewApproveOperationFact(
+		[]byte(token), p.account, p.fact.ProposalID(), p.tp.GenesisCurrency))
+	if err != nil {
+		t.Fatalf("new approve operation: %v", err)
+	}
+
+	sign(t, p.tp, &op, priv)
+
+	return op
+}
+
+func (p testProposal) state(t *testing.T) ccstate.ProposalStateValue {
+	t.Helper()
+
+	st, found, err := p.tp.GetStateFunc(ccstate.ProposalStateKey(p.account, p.fact.ProposalID()))
+	if err != nil || !found {
+		t.Fatalf("expected proposal state, %v", err)
+	}
+
+	return st.Value().(ccstate.ProposalStateValue)
+}
+
+func TestProposeOperationValidation(t *testing.T) {
+	p := newTestProposal(t)
+
+	if err := p.signed(t, p.fact, p.privs[0]).IsValid(p.tp.NetworkID); err != nil {
+		t.Fatalf("invalid propose operation: %v", err)
+	}
+
+	other := p.propose(t, "propose-other-sender", p.receiver, base.Height(20))
+	if err := p.signed(t, other, p.privs[0]).IsValid(p.tp.NetworkID); err == nil {
+		t.Fatal("expected proposed fact of other sender invalid")
+	}
+
+	notProposable := currency.NewProposeOperationFact([]byte("propose-mint"), p.account,
+		currency.NewMintFact([]byte("propose-mint"), p.receiver, amount(p.tp, 300)), base.Height(20), p.tp.GenesisCurrency)
+	if err := p.signed(t, notProposable, p.privs[0]).IsValid(p.tp.NetworkID); err == nil {
+		t.Fatal("expected not proposable fact invalid")
+	}
+}
+
+func TestProposeOperationRejections(t *testing.T) {
+	p := newTestProposal(t)
+
+	reason, err := p.tp.PreProcessAt(currency.NewProposeOperationProcessor(), base.Height(20), p.signed(t, p.fact, p.privs[0]))
+	requireReason(t, reason, err, "should be over current height")
+
+	_, _, strangerPriv := p.tp.NewTestAccountState(p.tp.NewPrivateKey("stranger-proposal"), true)
+
+	reason, err = p.tp.PreProcessAt(currency.NewProposeOperationProcessor(), base.Height(10), p.signed(t, p.fact, strangerPriv))
+	requireReason(t, reason, err, "is not key of account")
+
+	_, reason, err = p.tp.ProcessAt(currency.NewProposeOperationProcessor(), base.Height(10), p.signed(t, p.fact, p.privs[0]))
+	requireNoReason(t, reason, err)
+
+	reason, err = p.tp.PreProcessAt(currency.NewProposeOperationProcessor(), base.Height(10), p.signed(t, p.fact, p.privs[1]))
+	requireReason(t, reason, err, string(common.ErrMStateE))
+}
+
+func TestApproveOperation(t *testing.T) {
+	p := newTestProposal(t)
+
+	reason, err := p.tp.PreProcessAt(currency.NewApproveOperationProcessor(), base.Height(10), p.approve(t, "approve-not-proposed", p.privs[1]))
+	requireReason(t, reason, err, "proposal")
+
+	_, reason, err = p.tp.ProcessAt(currency.NewProposeOperationProcessor(), base.Height(10), p.signed(t, p.fact, p.privs[0]))
+	requireNoReason(t, reason, err)
+
+	if v := p.state(t); v.Status != ccstate.ProposalPending || !v.Approved(p.privs[0].Publickey()) {
+		t.Fatalf("expected pending proposal approved by proposer, %v", v.Status)
+	}
+
+	reason, err = p.tp.PreProcessAt(currency.NewApproveOperationProcessor(), base.Height(11), p.approve(t, "approve-again", p.privs[0]))
+	requireReason(t, reason, err, "already approved")
+
+	_, _, strangerPriv := p.tp.NewTestAccountState(p.tp.NewPrivateKey("stranger-approve"), true)
+
+	reason, err = p.tp.PreProcessAt(currency.NewApproveOperationProcessor(), base.Height(11), p.approve(t, "approve-stranger", strangerPriv))
+	requireReason(t, reason, err, "is not key of account")
+
+	reason, err = p.tp.PreProcessAt(currency.NewApproveOperationProcessor(), base.Height(20), p.approve(t, "approve-expired", p.privs[1]))
+	requireReason(t, reason, err, "expired")
+
+	// NOTE 2 of 3 approvals do not pass the threshold
+	op := p.approve(t, "approve-b", p.privs[1])
+
+	if _, approved, err := currency.ApprovedOperation(op, p.tp.GetStateFunc); err != nil {
+		t.Fatalf("approved operation: %v", err)
+	} else if approved {
+		t.Fatal("expected proposal not approved under threshold")
+	}
+
+	_, reason, err = p.tp.ProcessAt(currency.NewApproveOperationProcessor(), base.Height(11), op)
+	requireNoReason(t, reason, err)
+
+	if v := p.state(t); v.Status != ccstate.ProposalPending || len(v.Approvals) != 2 {
+		t.Fatalf("expected pending proposal with 2 approvals, %v with %d", v.Status, len(v.Approvals))
+	}
+
+	if b := p.tp.Balance(p.receiver, p.tp.GenesisCurrency); !b.IsZero() {
+		t.Fatalf("expected unapproved proposal not executed, not balance %v", b)
+	}
+
+	op = p.approve(t, "approve-c", p.privs[2])
+
+	proposed, approved, err := currency.ApprovedOperation(op, p.tp.GetStateFunc)
+	if err != nil {
+		t.Fatalf("approved operation: %v", err)
+	} else if !approved || !proposed.Fact().Hash().Equal(p.fact.Fact().Hash()) {
+		t.Fatalf("expected proposed transfer approved, %v", approved)
+	}
+
+	_, reason, err = p.tp.ProcessAt(currency.NewApproveOperationProcessor(), base.Height(12), op)
+	requireNoReason(t, reason, err)
+
+	if v := p.state(t); v.Status != ccstate.ProposalExecuted {
+		t.Fatalf("expected executed proposal, not %v", v.Status)
+	}
+
+	reason, err = p.tp.PreProcessAt(currency.NewApproveOperationProcessor(), base.Height(13), p.approve(t, "approve-executed", p.privs[2]))
+	requireReason(t, reason, err, "already executed")
+}
+
+func TestProposalRoundTrip(t *testing.T) {
+	p := newTestProposal(t)
+
+	facts := []base.Fact{p.fact, p.approve(t, "approve-round-trip", p.privs[1]).Fact()}
+
+	for i := range facts {
+		j, b := roundTrip(t, facts[i])
+
+		for _, got := range []base.Fact{j, b} {
+			if err := got.IsValid(nil); err != nil {
+				t.Fatalf("invalid decoded %T: %v", got, err)
+			}
+
+			if !got.Hash().Equal(facts[i].Hash()) {
+				t.Fatalf("decoded %T not matched", got)
+			}
+		}
+	}
+
+	v := ccstate.NewProposalStateValue(p.account, p.fact.ProposalID(), p.fact.Fact(), base.Height(20),
+		[]base.Publickey{p.privs[0].Publickey()}, ccstate.ProposalPending)
+
+	j, b := roundTrip(t, v)
+	for _, got := range []ccstate.ProposalStateValue{j, b} {
+		if err := got.IsValid(nil); err != nil {
+			t.Fatalf("invalid decoded proposal: %v", err)
+		}
+
+		if !got.Fact.Hash().Equal(p.fact.Fact().Hash()) || !got.Approved(p.privs[0].Publickey()) {
+			t.Fatalf("decoded proposal not matched, %+v", got)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package currency
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+)
+
+var (
+	RecoverAccountFactHint = hint.MustNewHint("mitum-currency-recover-account-operation-fact-v0.0.1")
+	RecoverAccountHint     = hint.MustNewHint("mitum-currency-recover-account-operation-v0.0.1")
+)
+
+// RecoverAccountFact starts the recovery of account with the new keys. The
+// keys replace the keys of account by CompleteRecovery after the delay of the
+// guardian set.
+type RecoverAccountFact struct {
+	base.BaseFact
+	account  base.Address
+	keys     types.AccountKeys
+	currency types.CurrencyID
+}
+
+func NewRecoverAccountFact(
+	token []byte, account base.Address, keys types.AccountKeys, currency types.CurrencyID,
+) RecoverAccountFact {
+	fact := RecoverAccountFact{
+		BaseFact: base.NewBaseFact(RecoverAccountFactHint, token),
+		account:  account,
+		keys:     keys,
+		currency: currency,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RecoverAccountFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RecoverAccountFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RecoverAccountFact) Bytes() []byte {
+	var ks []byte
+	if fact.keys != nil {
+		ks = fact.keys.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.account.Bytes(),
+		ks,
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RecoverAccountFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.account, fact.keys, fact.currency); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if _, ok := fact.keys.(types.BaseAccountKeys); !ok {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Errorf("expected BaseAccountKeys but %T", fact.keys))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact RecoverAccountFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RecoverAccountFact) Account() base.Address {
+	return fact.account
+}
+
+func (fact RecoverAccountFact) Keys() types.AccountKeys {
+	return fact.keys
+}
+
+func (fact RecoverAccountFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact RecoverAccountFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.account}, nil
+}
+
+func (fact RecoverAccountFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact RecoverAccountFact) FeePayer() base.Address {
+	return fact.account
+}
+
+func (fact RecoverAccountFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeRecovery] = []string{currency.RecoveryStateKey(fact.account)}
+
+	return r, nil
+}
+
+// RecoverAccount is signed by the guardians of account instead of the keys of
+// account, so it is not extended operation.
+type RecoverAccount struct {
+	common.BaseOperation
+}
+
+func NewRecoverAccount(fact RecoverAccountFact) (RecoverAccount, error) {
+	return RecoverAccount{BaseOperation: common.NewBaseOperation(RecoverAccountHint, fact)}, nil
+}
@@ -0,0 +1,30 @@
+package currency
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+func (fact *RecoverAccountFact) unpack(enc encoder.Encoder, ac string, bks []byte, cid string) error {
+	switch ad, err := base.DecodeAddress(ac, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.account = ad
+	}
+
+	if hinter, err := enc.Decode(bks); err != nil {
+		return err
+	} else if k, ok := hinter.(types.AccountKeys); !ok {
+		return common.ErrTypeMismatch.Wrap(errors.Errorf("expected AccountKeys, not %T", hinter))
+	} else {
+		fact.keys = k
+	}
+
+	fact.currency = types.CurrencyID(cid)
+
+	return nil
+}
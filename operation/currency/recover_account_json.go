@@ -0,0 +1,64 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+type RecoverAccountFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Account  base.Address      `json:"account"`
+	Keys     types.AccountKeys `json:"keys"`
+	Currency types.CurrencyID  `json:"currency"`
+}
+
+func (fact RecoverAccountFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RecoverAccountFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Account:               fact.account,
+		Keys:                  fact.keys,
+		Currency:              fact.currency,
+	})
+}
+
+type RecoverAccountFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Account  string          `json:"account"`
+	Keys     json.RawMessage `json:"keys"`
+	Currency string          `json:"currency"`
+}
+
+func (fact *RecoverAccountFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf RecoverAccountFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, uf.Account, uf.Keys, uf.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op RecoverAccount) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(op.BaseOperation)
+}
+
+func (op *RecoverAccount) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
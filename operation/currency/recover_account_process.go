@@ -0,0 +1,214 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var recoverAccountProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RecoverAccountProcessor)
+	},
+}
+
+func (RecoverAccount) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type RecoverAccountProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewRecoverAccountProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("create new RecoverAccountProcessor")
+
+		nopp := recoverAccountProcessorPool.Get()
+		opp, ok := nopp.(*RecoverAccountProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected %T, not %T", &RecoverAccountProcessor{}, nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *RecoverAccountProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(RecoverAccountFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", RecoverAccountFact{}, op.Fact())), nil
+	}
+
+	ac, rerr := loadRecoverableAccount(fact.Account(), getStateFunc)
+	if rerr != nil {
+		return ctx, rerr, nil
+	}
+
+	if ac.Keys().Equal(fact.Keys()) {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("account keys is same with keys to recover, keys hash %v", fact.Keys().Hash())), nil
+	}
+
+	g, err := loadGuardian(fact.Account(), getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	if err := checkGuardianSigns(op.Signs(), g.Guardians, true); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMSignInvalid).Errorf("%v", err)), nil
+	}
+
+	if _, err := loadPendingRecovery(fact.Account(), getStateFunc); err == nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("recovery of account, %v is already pending", fact.Account())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RecoverAccountProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(RecoverAccountFact)
+
+	g, err := loadGuardian(fact.Account(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	return []base.StateMergeValue{
+		state.NewStateMergeValue(
+			currency.RecoveryStateKey(fact.Account()),
+			currency.NewRecoveryStateValue(
+				fact.Account(),
+				fact.Keys(),
+				opp.Height()+base.Height(g.Delay),
+				currency.RecoveryPending,
+			),
+		),
+	}, nil, nil
+}
+
+func (opp *RecoverAccountProcessor) Close() error {
+	recoverAccountProcessorPool.Put(opp)
+
+	return nil
+}
+
+// loadRecoverableAccount returns the account, which has the keys; the
+// contract account can not be recovered.
+func loadRecoverableAccount(
+	account base.Address, getStateFunc base.GetStateFunc,
+) (types.Account, base.OperationProcessReasonError) {
+	ast, _, aErr, cErr := state.ExistsCAccount(account, "account", true, false, getStateFunc)
+	switch {
+	case aErr != nil:
+		return types.Account{}, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", aErr))
+	case cErr != nil:
+		return types.Account{}, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).Errorf("%v", cErr))
+	}
+
+	ac, err := currency.LoadAccountStateValue(ast)
+	switch {
+	case err != nil:
+		return types.Account{}, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).Errorf("%v: account %v", err, account))
+	case ac == nil || ac.Keys() == nil:
+		return types.Account{}, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).Errorf("empty keys of account, %v", account))
+	}
+
+	if _, ok := ac.Keys().(types.NilAccountKeys); ok {
+		return types.Account{}, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).Errorf("account %v must be multi-sig account", account))
+	}
+
+	return *ac, nil
+}
+
+func loadGuardian(account base.Address, getStateFunc base.GetStateFunc) (currency.GuardianStateValue, error) {
+	st, err := state.ExistsState(currency.GuardianStateKey(account), "guardian", getStateFunc)
+	if err != nil {
+		return currency.GuardianStateValue{}, common.ErrStateNF.Wrap(err)
+	}
+
+	v, err := currency.StateGuardianValue(st)
+	if err != nil {
+		return currency.GuardianStateValue{}, common.ErrStateValInvalid.Wrap(err)
+	}
+
+	return v, nil
+}
+
+func loadPendingRecovery(account base.Address, getStateFunc base.GetStateFunc) (currency.RecoveryStateValue, error) {
+	st, err := state.ExistsState(currency.RecoveryStateKey(account), "recovery", getStateFunc)
+	if err != nil {
+		return currency.RecoveryStateValue{}, common.ErrStateNF.Wrap(err)
+	}
+
+	v, err := currency.StateRecoveryValue(st)
+	if err != nil {
+		return currency.RecoveryStateValue{}, common.ErrStateValInvalid.Wrap(err)
+	}
+
+	if v.Status != currency.RecoveryPending {
+		return currency.RecoveryStateValue{}, common.ErrValueInvalid.Wrap(
+			errors.Errorf("recovery of account, %v is already %v", account, v.Status))
+	}
+
+	return v, nil
+}
+
+// checkGuardianSigns checks every signer is one of guardians; with threshold,
+// the signers should pass the threshold of guardians.
+func checkGuardianSigns(signs []base.Sign, guardians types.AccountKeys, threshold bool) error {
+	signers := signersOf(signs)
+
+	for i := range signers {
+		if _, found := guardians.Key(signers[i]); !found {
+			return errors.Errorf("signer, %v is not guardian", signers[i])
+		}
+	}
+
+	if !threshold {
+		return nil
+	}
+
+	return types.CheckSignersThreshold(signers, guardians)
+}
@@ -0,0 +1,291 @@
+package currency_test
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/operation/currency"
+	operationtest "github.com/imfact-labs/currency-model/operation/test"
+	ccstate "github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type testRecovery struct {
+	tp            *operationtest.TestProcessor
+	account       base.Address
+	accountPriv   base.Privatekey
+	guardians     types.AccountKeys
+	guardianPrivs []base.Privatekey
+	keys          types.AccountKeys
+	keyPrivs      []base.Privatekey
+}
+
+// newTestRecovery sets 2 guardians of the account, which need both to
+// recover with the delay 5 at height 10.
+func newTestRecovery(t *testing.T) testRecovery {
+	t.Helper()
+
+	tp := newTestProcessor(t, nilFeePolicy())
+
+	r := testRecovery{tp: tp}
+	r.account, _, r.accountPriv = tp.NewTestAccountState(tp.NewPrivateKey("account-recovery"), true)
+	r.guardians, r.guardianPrivs = newTestKeys(t, tp, 50, 100, "guardian-a", "guardian-b")
+	r.keys, r.keyPrivs = newTestKeys(t, tp, 100, 100, "recovered-key")
+
+	_, reason, err := tp.ProcessAt(currency.NewSetGuardiansProcessor(), base.Height(10), r.setGuardians(t, "set-guardians", 5))
+	requireNoReason(t, reason, err)
+
+	return r
+}
+
+func (r testRecovery) setGuardians(t *testing.T, token string, delay uint64) currency.SetGuardians {
+	t.Helper()
+
+	op, err := currency.NewSetGuardians(currency.NewSetGuardiansFact(
+		[]byte(token), r.account, r.guardians, delay, r.tp.GenesisCurrency))
+	if err != nil {
+		t.Fatalf("new set guardians: %v", err)
+	}
+
+	sign(t, r.tp, &op, r.accountPriv)
+
+	return op
+}
+
+func (r testRecovery) recover(t *testing.T, token string, keys types.AccountKeys, privs ...base.Privatekey) currency.RecoverAccount {
+	t.Helper()
+
+	op, err := currency.NewRecoverAccount(currency.NewRecoverAccountFact(
+		[]byte(token), r.account, keys, r.tp.GenesisCurrency))
+	if err != nil {
+		t.Fatalf("new recover account: %v", err)
+	}
+
+	for i := range privs {
+		sign(t, r.tp, &op, privs[i])
+	}
+
+	return op
+}
+
+func (r testRecovery) complete(t *testing.T, token string, priv base.Privatekey) currency.CompleteRecovery {
+	t.Helper()
+
+	op, err := currency.NewCompleteRecovery(currency.NewCompleteRecoveryFact(
+		[]byte(token), r.account, r.tp.GenesisCurrency))
+	if err != nil {
+		t.Fatalf("new complete recovery: %v", err)
+	}
+
+	sign(t, r.tp, &op, priv)
+
+	return op
+}
+
+func (r testRecovery) cancel(t *testing.T, token string) currency.CancelRecovery {
+	t.Helper()
+
+	op, err := currency.NewCancelRecovery(currency.NewCancelRecoveryFact(
+		[]byte(token), r.account, r.tp.GenesisCurrency))
+	if err != nil {
+		t.Fatalf("new cancel recovery: %v", err)
+	}
+
+	sign(t, r.tp, &op, r.accountPriv)
+
+	return op
+}
+
+// pending processes the recovery signed by both guardians at height 11.
+func (r testRecovery) pending(t *testing.T) {
+	t.Helper()
+
+	_, reason, err := r.tp.ProcessAt(currency.NewRecoverAccountProcessor(), base.Height(11),
+		r.recover(t, "recover-account", r.keys, r.guardianPrivs...))
+	requireNoReason(t, reason, err)
+}
+
+func (r testRecovery) state(t *testing.T) ccstate.RecoveryStateValue {
+	t.Helper()
+
+	st, found, err := r.tp.GetStateFunc(ccstate.RecoveryStateKey(r.account))
+	if err != nil || !found {
+		t.Fatalf("expected recovery state, %v", err)
+	}
+
+	return st.Value().(ccstate.RecoveryStateValue)
+}
+
+func TestSetGuardiansValidation(t *testing.T) {
+	r := newTestRecovery(t)
+
+	if err := r.setGuardians(t, "zero-delay", 0).IsValid(r.tp.NetworkID); err == nil {
+		t.Fatal("expected zero recovery delay invalid")
+	}
+}
+
+func TestSetGuardiansRejections(t *testing.T) {
+	r := newTestRecovery(t)
+
+	r.account, r.accountPriv = r.tp.NewTestContractAccountState(r.tp.GenesisAddr, r.tp.NewPrivateKey("contract-recovery"), true)
+
+	reason, err := r.tp.PreProcessAt(currency.NewSetGuardiansProcessor(), base.Height(10), r.setGuardians(t, "contract", 5))
+	requireReason(t, reason, err, "Contract account not allowed")
+
+	f := newTestFreeze(t, false)
+	r.tp, r.account, r.accountPriv = f.tp, f.holder, f.holderPriv
+
+	_, reason, err = f.tp.ProcessAt(currency.NewFreezeAccountProcessor(), base.Height(10),
+		f.freeze(t, "freeze-guardians", f.authority, f.authorityPriv, true))
+	requireNoReason(t, reason, err)
+
+	reason, err = r.tp.PreProcessAt(currency.NewSetGuardiansProcessor(), base.Height(11), r.setGuardians(t, "frozen", 5))
+	requireReason(t, reason, err, "frozen")
+
+	r = newTestRecovery(t)
+	r.pending(t)
+
+	reason, err = r.tp.PreProcessAt(currency.NewSetGuardiansProcessor(), base.Height(12), r.setGuardians(t, "under-recovery", 5))
+	requireReason(t, reason, err, "is pending")
+}
+
+func TestRecoverAccountRejections(t *testing.T) {
+	r := newTestRecovery(t)
+
+	other, _, _ := r.tp.NewTestAccountState(r.tp.NewPrivateKey("account-without-guardians"), true)
+	withoutGuardians := r
+	withoutGuardians.account = other
+
+	reason, err := r.tp.PreProcessAt(currency.NewRecoverAccountProcessor(), base.Height(11),
+		withoutGuardians.recover(t, "without-guardians", r.keys, r.guardianPrivs...))
+	requireReason(t, reason, err, "guardian")
+
+	reason, err = r.tp.PreProcessAt(currency.NewRecoverAccountProcessor(), base.Height(11),
+		r.recover(t, "not-guardian", r.keys, r.guardianPrivs[0], r.keyPrivs[0]))
+	requireReason(t, reason, err, "is not guardian")
+
+	// NOTE one guardian can not pass the threshold of guardians.
+	reason, err = r.tp.PreProcessAt(currency.NewRecoverAccountProcessor(), base.Height(11),
+		r.recover(t, "one-guardian", r.keys, r.guardianPrivs[0]))
+	requireReason(t, reason, err, "threshold")
+
+	st, _, _ := r.tp.GetStateFunc(ccstate.AccountStateKey(r.account))
+	ac, _ := ccstate.LoadAccountStateValue(st)
+
+	reason, err = r.tp.PreProcessAt(currency.NewRecoverAccountProcessor(), base.Height(11),
+		r.recover(t, "same-keys", ac.Keys(), r.guardianPrivs...))
+	requireReason(t, reason, err, "same with keys to recover")
+
+	r.pending(t)
+
+	reason, err = r.tp.PreProcessAt(currency.NewRecoverAccountProcessor(), base.Height(12),
+		r.recover(t, "recover-again", r.keys, r.guardianPrivs...))
+	requireReason(t, reason, err, "already pending")
+}
+
+func TestCompleteRecovery(t *testing.T) {
+	r := newTestRecovery(t)
+
+	reason, err := r.tp.PreProcessAt(currency.NewCompleteRecoveryProcessor(), base.Height(16), r.complete(t, "not-recovered", r.guardianPrivs[1]))
+	requireReason(t, reason, err, "recovery")
+
+	r.pending(t)
+
+	if v := r.state(t); v.Status != ccstate.RecoveryPending || v.Ready != base.Height(16) {
+		t.Fatalf("expected pending recovery ready at 16, not %v at %v", v.Status, v.Ready)
+	}
+
+	reason, err = r.tp.PreProcessAt(currency.NewCompleteRecoveryProcessor(), base.Height(15), r.complete(t, "not-ready", r.guardianPrivs[1]))
+	requireReason(t, reason, err, "not ready")
+
+	reason, err = r.tp.PreProcessAt(currency.NewCompleteRecoveryProcessor(), base.Height(16), r.complete(t, "not-guardian", r.keyPrivs[0]))
+	requireReason(t, reason, err, "is not guardian")
+
+	_, reason, err = r.tp.ProcessAt(currency.NewCompleteRecoveryProcessor(), base.Height(16), r.complete(t, "complete", r.guardianPrivs[1]))
+	requireNoReason(t, reason, err)
+
+	st, _, _ := r.tp.GetStateFunc(ccstate.AccountStateKey(r.account))
+	if ac, err := ccstate.LoadAccountStateValue(st); err != nil || !ac.Keys().Equal(r.keys) {
+		t.Fatalf("expected account keys recovered, %v", err)
+	}
+
+	if v := r.state(t); v.Status != ccstate.RecoveryCompleted {
+		t.Fatalf("expected completed recovery, not %v", v.Status)
+	}
+
+	reason, err = r.tp.PreProcessAt(currency.NewCompleteRecoveryProcessor(), base.Height(17), r.complete(t, "complete-again", r.guardianPrivs[1]))
+	requireReason(t, reason, err, "already completed")
+}
+
+func TestCancelRecovery(t *testing.T) {
+	r := newTestRecovery(t)
+
+	reason, err := r.tp.PreProcessAt(currency.NewCancelRecoveryProcessor(), base.Height(12), r.cancel(t, "not-recovered"))
+	requireReason(t, reason, err, "recovery")
+
+	r.pending(t)
+
+	_, reason, err = r.tp.ProcessAt(currency.NewCancelRecoveryProcessor(), base.Height(12), r.cancel(t, "cancel"))
+	requireNoReason(t, reason, err)
+
+	if v := r.state(t); v.Status != ccstate.RecoveryCancelled {
+		t.Fatalf("expected cancelled recovery, not %v", v.Status)
+	}
+
+	reason, err = r.tp.PreProcessAt(currency.NewCompleteRecoveryProcessor(), base.Height(16), r.complete(t, "complete-cancelled", r.guardianPrivs[1]))
+	requireReason(t, reason, err, "already cancelled")
+
+	reason, err = r.tp.PreProcessAt(currency.NewCancelRecoveryProcessor(), base.Height(16), r.cancel(t, "cancel-again"))
+	requireReason(t, reason, err, "already cancelled")
+}
+
+func TestRecoveryRoundTrip(t *testing.T) {
+	r := newTestRecovery(t)
+
+	facts := []base.Fact{
+		r.setGuardians(t, "set-guardians-round-trip", 5).Fact(),
+		r.recover(t, "recover-round-trip", r.keys, r.guardianPrivs...).Fact(),
+		r.complete(t, "complete-round-trip", r.guardianPrivs[0]).Fact(),
+		r.cancel(t, "cancel-round-trip").Fact(),
+	}
+
+	for i := range facts {
+		j, b := roundTrip(t, facts[i])
+
+		for _, got := range []base.Fact{j, b} {
+			if err := got.IsValid(nil); err != nil {
+				t.Fatalf("invalid decoded %T: %v", got, err)
+			}
+
+			if !got.Hash().Equal(facts[i].Hash()) {
+				t.Fatalf("decoded %T not matched", got)
+			}
+		}
+	}
+
+	guardian := ccstate.NewGuardianStateValue(r.account, r.guardians, 5)
+
+	gj, gb := roundTrip(t, guardian)
+	for _, got := range []ccstate.GuardianStateValue{gj, gb} {
+		if err := got.IsValid(nil); err != nil {
+			t.Fatalf("invalid decoded guardian: %v", err)
+		}
+
+		if !got.Guardians.Equal(r.guardians) || got.Delay != 5 {
+			t.Fatalf("decoded guardian not matched, %+v", got)
+		}
+	}
+
+	recovery := ccstate.NewRecoveryStateValue(r.account, r.keys, base.Height(16), ccstate.RecoveryPending)
+
+	rj, rb := roundTrip(t, recovery)
+	for _, got := range []ccstate.RecoveryStateValue{rj, rb} {
+		if err := got.IsValid(nil); err != nil {
+			t.Fatalf("invalid decoded recovery: %v", err)
+		}
+
+		if !got.Keys.Equal(r.keys) || got.Ready != recovery.Ready || got.Status != recovery.Status {
+			t.Fatalf("decoded recovery not matched, %+v", got)
+		}
+	}
+}
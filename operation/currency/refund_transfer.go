@@ -0,0 +1,144 @@
+package currency
+
+import (
+	"fmt"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+)
+
+var (
+	RefundTransferFactHint = hint.MustNewHint("mitum-currency-refund-transfer-operation-fact-v0.0.1")
+	RefundTransferHint     = hint.MustNewHint("mitum-currency-refund-transfer-operation-v0.0.1")
+)
+
+// RefundTransferFact moves the amount of expired transfer lock, which was
+// locked by sender under hashlock, back to the balance of sender.
+type RefundTransferFact struct {
+	base.BaseFact
+	sender   base.Address
+	hashlock string
+	currency types.CurrencyID
+}
+
+func NewRefundTransferFact(
+	token []byte, sender base.Address, hashlock string, currency types.CurrencyID,
+) RefundTransferFact {
+	fact := RefundTransferFact{
+		BaseFact: base.NewBaseFact(RefundTransferFactHint, token),
+		sender:   sender,
+		hashlock: hashlock,
+		currency: currency,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RefundTransferFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RefundTransferFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RefundTransferFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		[]byte(fact.hashlock),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RefundTransferFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.currency); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := isValidTransferHashlock(fact.hashlock); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact RefundTransferFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RefundTransferFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RefundTransferFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact RefundTransferFact) Hashlock() string {
+	return fact.hashlock
+}
+
+func (fact RefundTransferFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact RefundTransferFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+func (fact RefundTransferFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact RefundTransferFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact RefundTransferFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact RefundTransferFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fmt.Sprintf("%s:%s", fact.sender.String(), fact.currency.String())}
+	r[extras.DuplicationKeyTypeTransferLock] = []string{currency.TransferLockStateKey(fact.sender, fact.hashlock)}
+
+	return r, nil
+}
+
+type RefundTransfer struct {
+	extras.ExtendedOperation
+}
+
+func (op RefundTransfer) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewRefundTransfer(fact RefundTransferFact) (RefundTransfer, error) {
+	return RefundTransfer{
+		ExtendedOperation: extras.NewExtendedOperation(RefundTransferHint, fact),
+	}, nil
+}
@@ -0,0 +1,129 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/state/extension"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var refundTransferProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RefundTransferProcessor)
+	},
+}
+
+func (RefundTransfer) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type RefundTransferProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewRefundTransferProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("create new RefundTransferProcessor")
+
+		nopp := refundTransferProcessorPool.Get()
+		opp, ok := nopp.(*RefundTransferProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected %T, not %T", &RefundTransferProcessor{}, nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *RefundTransferProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(RefundTransferFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", RefundTransferFact{}, op.Fact())), nil
+	}
+
+	if _, err := state.ExistsAccount(fact.Sender(), "sender", true, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	if err := extension.CheckDepositAllowed(fact.Sender(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	v, rerr := loadOpenTransferLock(fact.Sender(), fact.Hashlock(), getStateFunc)
+	if rerr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", rerr)), nil
+	}
+
+	if opp.Height() < v.Expiry {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("transfer lock not expired until height, %v", v.Expiry)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RefundTransferProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(RefundTransferFact)
+
+	v, rerr := loadOpenTransferLock(fact.Sender(), fact.Hashlock(), getStateFunc)
+	if rerr != nil {
+		return nil, rerr, nil
+	}
+
+	bk := currency.BalanceStateKey(v.Sender, v.Amount.Currency())
+
+	return []base.StateMergeValue{
+		common.NewBaseStateMergeValue(
+			bk,
+			currency.NewAddBalanceStateValue(v.Amount),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return currency.NewBalanceStateValueMerger(height, bk, v.Amount.Currency(), st)
+			},
+		),
+		state.NewStateMergeValue(
+			currency.TransferLockStateKey(v.Sender, v.Hashlock),
+			currency.NewTransferLockStateValue(
+				v.Sender, v.Receiver, v.Amount, v.Hashlock, v.Expiry, currency.TransferLockRefunded, ""),
+		),
+	}, nil, nil
+}
+
+func (opp *RefundTransferProcessor) Close() error {
+	refundTransferProcessorPool.Put(opp)
+
+	return nil
+}
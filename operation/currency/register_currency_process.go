@@ -114,13 +114,20 @@ func (opp *RegisterCurrencyProcessor) PreProcess(
 			common.ErrMPreProcess.Errorf("%v", err)), nil
 	}
 
-	if receiver := design.Policy().Feeer().Receiver(); receiver != nil {
+	for _, receiver := range types.FeeerReceivers(design.Policy().Feeer()) {
 		if _, err := state.ExistsAccount(receiver, "feeer receiver", true, getStateFunc); err != nil {
 			return ctx, base.NewBaseOperationProcessReasonError(
 				common.ErrMPreProcess.Errorf("%v", err)), nil
 		}
 	}
 
+	if fc, ok := design.Policy().FeeCurrency(); ok {
+		if err := state.CheckExistsState(currency.DesignStateKey(fc.Currency()), getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("fee currency id %q", fc.Currency())), nil
+		}
+	}
+
 	switch _, found, err := getStateFunc(currency.DesignStateKey(design.Currency())); {
 	case err != nil:
 		return ctx, nil, err
@@ -0,0 +1,131 @@
+package currency
+
+import (
+	"fmt"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+)
+
+var (
+	ReleaseVestingFactHint = hint.MustNewHint("mitum-currency-release-vesting-operation-fact-v0.0.1")
+	ReleaseVestingHint     = hint.MustNewHint("mitum-currency-release-vesting-operation-v0.0.1")
+)
+
+// ReleaseVestingFact moves the vested amount of currency from the vesting
+// schedule of sender to the balance of sender.
+type ReleaseVestingFact struct {
+	base.BaseFact
+	sender   base.Address
+	currency types.CurrencyID
+}
+
+func NewReleaseVestingFact(token []byte, sender base.Address, currency types.CurrencyID) ReleaseVestingFact {
+	fact := ReleaseVestingFact{
+		BaseFact: base.NewBaseFact(ReleaseVestingFactHint, token),
+		sender:   sender,
+		currency: currency,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ReleaseVestingFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ReleaseVestingFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ReleaseVestingFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact ReleaseVestingFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.currency); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact ReleaseVestingFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ReleaseVestingFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ReleaseVestingFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact ReleaseVestingFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact ReleaseVestingFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+func (fact ReleaseVestingFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact ReleaseVestingFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact ReleaseVestingFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact ReleaseVestingFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fmt.Sprintf("%s:%s", fact.sender.String(), fact.currency.String())}
+	r[extras.DuplicationKeyTypeVesting] = []string{currency.VestingStateKey(fact.sender, fact.currency)}
+
+	return r, nil
+}
+
+type ReleaseVesting struct {
+	extras.ExtendedOperation
+}
+
+func (op ReleaseVesting) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewReleaseVesting(fact ReleaseVestingFact) (ReleaseVesting, error) {
+	return ReleaseVesting{
+		ExtendedOperation: extras.NewExtendedOperation(ReleaseVestingHint, fact),
+	}, nil
+}
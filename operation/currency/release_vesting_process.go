@@ -0,0 +1,159 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/state/extension"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var releaseVestingProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ReleaseVestingProcessor)
+	},
+}
+
+func (ReleaseVesting) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type ReleaseVestingProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewReleaseVestingProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("create new ReleaseVestingProcessor")
+
+		nopp := releaseVestingProcessorPool.Get()
+		opp, ok := nopp.(*ReleaseVestingProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected %T, not %T", &ReleaseVestingProcessor{}, nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *ReleaseVestingProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(ReleaseVestingFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", ReleaseVestingFact{}, op.Fact())), nil
+	}
+
+	if _, err := state.ExistsAccount(fact.Sender(), "sender", true, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	if err := state.CheckCurrencyNotPaused(fact.Currency(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	if err := extension.CheckDepositAllowed(fact.Sender(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	st, err := state.ExistsState(currency.VestingStateKey(fact.Sender(), fact.Currency()), "vesting", getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateNF).Errorf("%v", err)), nil
+	}
+
+	v, err := currency.StateVestingValue(st)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).Errorf("%v", err)), nil
+	}
+
+	releasable := v.Releasable(opp.Height())
+	if !releasable.OverZero() {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("nothing to release of currency, %v at height, %v", fact.Currency(), opp.Height())), nil
+	}
+
+	totals := NewReceiverTotals()
+	totals.Add(fact.Sender(), types.NewAmount(releasable, fact.Currency()))
+
+	if err := totals.CheckMaxBalance(getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ReleaseVestingProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(ReleaseVestingFact)
+
+	vk := currency.VestingStateKey(fact.Sender(), fact.Currency())
+
+	st, err := state.ExistsState(vk, "vesting", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMStateNF.Errorf("%v", err)), nil
+	}
+
+	v, err := currency.StateVestingValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMStateValInvalid.Errorf("%v", err)), nil
+	}
+
+	releasable := v.Releasable(opp.Height())
+	am := types.NewAmount(releasable, fact.Currency())
+	bk := currency.BalanceStateKey(fact.Sender(), fact.Currency())
+
+	return []base.StateMergeValue{
+		common.NewBaseStateMergeValue(
+			bk,
+			currency.NewAddBalanceStateValue(am),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return currency.NewBalanceStateValueMerger(height, bk, fact.Currency(), st)
+			},
+		),
+		state.NewStateMergeValue(
+			vk,
+			currency.NewVestingStateValue(v.Amount, v.Released.Add(releasable), v.Start, v.Cliff, v.End),
+		),
+	}, nil, nil
+}
+
+func (opp *ReleaseVestingProcessor) Close() error {
+	releaseVestingProcessorPool.Put(opp)
+
+	return nil
+}
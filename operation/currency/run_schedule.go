@@ -0,0 +1,176 @@
+package currency
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	RunScheduleFactHint = hint.MustNewHint("mitum-currency-run-schedule-operation-fact-v0.0.1")
+	RunScheduleHint     = hint.MustNewHint("mitum-currency-run-schedule-operation-v0.0.1")
+)
+
+// RunScheduleFact is the run of the schedule of sender at height. It is not
+// requested by user; the block processing makes it for each due schedule at
+// the start of the block, so it has no signs. The fee of run is paid by
+// sender.
+type RunScheduleFact struct {
+	base.BaseFact
+	sender   base.Address
+	schedule string
+	receiver base.Address
+	amount   types.Amount
+	height   base.Height
+}
+
+func NewRunScheduleFact(
+	sender base.Address,
+	schedule string,
+	receiver base.Address,
+	amount types.Amount,
+	height base.Height,
+) RunScheduleFact {
+	fact := RunScheduleFact{
+		BaseFact: base.NewBaseFact(RunScheduleFactHint, height.Bytes()),
+		sender:   sender,
+		schedule: schedule,
+		receiver: receiver,
+		amount:   amount,
+		height:   height,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RunScheduleFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RunScheduleFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RunScheduleFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		[]byte(fact.schedule),
+		fact.receiver.Bytes(),
+		fact.amount.Bytes(),
+		fact.height.Bytes(),
+	)
+}
+
+func (fact RunScheduleFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.receiver, fact.amount, fact.height); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := isValidScheduleID(fact.schedule); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if !fact.amount.Big().OverZero() {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("Under zero amount of RunSchedule")))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact RunScheduleFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RunScheduleFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RunScheduleFact) Schedule() string {
+	return fact.schedule
+}
+
+func (fact RunScheduleFact) Receiver() base.Address {
+	return fact.receiver
+}
+
+func (fact RunScheduleFact) Amount() types.Amount {
+	return fact.amount
+}
+
+func (fact RunScheduleFact) Height() base.Height {
+	return fact.height
+}
+
+func (fact RunScheduleFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.receiver, fact.sender}, nil
+}
+
+func (fact RunScheduleFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.amount.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact RunScheduleFact) FeeAmounts() []common.Big {
+	return []common.Big{fact.amount.Big()}
+}
+
+func (fact RunScheduleFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact RunScheduleFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSchedule] = []string{currency.ScheduleStateKey(fact.sender, fact.schedule)}
+
+	return r, nil
+}
+
+type RunSchedule struct {
+	common.BaseOperation
+}
+
+func NewRunSchedule(fact RunScheduleFact) RunSchedule {
+	op := RunSchedule{BaseOperation: common.NewBaseOperation(RunScheduleHint, fact)}
+	op.SetHash(valuehash.NewSHA256(op.HashBytes()))
+
+	return op
+}
+
+func (op RunSchedule) IsValid(networkID []byte) error {
+	return isValidUnsignedOperation(op.BaseOperation, networkID)
+}
+
+// isValidUnsignedOperation checks the operation, which is made by the block
+// processing instead of user; it should not have signs.
+func isValidUnsignedOperation(op common.BaseOperation, networkID []byte) error {
+	if len(op.Signs()) > 0 {
+		return common.ErrOperationInvalid.Wrap(
+			common.ErrSignInvalid.Wrap(errors.Errorf("%T should not be signed", op.Fact())))
+	}
+
+	if err := util.CheckIsValiders(networkID, false, op.Hash(), op.Fact()); err != nil {
+		return common.ErrOperationInvalid.Wrap(err)
+	}
+
+	if !op.Hash().Equal(valuehash.NewSHA256(op.HashBytes())) {
+		return common.ErrOperationInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("wrong operation hash")))
+	}
+
+	return nil
+}
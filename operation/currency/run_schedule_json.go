@@ -0,0 +1,59 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+type RunScheduleFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address `json:"sender"`
+	Schedule string       `json:"schedule"`
+	Receiver base.Address `json:"receiver"`
+	Amount   types.Amount `json:"amount"`
+	Height   base.Height  `json:"height"`
+}
+
+func (fact RunScheduleFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RunScheduleFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Schedule:              fact.schedule,
+		Receiver:              fact.receiver,
+		Amount:                fact.amount,
+		Height:                fact.height,
+	})
+}
+
+type RunScheduleFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string          `json:"sender"`
+	Schedule string          `json:"schedule"`
+	Receiver string          `json:"receiver"`
+	Amount   json.RawMessage `json:"amount"`
+	Height   base.Height     `json:"height"`
+}
+
+func (fact *RunScheduleFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf RunScheduleFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, uf.Sender, uf.Schedule, uf.Receiver, uf.Amount, uf.Height); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op RunSchedule) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(op.BaseOperation)
+}
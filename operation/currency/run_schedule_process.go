@@ -0,0 +1,269 @@
+package currency
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/state/extension"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var runScheduleProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RunScheduleProcessor)
+	},
+}
+
+// DueSchedules returns the runs of the active schedules, which are due at
+// height, in the order of schedule key.
+func DueSchedules(height base.Height, getStateFunc base.GetStateFunc) ([]RunSchedule, error) {
+	e := util.StringError("due schedules")
+
+	var queue currency.ScheduleQueueStateValue
+
+	switch st, found, err := getStateFunc(currency.ScheduleQueueStateKey(height)); {
+	case err != nil:
+		return nil, e.Wrap(err)
+	case !found:
+		return nil, nil
+	default:
+		i, err := currency.StateScheduleQueueValue(st)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		queue = i
+	}
+
+	var ops []RunSchedule
+
+	for i := range queue.Keys {
+		key := queue.Keys[i]
+
+		st, found, err := getStateFunc(key)
+		switch {
+		case err != nil:
+			return nil, e.Wrap(err)
+		case !found:
+			continue
+		}
+
+		v, err := currency.StateScheduleValue(st)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		// NOTE cancelled or moved schedule is ignored.
+		if v.Status != currency.ScheduleActive || v.Next != height {
+			continue
+		}
+
+		id := strings.TrimSuffix(strings.TrimPrefix(key, v.Sender.String()+":"), currency.ScheduleStateKeySuffix)
+
+		ops = append(ops, NewRunSchedule(NewRunScheduleFact(v.Sender, id, v.Receiver, v.Amount, height)))
+	}
+
+	return ops, nil
+}
+
+func (RunSchedule) PreProcess(
+	ctx context.Context, _ base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	return ctx, base.NewBaseOperationProcessReasonError(
+		common.ErrMPreProcess.Errorf("RunSchedule can be made only by block processing")), nil
+}
+
+func (RunSchedule) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type RunScheduleProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewRunScheduleProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("create new RunScheduleProcessor")
+
+		nopp := runScheduleProcessorPool.Get()
+		opp, ok := nopp.(*RunScheduleProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected %T, not %T", &RunScheduleProcessor{}, nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *RunScheduleProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(RunScheduleFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", RunScheduleFact{}, op.Fact())), nil
+	}
+
+	v, rerr := loadDueSchedule(fact.Sender(), fact.Schedule(), opp.Height(), getStateFunc)
+	if rerr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", rerr)), nil
+	}
+
+	if !v.Receiver.Equal(fact.Receiver()) || !v.Amount.Equal(fact.Amount()) {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("run does not match with schedule, %v", fact.Schedule())), nil
+	}
+
+	cid := fact.Amount().Currency()
+
+	if err := state.CheckCurrencyNotPaused(cid, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	if err := state.CheckAccountNotFrozen(fact.Sender(), []types.CurrencyID{cid}, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	if _, err := state.ExistsAccount(fact.Receiver(), "receiver", true, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	if err := extension.CheckDepositAllowed(fact.Receiver(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	if err := CheckTransferLimits(fact.Receiver(), fact.Amount(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	bst, err := state.ExistsState(currency.BalanceStateKey(fact.Sender(), cid), "sender balance", getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateNF).Errorf("%v", err)), nil
+	}
+
+	balance, err := currency.StateBalanceValue(bst)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).Errorf("%v", err)), nil
+	}
+
+	if balance.Big().Compare(fact.Amount().Big()) < 0 {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("insufficient balance of currency, %v of sender, %v", cid, fact.Sender())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RunScheduleProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(RunScheduleFact)
+
+	v, rerr := loadDueSchedule(fact.Sender(), fact.Schedule(), opp.Height(), getStateFunc)
+	if rerr != nil {
+		return nil, rerr, nil
+	}
+
+	am := fact.Amount()
+	sk := currency.BalanceStateKey(fact.Sender(), am.Currency())
+	rk := currency.BalanceStateKey(fact.Receiver(), am.Currency())
+
+	outflowValues, err := PrepareOutflowLimits(fact.Sender(), []types.Amount{am}, opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	stvs := []base.StateMergeValue{
+		common.NewBaseStateMergeValue(
+			sk,
+			currency.NewDeductBalanceStateValue(am),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return currency.NewBalanceStateValueMerger(height, sk, am.Currency(), st)
+			},
+		),
+		common.NewBaseStateMergeValue(
+			rk,
+			currency.NewAddBalanceStateValue(am),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return currency.NewBalanceStateValueMerger(height, rk, am.Currency(), st)
+			},
+		),
+	}
+
+	stvs = append(stvs, outflowValues...)
+
+	return append(stvs, nextScheduleStateMergeValues(fact.Sender(), fact.Schedule(), v.Run())...), nil, nil
+}
+
+func (opp *RunScheduleProcessor) Close() error {
+	runScheduleProcessorPool.Put(opp)
+
+	return nil
+}
+
+// loadDueSchedule loads the active schedule, which is due at height.
+func loadDueSchedule(
+	sender base.Address, id string, height base.Height, getStateFunc base.GetStateFunc,
+) (currency.ScheduleStateValue, base.OperationProcessReasonError) {
+	v, rerr := loadActiveSchedule(sender, id, getStateFunc)
+	if rerr != nil {
+		return v, rerr
+	}
+
+	if v.Next != height {
+		return currency.ScheduleStateValue{}, base.NewBaseOperationProcessReasonError(
+			common.ErrMValueInvalid.Errorf("schedule, %v not due at height, %v; next is %v", id, height, v.Next))
+	}
+
+	return v, nil
+}
+
+// nextScheduleStateMergeValues stores the updated schedule and, if it is still
+// active, enqueues it at the next height.
+func nextScheduleStateMergeValues(
+	sender base.Address, id string, v currency.ScheduleStateValue,
+) []base.StateMergeValue {
+	key := currency.ScheduleStateKey(sender, id)
+
+	stvs := []base.StateMergeValue{newScheduleStateMergeValue(key, v)}
+	if v.Status == currency.ScheduleActive {
+		stvs = append(stvs, newScheduleQueueStateMergeValue(v.Next, key))
+	}
+
+	return stvs
+}
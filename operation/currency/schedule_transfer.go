@@ -0,0 +1,224 @@
+package currency
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	ScheduleTransferFactHint = hint.MustNewHint("mitum-currency-schedule-transfer-operation-fact-v0.0.1")
+	ScheduleTransferHint     = hint.MustNewHint("mitum-currency-schedule-transfer-operation-v0.0.1")
+)
+
+const MaxScheduleIDSize = 100
+
+func isValidScheduleID(id string) error {
+	switch l := len(id); {
+	case l < 1:
+		return common.ErrValueInvalid.Wrap(errors.Errorf("empty schedule id"))
+	case l > MaxScheduleIDSize:
+		return common.ErrValueInvalid.Wrap(errors.Errorf("schedule id too long, %d > %d", l, MaxScheduleIDSize))
+	case strings.Contains(id, ":"):
+		return common.ErrValueInvalid.Wrap(errors.Errorf("invalid schedule id, %q", id))
+	default:
+		return nil
+	}
+}
+
+// ScheduleTransferFact stores the standing order of sender, which transfers
+// amount to receiver from start height. With interval 0 the order runs once;
+// otherwise it runs every interval blocks for times periods, or until it is
+// cancelled if times is 0. onInsufficient decides whether the run, which
+// can not be made, is skipped or cancels the order. The fact hash becomes the
+// id of the schedule.
+type ScheduleTransferFact struct {
+	base.BaseFact
+	sender         base.Address
+	receiver       base.Address
+	amount         types.Amount
+	start          base.Height
+	interval       uint64
+	times          uint64
+	onInsufficient currency.ScheduleMissPolicy
+	currency       types.CurrencyID
+}
+
+func NewScheduleTransferFact(
+	token []byte,
+	sender base.Address,
+	receiver base.Address,
+	amount types.Amount,
+	start base.Height,
+	interval uint64,
+	times uint64,
+	onInsufficient currency.ScheduleMissPolicy,
+	currency types.CurrencyID,
+) ScheduleTransferFact {
+	fact := ScheduleTransferFact{
+		BaseFact:       base.NewBaseFact(ScheduleTransferFactHint, token),
+		sender:         sender,
+		receiver:       receiver,
+		amount:         amount,
+		start:          start,
+		interval:       interval,
+		times:          times,
+		onInsufficient: onInsufficient,
+		currency:       currency,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ScheduleTransferFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ScheduleTransferFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ScheduleTransferFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.receiver.Bytes(),
+		fact.amount.Bytes(),
+		fact.start.Bytes(),
+		util.Uint64ToBytes(fact.interval),
+		util.Uint64ToBytes(fact.times),
+		[]byte(fact.onInsufficient),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact ScheduleTransferFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false, fact.sender, fact.receiver, fact.amount, fact.start, fact.onInsufficient, fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.receiver) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("receiver is same with sender, %v", fact.sender)))
+	}
+
+	if !fact.amount.Big().OverZero() {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("Under zero amount of ScheduleTransfer")))
+	}
+
+	if fact.interval < 1 && fact.times > 1 {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrValueInvalid.Wrap(errors.Errorf("one-shot schedule can not run %d times", fact.times)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact ScheduleTransferFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ScheduleTransferFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ScheduleTransferFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact ScheduleTransferFact) Receiver() base.Address {
+	return fact.receiver
+}
+
+func (fact ScheduleTransferFact) Amount() types.Amount {
+	return fact.amount
+}
+
+func (fact ScheduleTransferFact) Start() base.Height {
+	return fact.start
+}
+
+func (fact ScheduleTransferFact) Interval() uint64 {
+	return fact.interval
+}
+
+func (fact ScheduleTransferFact) Times() uint64 {
+	return fact.times
+}
+
+func (fact ScheduleTransferFact) OnInsufficient() currency.ScheduleMissPolicy {
+	return fact.onInsufficient
+}
+
+func (fact ScheduleTransferFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+// ScheduleID returns the id of the schedule stored by fact.
+func (fact ScheduleTransferFact) ScheduleID() string {
+	return fact.Hash().String()
+}
+
+func (fact ScheduleTransferFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.receiver, fact.sender}, nil
+}
+
+func (fact ScheduleTransferFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact ScheduleTransferFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact ScheduleTransferFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact ScheduleTransferFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fmt.Sprintf("%s:%s", fact.sender.String(), fact.currency.String())}
+
+	return r, nil
+}
+
+type ScheduleTransfer struct {
+	extras.ExtendedOperation
+}
+
+func (op ScheduleTransfer) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewScheduleTransfer(fact ScheduleTransferFact) (ScheduleTransfer, error) {
+	return ScheduleTransfer{
+		ExtendedOperation: extras.NewExtendedOperation(ScheduleTransferHint, fact),
+	}, nil
+}
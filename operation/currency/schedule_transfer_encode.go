@@ -0,0 +1,49 @@
+package currency
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+func (fact *ScheduleTransferFact) unpack(
+	enc encoder.Encoder,
+	sd, rc string,
+	bam []byte,
+	start base.Height,
+	interval, times uint64,
+	onInsufficient, cid string,
+) error {
+	switch ad, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.sender = ad
+	}
+
+	switch ad, err := base.DecodeAddress(rc, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.receiver = ad
+	}
+
+	if hinter, err := enc.Decode(bam); err != nil {
+		return err
+	} else if am, ok := hinter.(types.Amount); !ok {
+		return common.ErrTypeMismatch.Wrap(errors.Errorf("expected Amount, not %T", hinter))
+	} else {
+		fact.amount = am
+	}
+
+	fact.start = start
+	fact.interval = interval
+	fact.times = times
+	fact.onInsufficient = currency.ScheduleMissPolicy(onInsufficient)
+	fact.currency = types.CurrencyID(cid)
+
+	return nil
+}
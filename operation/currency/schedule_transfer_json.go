@@ -0,0 +1,93 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+type ScheduleTransferFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender         base.Address                `json:"sender"`
+	Receiver       base.Address                `json:"receiver"`
+	Amount         types.Amount                `json:"amount"`
+	Start          base.Height                 `json:"start"`
+	Interval       uint64                      `json:"interval"`
+	Times          uint64                      `json:"times"`
+	OnInsufficient currency.ScheduleMissPolicy `json:"on_insufficient"`
+	Currency       types.CurrencyID            `json:"currency"`
+}
+
+func (fact ScheduleTransferFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ScheduleTransferFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Receiver:              fact.receiver,
+		Amount:                fact.amount,
+		Start:                 fact.start,
+		Interval:              fact.interval,
+		Times:                 fact.times,
+		OnInsufficient:        fact.onInsufficient,
+		Currency:              fact.currency,
+	})
+}
+
+type ScheduleTransferFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender         string          `json:"sender"`
+	Receiver       string          `json:"receiver"`
+	Amount         json.RawMessage `json:"amount"`
+	Start          base.Height     `json:"start"`
+	Interval       uint64          `json:"interval"`
+	Times          uint64          `json:"times"`
+	OnInsufficient string          `json:"on_insufficient"`
+	Currency       string          `json:"currency"`
+}
+
+func (fact *ScheduleTransferFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf ScheduleTransferFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(
+		enc, uf.Sender, uf.Receiver, uf.Amount, uf.Start, uf.Interval, uf.Times, uf.OnInsufficient, uf.Currency,
+	); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op ScheduleTransfer) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperationMarshaler{
+		BaseOperationJSONMarshaler:           op.BaseOperation.JSONMarshaler(),
+		BaseOperationExtensionsJSONMarshaler: op.BaseOperationExtensions.JSONMarshaler(),
+	})
+}
+
+func (op *ScheduleTransfer) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	var ueo extras.BaseOperationExtensions
+	if err := ueo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperationExtensions = &ueo
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var scheduleTransferProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ScheduleTransferProcessor)
+	},
+}
+
+func (ScheduleTransfer) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type ScheduleTransferProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewScheduleTransferProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("create new ScheduleTransferProcessor")
+
+		nopp := scheduleTransferProcessorPool.Get()
+		opp, ok := nopp.(*ScheduleTransferProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected %T, not %T", &ScheduleTransferProcessor{}, nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *ScheduleTransferProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(ScheduleTransferFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", ScheduleTransferFact{}, op.Fact())), nil
+	}
+
+	if fact.Start() <= opp.Height() {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("start, %v should be over current height, %v", fact.Start(), opp.Height())), nil
+	}
+
+	if _, _, aErr, cErr := state.ExistsCAccount(fact.Sender(), "sender", true, false, getStateFunc); aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCAccountNA).Errorf("%v", cErr)), nil
+	}
+
+	if _, err := state.ExistsAccount(fact.Receiver(), "receiver", true, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	if _, err := state.ExistsCurrencyPolicy(fact.Amount().Currency(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("%v", err)), nil
+	}
+
+	if found, _ := state.CheckNotExistsState(
+		currency.ScheduleStateKey(fact.Sender(), fact.ScheduleID()), getStateFunc,
+	); found {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateE).
+				Errorf("schedule, %v of sender, %v", fact.ScheduleID(), fact.Sender())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ScheduleTransferProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, _ base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(ScheduleTransferFact)
+
+	key := currency.ScheduleStateKey(fact.Sender(), fact.ScheduleID())
+
+	return []base.StateMergeValue{
+		newScheduleStateMergeValue(key, currency.NewScheduleStateValue(
+			fact.Sender(),
+			fact.Receiver(),
+			fact.Amount(),
+			fact.Interval(),
+			fact.Times(),
+			fact.Start(),
+			0,
+			0,
+			fact.OnInsufficient(),
+			currency.ScheduleActive,
+		)),
+		newScheduleQueueStateMergeValue(fact.Start(), key),
+	}, nil, nil
+}
+
+func (opp *ScheduleTransferProcessor) Close() error {
+	scheduleTransferProcessorPool.Put(opp)
+
+	return nil
+}
+
+func newScheduleStateMergeValue(key string, v base.StateValue) base.StateMergeValue {
+	return common.NewBaseStateMergeValue(
+		key,
+		v,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return currency.NewScheduleStateValueMerger(height, key, st)
+		},
+	)
+}
+
+// newScheduleQueueStateMergeValue adds the schedule key to the queue of the
+// due height.
+func newScheduleQueueStateMergeValue(due base.Height, key string) base.StateMergeValue {
+	qk := currency.ScheduleQueueStateKey(due)
+
+	return common.NewBaseStateMergeValue(
+		qk,
+		currency.NewAddScheduleQueueStateValue(key),
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return currency.NewScheduleQueueStateValueMerger(height, qk, due, st)
+		},
+	)
+}
+
+// loadActiveSchedule loads the schedule of sender, which is not yet completed
+// or cancelled.
+func loadActiveSchedule(
+	sender base.Address, id string, getStateFunc base.GetStateFunc,
+) (currency.ScheduleStateValue, base.OperationProcessReasonError) {
+	st, err := state.ExistsState(currency.ScheduleStateKey(sender, id), "schedule", getStateFunc)
+	if err != nil {
+		return currency.ScheduleStateValue{}, base.NewBaseOperationProcessReasonError(
+			common.ErrMStateNF.Errorf("schedule, %v of sender, %v: %v", id, sender, err))
+	}
+
+	v, err := currency.StateScheduleValue(st)
+	if err != nil {
+		return currency.ScheduleStateValue{}, base.NewBaseOperationProcessReasonError(
+			common.ErrMStateValInvalid.Errorf("%v", err))
+	}
+
+	if v.Status != currency.ScheduleActive {
+		return currency.ScheduleStateValue{}, base.NewBaseOperationProcessReasonError(
+			common.ErrMValueInvalid.Errorf("schedule, %v of sender, %v already %v", id, sender, v.Status))
+	}
+
+	return v, nil
+}
@@ -0,0 +1,211 @@
+package currency_test
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/currency"
+	operationtest "github.com/imfact-labs/currency-model/operation/test"
+	ccstate "github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type testSchedule struct {
+	tp       *operationtest.TestProcessor
+	sender   base.Address
+	receiver base.Address
+	id       string
+}
+
+// newTestSchedule schedules 300 of sender to receiver every 10 blocks from
+// height 20, twice.
+func newTestSchedule(t *testing.T, tp *operationtest.TestProcessor, onInsufficient ccstate.ScheduleMissPolicy) testSchedule {
+	t.Helper()
+
+	sender, _, priv := tp.NewTestAccountState(tp.NewPrivateKey("sender-schedule"), true)
+	tp.NewTestBalanceState(sender, tp.GenesisCurrency, 1000, true)
+	receiver, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("receiver-schedule"), true)
+
+	fact := currency.NewScheduleTransferFact(
+		[]byte("schedule-transfer"), sender, receiver, amount(tp, 300),
+		base.Height(20), 10, 2, onInsufficient, tp.GenesisCurrency,
+	)
+
+	op, err := currency.NewScheduleTransfer(fact)
+	if err != nil {
+		t.Fatalf("new schedule transfer: %v", err)
+	}
+
+	sign(t, tp, &op, priv)
+
+	_, reason, err := tp.ProcessAt(currency.NewScheduleTransferProcessor(), base.Height(10), op)
+	requireNoReason(t, reason, err)
+
+	return testSchedule{tp: tp, sender: sender, receiver: receiver, id: fact.ScheduleID()}
+}
+
+func (s testSchedule) run(height base.Height) currency.RunSchedule {
+	return currency.NewRunSchedule(currency.NewRunScheduleFact(s.sender, s.id, s.receiver, amount(s.tp, 300), height))
+}
+
+func (s testSchedule) due(t *testing.T, height base.Height) []currency.RunSchedule {
+	t.Helper()
+
+	runs, err := currency.DueSchedules(height, s.tp.GetStateFunc)
+	if err != nil {
+		t.Fatalf("due schedules: %v", err)
+	}
+
+	return runs
+}
+
+func (s testSchedule) state(t *testing.T) ccstate.ScheduleStateValue {
+	t.Helper()
+
+	st, found, err := s.tp.GetStateFunc(ccstate.ScheduleStateKey(s.sender, s.id))
+	if err != nil || !found {
+		t.Fatalf("expected schedule state, %v", err)
+	}
+
+	return st.Value().(ccstate.ScheduleStateValue)
+}
+
+func TestRunScheduleAtDueHeights(t *testing.T) {
+	s := newTestSchedule(t, newTestProcessor(t, nilFeePolicy()), ccstate.ScheduleMissCancel)
+
+	if runs := s.due(t, base.Height(15)); len(runs) != 0 {
+		t.Fatalf("expected no run before start height, not %d", len(runs))
+	}
+
+	reason, err := s.tp.PreProcessAt(currency.NewRunScheduleProcessor(), base.Height(15), s.run(base.Height(15)))
+	requireReason(t, reason, err, "not due at height")
+
+	for _, height := range []base.Height{20, 30} {
+		runs := s.due(t, height)
+		if len(runs) != 1 {
+			t.Fatalf("expected one due run at %v, not %d", height, len(runs))
+		}
+
+		_, reason, err := s.tp.ProcessAt(currency.NewRunScheduleProcessor(), height, runs[0])
+		requireNoReason(t, reason, err)
+	}
+
+	if b := s.tp.Balance(s.receiver, s.tp.GenesisCurrency); !b.Equal(common.NewBig(600)) {
+		t.Fatalf("expected receiver balance 600, not %v", b)
+	}
+
+	if v := s.state(t); v.Status != ccstate.ScheduleCompleted || v.Runs != 2 {
+		t.Fatalf("expected schedule completed after 2 runs, not %v after %d", v.Status, v.Runs)
+	}
+
+	if runs := s.due(t, base.Height(40)); len(runs) != 0 {
+		t.Fatalf("expected no run after completed, not %d", len(runs))
+	}
+}
+
+func TestRunScheduleRejectsOverMaxTransfer(t *testing.T) {
+	tp := newLimitedTestProcessor(t, types.NewTransferLimits(common.NewBig(200), common.ZeroBig, 0, common.ZeroBig))
+	s := newTestSchedule(t, tp, ccstate.ScheduleMissCancel)
+
+	reason, err := tp.PreProcessAt(currency.NewRunScheduleProcessor(), base.Height(20), s.run(base.Height(20)))
+	requireReason(t, reason, err, "over max transfer")
+}
+
+func TestRunScheduleRejectsOverMaxBalance(t *testing.T) {
+	tp := newLimitedTestProcessor(t, types.NewTransferLimits(common.ZeroBig, common.ZeroBig, 0, common.NewBig(1000)))
+	s := newTestSchedule(t, tp, ccstate.ScheduleMissCancel)
+	tp.NewTestBalanceState(s.receiver, tp.GenesisCurrency, 800, true)
+
+	reason, err := tp.PreProcessAt(currency.NewRunScheduleProcessor(), base.Height(20), s.run(base.Height(20)))
+	requireReason(t, reason, err, "over max balance")
+}
+
+func TestRunScheduleRejectsDepositBlockedContract(t *testing.T) {
+	tp := newTestProcessor(t, nilFeePolicy())
+	s := newTestSchedule(t, tp, ccstate.ScheduleMissCancel)
+
+	for _, bs := range []types.BalanceStatus{types.DepositBlocked, types.FullyFrozen} {
+		setBalanceStatus(tp, s.receiver, s.sender, bs)
+
+		reason, err := tp.PreProcessAt(currency.NewRunScheduleProcessor(), base.Height(20), s.run(base.Height(20)))
+		requireReason(t, reason, err, "not allowed to deposit")
+	}
+
+	setBalanceStatus(tp, s.receiver, s.sender, types.WithdrawalBlocked)
+
+	_, reason, err := tp.ProcessAt(currency.NewRunScheduleProcessor(), base.Height(20), s.run(base.Height(20)))
+	requireNoReason(t, reason, err)
+}
+
+func TestRunScheduleCountsOutflow(t *testing.T) {
+	// NOTE max outflow 500 per 100 blocks
+	tp := newLimitedTestProcessor(t, types.NewTransferLimits(common.ZeroBig, common.NewBig(500), 100, common.ZeroBig))
+	s := newTestSchedule(t, tp, ccstate.ScheduleMissCancel)
+
+	_, reason, err := tp.ProcessAt(currency.NewRunScheduleProcessor(), base.Height(20), s.run(base.Height(20)))
+	requireNoReason(t, reason, err)
+
+	_, reason, err = tp.ProcessAt(currency.NewRunScheduleProcessor(), base.Height(30), s.run(base.Height(30)))
+	requireReason(t, reason, err, "over max outflow")
+}
+
+func TestSkipScheduleByMissPolicy(t *testing.T) {
+	cases := []struct {
+		policy ccstate.ScheduleMissPolicy
+		status ccstate.ScheduleStatus
+		next   base.Height
+	}{
+		{ccstate.ScheduleMissCancel, ccstate.ScheduleCancelled, 20},
+		{ccstate.ScheduleMissSkip, ccstate.ScheduleActive, 30},
+	}
+
+	for _, c := range cases {
+		s := newTestSchedule(t, newTestProcessor(t, nilFeePolicy()), c.policy)
+		s.tp.NewTestBalanceState(s.sender, s.tp.GenesisCurrency, 100, true)
+
+		reason, err := s.tp.PreProcessAt(currency.NewRunScheduleProcessor(), base.Height(20), s.run(base.Height(20)))
+		requireReason(t, reason, err, "insufficient balance")
+
+		skip := currency.NewSkipSchedule(currency.NewSkipScheduleFact(s.sender, s.id, base.Height(20), reason.Error()))
+		if err := skip.IsValid(s.tp.NetworkID); err != nil {
+			t.Fatalf("invalid skip schedule: %v", err)
+		}
+
+		_, reason, err = s.tp.ProcessAt(currency.NewSkipScheduleProcessor(), base.Height(20), skip)
+		requireNoReason(t, reason, err)
+
+		if v := s.state(t); v.Status != c.status || v.Missed != 1 || v.Next != c.next {
+			t.Fatalf("%s: expected %v schedule next at %v, not %v next at %v", c.policy, c.status, c.next, v.Status, v.Next)
+		}
+	}
+}
+
+func TestScheduleFactsRoundTrip(t *testing.T) {
+	tp := newTestProcessor(t, nilFeePolicy())
+	s := newTestSchedule(t, tp, ccstate.ScheduleMissSkip)
+
+	facts := []base.Fact{
+		currency.NewScheduleTransferFact(
+			[]byte("schedule-round-trip"), s.sender, s.receiver, amount(tp, 300),
+			base.Height(20), 10, 2, ccstate.ScheduleMissSkip, tp.GenesisCurrency,
+		),
+		currency.NewCancelScheduleFact([]byte("cancel-round-trip"), s.sender, s.id, tp.GenesisCurrency),
+		s.run(base.Height(20)).Fact(),
+		currency.NewSkipScheduleFact(s.sender, s.id, base.Height(20), "insufficient balance"),
+	}
+
+	for i := range facts {
+		j, b := roundTrip(t, facts[i])
+
+		for _, got := range []base.Fact{j, b} {
+			if err := got.IsValid(nil); err != nil {
+				t.Fatalf("invalid decoded %T: %v", got, err)
+			}
+
+			if !got.Hash().Equal(facts[i].Hash()) {
+				t.Fatalf("decoded %T not matched", got)
+			}
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package currency
+
+import (
+	"fmt"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+)
+
+var (
+	SetGuardiansFactHint = hint.MustNewHint("mitum-currency-set-guardians-operation-fact-v0.0.1")
+	SetGuardiansHint     = hint.MustNewHint("mitum-currency-set-guardians-operation-v0.0.1")
+)
+
+// SetGuardiansFact registers the guardian set of sender. When the signs of
+// guardians pass their threshold, they can recover sender with the new keys;
+// the new keys are applied after delay blocks.
+type SetGuardiansFact struct {
+	base.BaseFact
+	sender    base.Address
+	guardians types.AccountKeys
+	delay     uint64
+	currency  types.CurrencyID
+}
+
+func NewSetGuardiansFact(
+	token []byte,
+	sender base.Address,
+	guardians types.AccountKeys,
+	delay uint64,
+	currency types.CurrencyID,
+) SetGuardiansFact {
+	fact := SetGuardiansFact{
+		BaseFact:  base.NewBaseFact(SetGuardiansFactHint, token),
+		sender:    sender,
+		guardians: guardians,
+		delay:     delay,
+		currency:  currency,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact SetGuardiansFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact SetGuardiansFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact SetGuardiansFact) Bytes() []byte {
+	var gs []byte
+	if fact.guardians != nil {
+		gs = fact.guardians.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		gs,
+		util.Uint64ToBytes(fact.delay),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact SetGuardiansFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.guardians, fact.currency); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if _, ok := fact.guardians.(types.BaseAccountKeys); !ok {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrValueInvalid.Errorf("expected BaseAccountKeys but %T", fact.guardians))
+	}
+
+	if fact.delay < 1 {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Errorf("zero recovery delay"))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact SetGuardiansFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact SetGuardiansFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact SetGuardiansFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact SetGuardiansFact) Guardians() types.AccountKeys {
+	return fact.guardians
+}
+
+func (fact SetGuardiansFact) Delay() uint64 {
+	return fact.delay
+}
+
+func (fact SetGuardiansFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact SetGuardiansFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+func (fact SetGuardiansFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact SetGuardiansFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact SetGuardiansFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact SetGuardiansFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fmt.Sprintf("%s:%s", fact.sender.String(), fact.currency.String())}
+	r[extras.DuplicationKeyTypeRecovery] = []string{currency.RecoveryStateKey(fact.sender)}
+
+	return r, nil
+}
+
+type SetGuardians struct {
+	extras.ExtendedOperation
+}
+
+func (op SetGuardians) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewSetGuardians(fact SetGuardiansFact) (SetGuardians, error) {
+	return SetGuardians{
+		ExtendedOperation: extras.NewExtendedOperation(SetGuardiansHint, fact),
+	}, nil
+}
@@ -0,0 +1,31 @@
+package currency
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+func (fact *SetGuardiansFact) unpack(enc encoder.Encoder, sd string, bgs []byte, delay uint64, cid string) error {
+	switch ad, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.sender = ad
+	}
+
+	if hinter, err := enc.Decode(bgs); err != nil {
+		return err
+	} else if k, ok := hinter.(types.AccountKeys); !ok {
+		return common.ErrTypeMismatch.Wrap(errors.Errorf("expected AccountKeys, not %T", hinter))
+	} else {
+		fact.guardians = k
+	}
+
+	fact.delay = delay
+	fact.currency = types.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+type SetGuardiansFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender    base.Address      `json:"sender"`
+	Guardians types.AccountKeys `json:"guardians"`
+	Delay     uint64            `json:"delay"`
+	Currency  types.CurrencyID  `json:"currency"`
+}
+
+func (fact SetGuardiansFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SetGuardiansFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Guardians:             fact.guardians,
+		Delay:                 fact.delay,
+		Currency:              fact.currency,
+	})
+}
+
+type SetGuardiansFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender    string          `json:"sender"`
+	Guardians json.RawMessage `json:"guardians"`
+	Delay     uint64          `json:"delay"`
+	Currency  string          `json:"currency"`
+}
+
+func (fact *SetGuardiansFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf SetGuardiansFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, uf.Sender, uf.Guardians, uf.Delay, uf.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op SetGuardians) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperationMarshaler{
+		BaseOperationJSONMarshaler:           op.BaseOperation.JSONMarshaler(),
+		BaseOperationExtensionsJSONMarshaler: op.BaseOperationExtensions.JSONMarshaler(),
+	})
+}
+
+func (op *SetGuardians) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	var ueo extras.BaseOperationExtensions
+	if err := ueo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperationExtensions = &ueo
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var setGuardiansProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(SetGuardiansProcessor)
+	},
+}
+
+func (SetGuardians) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type SetGuardiansProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewSetGuardiansProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("create new SetGuardiansProcessor")
+
+		nopp := setGuardiansProcessorPool.Get()
+		opp, ok := nopp.(*SetGuardiansProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected %T, not %T", &SetGuardiansProcessor{}, nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *SetGuardiansProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(SetGuardiansFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", SetGuardiansFact{}, op.Fact())), nil
+	}
+
+	if _, rerr := loadRecoverableAccount(fact.Sender(), getStateFunc); rerr != nil {
+		return ctx, rerr, nil
+	}
+
+	if err := state.CheckAccountNotFrozen(fact.Sender(), []types.CurrencyID{fact.Currency()}, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	// NOTE the guardians can not be changed under recovery; the recovery
+	// should be cancelled first.
+	if _, err := loadPendingRecovery(fact.Sender(), getStateFunc); err == nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("recovery of account, %v is pending", fact.Sender())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *SetGuardiansProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, _ base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(SetGuardiansFact)
+
+	return []base.StateMergeValue{
+		state.NewStateMergeValue(
+			currency.GuardianStateKey(fact.Sender()),
+			currency.NewGuardianStateValue(fact.Sender(), fact.Guardians(), fact.Delay()),
+		),
+	}, nil, nil
+}
+
+func (opp *SetGuardiansProcessor) Close() error {
+	setGuardiansProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package currency
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	SkipScheduleFactHint = hint.MustNewHint("mitum-currency-skip-schedule-operation-fact-v0.0.1")
+	SkipScheduleHint     = hint.MustNewHint("mitum-currency-skip-schedule-operation-v0.0.1")
+)
+
+// SkipScheduleFact records the run of the schedule of sender at height, which
+// could not be made by reason, like insufficient balance. By the miss policy
+// of schedule, the schedule moves to the next period or is cancelled. Like
+// RunScheduleFact, it is made by the block processing and no fee is charged.
+type SkipScheduleFact struct {
+	base.BaseFact
+	sender   base.Address
+	schedule string
+	height   base.Height
+	reason   string
+}
+
+func NewSkipScheduleFact(
+	sender base.Address,
+	schedule string,
+	height base.Height,
+	reason string,
+) SkipScheduleFact {
+	fact := SkipScheduleFact{
+		BaseFact: base.NewBaseFact(SkipScheduleFactHint, height.Bytes()),
+		sender:   sender,
+		schedule: schedule,
+		height:   height,
+		reason:   reason,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact SkipScheduleFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact SkipScheduleFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact SkipScheduleFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		[]byte(fact.schedule),
+		fact.height.Bytes(),
+		[]byte(fact.reason),
+	)
+}
+
+func (fact SkipScheduleFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.height); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := isValidScheduleID(fact.schedule); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if len(fact.reason) < 1 {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(errors.Errorf("empty reason")))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact SkipScheduleFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact SkipScheduleFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact SkipScheduleFact) Schedule() string {
+	return fact.schedule
+}
+
+func (fact SkipScheduleFact) Height() base.Height {
+	return fact.height
+}
+
+func (fact SkipScheduleFact) Reason() string {
+	return fact.reason
+}
+
+func (fact SkipScheduleFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+func (fact SkipScheduleFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSchedule] = []string{currency.ScheduleStateKey(fact.sender, fact.schedule)}
+
+	return r, nil
+}
+
+type SkipSchedule struct {
+	common.BaseOperation
+}
+
+func NewSkipSchedule(fact SkipScheduleFact) SkipSchedule {
+	op := SkipSchedule{BaseOperation: common.NewBaseOperation(SkipScheduleHint, fact)}
+	op.SetHash(valuehash.NewSHA256(op.HashBytes()))
+
+	return op
+}
+
+func (op SkipSchedule) IsValid(networkID []byte) error {
+	return isValidUnsignedOperation(op.BaseOperation, networkID)
+}
@@ -0,0 +1,23 @@
+package currency
+
+import (
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+func (fact *SkipScheduleFact) unpack(
+	enc encoder.Encoder, sd, schedule string, height base.Height, reason string,
+) error {
+	switch ad, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.sender = ad
+	}
+
+	fact.schedule = schedule
+	fact.height = height
+	fact.reason = reason
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package currency
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+type SkipScheduleFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address `json:"sender"`
+	Schedule string       `json:"schedule"`
+	Height   base.Height  `json:"height"`
+	Reason   string       `json:"reason"`
+}
+
+func (fact SkipScheduleFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SkipScheduleFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Schedule:              fact.schedule,
+		Height:                fact.height,
+		Reason:                fact.reason,
+	})
+}
+
+type SkipScheduleFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string      `json:"sender"`
+	Schedule string      `json:"schedule"`
+	Height   base.Height `json:"height"`
+	Reason   string      `json:"reason"`
+}
+
+func (fact *SkipScheduleFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf SkipScheduleFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, uf.Sender, uf.Schedule, uf.Height, uf.Reason); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op SkipSchedule) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(op.BaseOperation)
+}
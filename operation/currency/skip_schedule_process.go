@@ -0,0 +1,101 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var skipScheduleProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(SkipScheduleProcessor)
+	},
+}
+
+func (SkipSchedule) PreProcess(
+	ctx context.Context, _ base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	return ctx, base.NewBaseOperationProcessReasonError(
+		common.ErrMPreProcess.Errorf("SkipSchedule can be made only by block processing")), nil
+}
+
+func (SkipSchedule) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type SkipScheduleProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewSkipScheduleProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("create new SkipScheduleProcessor")
+
+		nopp := skipScheduleProcessorPool.Get()
+		opp, ok := nopp.(*SkipScheduleProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected %T, not %T", &SkipScheduleProcessor{}, nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *SkipScheduleProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(SkipScheduleFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", SkipScheduleFact{}, op.Fact())), nil
+	}
+
+	if _, rerr := loadDueSchedule(fact.Sender(), fact.Schedule(), opp.Height(), getStateFunc); rerr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", rerr)), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *SkipScheduleProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(SkipScheduleFact)
+
+	v, rerr := loadDueSchedule(fact.Sender(), fact.Schedule(), opp.Height(), getStateFunc)
+	if rerr != nil {
+		return nil, rerr, nil
+	}
+
+	return nextScheduleStateMergeValues(fact.Sender(), fact.Schedule(), v.Miss()), nil, nil
+}
+
+func (opp *SkipScheduleProcessor) Close() error {
+	skipScheduleProcessorPool.Put(opp)
+
+	return nil
+}
@@ -72,7 +72,7 @@ func (t *TestUpdateKeyProcessor) MakeOperation(
 ) *TestUpdateKeyProcessor {
 	//t.MockGetter.On("Get", mock.Anything).Return(nil, false, nil)
 
-	op, _ := NewUpdateKey(NewUpdateKeyFact([]byte("token"), sender, target, currency))
+	op, _ := NewUpdateKey(NewUpdateKeyFact([]byte("token"), sender, target, currency, 0))
 	_ = op.Sign(privatekey, t.NetworkID)
 
 	t.Op = op
@@ -77,7 +77,7 @@ func (t *TestUpdateCurrencyProcessor) MakeOperation() *TestUpdateCurrencyProcess
 		panic("execute SetCurrencyPolicy")
 	}
 
-	op, _ := NewUpdateCurrency(NewUpdateCurrencyFact([]byte("token"), t.Currency(), t.Policy()))
+	op, _ := NewUpdateCurrency(NewUpdateCurrencyFact([]byte("token"), t.Currency(), t.Policy(), base.GenesisHeight, common.ZeroBig))
 	_ = op.NodeSign(t.NodePriv, t.NetworkID, t.NodeAddr)
 	t.op = op
 
@@ -168,6 +168,15 @@ func (fact TransferFact) FeeBase() (types.CurrencyID, int, int, bool) {
 	return fact.Currency(), len(fact.items), len(fact.Bytes()), extras.HasItem
 }
 
+func (fact TransferFact) FeeAmounts() []common.Big {
+	items := make([]AmountsItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return AmountsItemsFeeAmounts(fact.currency, items)
+}
+
 func (fact TransferFact) FeePayer() base.Address {
 	return fact.sender
 }
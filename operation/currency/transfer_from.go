@@ -0,0 +1,186 @@
+package currency
+
+import (
+	"fmt"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	TransferFromFactHint = hint.MustNewHint("mitum-currency-transfer-from-operation-fact-v0.0.1")
+	TransferFromHint     = hint.MustNewHint("mitum-currency-transfer-from-operation-v0.0.1")
+)
+
+// TransferFromFact transfers amount from the balance of owner to receiver
+// within the allowance, which owner approved to sender by Approve.
+type TransferFromFact struct {
+	base.BaseFact
+	sender   base.Address
+	owner    base.Address
+	receiver base.Address
+	amount   types.Amount
+	currency types.CurrencyID
+}
+
+func NewTransferFromFact(
+	token []byte,
+	sender base.Address,
+	owner base.Address,
+	receiver base.Address,
+	amount types.Amount,
+	currency types.CurrencyID,
+) TransferFromFact {
+	fact := TransferFromFact{
+		BaseFact: base.NewBaseFact(TransferFromFactHint, token),
+		sender:   sender,
+		owner:    owner,
+		receiver: receiver,
+		amount:   amount,
+		currency: currency,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact TransferFromFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact TransferFromFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact TransferFromFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.owner.Bytes(),
+		fact.receiver.Bytes(),
+		fact.amount.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact TransferFromFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false, fact.sender, fact.owner, fact.receiver, fact.amount, fact.currency,
+	); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.owner) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("owner is same with sender, %v", fact.sender)))
+	}
+
+	if fact.owner.Equal(fact.receiver) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("receiver is same with owner, %v", fact.owner)))
+	}
+
+	if !fact.amount.Big().OverZero() {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(errors.Errorf("Under zero amount of TransferFrom")))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact TransferFromFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact TransferFromFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact TransferFromFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact TransferFromFact) Owner() base.Address {
+	return fact.owner
+}
+
+func (fact TransferFromFact) Receiver() base.Address {
+	return fact.receiver
+}
+
+func (fact TransferFromFact) Amount() types.Amount {
+	return fact.amount
+}
+
+func (fact TransferFromFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact TransferFromFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.receiver, fact.owner, fact.sender}, nil
+}
+
+func (fact TransferFromFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact TransferFromFact) FeeAmounts() []common.Big {
+	if fact.amount.Currency() != fact.currency {
+		return []common.Big{common.ZeroBig}
+	}
+
+	return []common.Big{fact.amount.Big()}
+}
+
+func (fact TransferFromFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact TransferFromFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact TransferFromFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeSender] = []string{fmt.Sprintf("%s:%s", fact.sender.String(), fact.currency.String())}
+	r[extras.DuplicationKeyTypeAllowance] = []string{
+		currency.AllowanceStateKey(fact.owner, fact.sender, fact.amount.Currency()),
+	}
+
+	return r, nil
+}
+
+type TransferFrom struct {
+	extras.ExtendedOperation
+}
+
+func (op TransferFrom) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewTransferFrom(fact TransferFromFact) (TransferFrom, error) {
+	return TransferFrom{
+		ExtendedOperation: extras.NewExtendedOperation(TransferFromHint, fact),
+	}, nil
+}
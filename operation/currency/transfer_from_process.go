@@ -0,0 +1,207 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/state/extension"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var transferFromProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(TransferFromProcessor)
+	},
+}
+
+func (TransferFrom) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type TransferFromProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewTransferFromProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("create new TransferFromProcessor")
+
+		nopp := transferFromProcessorPool.Get()
+		opp, ok := nopp.(*TransferFromProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected %T, not %T", &TransferFromProcessor{}, nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *TransferFromProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(TransferFromFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMTypeMismatch).
+				Errorf("expected %T, not %T", TransferFromFact{}, op.Fact())), nil
+	}
+
+	if _, err := state.ExistsAccount(fact.Sender(), "sender", true, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	if _, err := state.ExistsAccount(fact.Receiver(), "receiver", true, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	if _, rerr := loadUsableAllowance(fact, opp.Height(), getStateFunc); rerr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", rerr)), nil
+	}
+
+	am := fact.Amount()
+
+	if err := state.CheckCurrencyNotPaused(am.Currency(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	if err := state.CheckAccountNotFrozen(fact.Owner(), []types.CurrencyID{am.Currency()}, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	if err := extension.CheckDepositAllowed(fact.Receiver(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	if err := CheckTransferLimits(fact.Receiver(), am, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	bst, err := state.ExistsState(currency.BalanceStateKey(fact.Owner(), am.Currency()), "owner balance", getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateNF).Errorf("%v", err)), nil
+	}
+
+	balance, err := currency.StateBalanceValue(bst)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).Errorf("%v", err)), nil
+	}
+
+	if balance.Big().Compare(am.Big()) < 0 {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).
+				Errorf("insufficient balance of currency, %v of owner, %v", am.Currency(), fact.Owner())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *TransferFromProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(TransferFromFact)
+
+	v, rerr := loadUsableAllowance(fact, opp.Height(), getStateFunc)
+	if rerr != nil {
+		return nil, rerr, nil
+	}
+
+	am := fact.Amount()
+	bk := currency.BalanceStateKey(fact.Owner(), am.Currency())
+	rk := currency.BalanceStateKey(fact.Receiver(), am.Currency())
+
+	sts := []base.StateMergeValue{
+		common.NewBaseStateMergeValue(
+			bk,
+			currency.NewDeductBalanceStateValue(am),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return currency.NewBalanceStateValueMerger(height, bk, am.Currency(), st)
+			},
+		),
+		common.NewBaseStateMergeValue(
+			rk,
+			currency.NewAddBalanceStateValue(am),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return currency.NewBalanceStateValueMerger(height, rk, am.Currency(), st)
+			},
+		),
+		state.NewStateMergeValue(
+			currency.AllowanceStateKey(v.Owner, v.Spender, am.Currency()),
+			currency.NewAllowanceStateValue(v.Owner, v.Spender, v.Amount.WithBig(v.Amount.Big().Sub(am.Big())), v.Expiry),
+		),
+	}
+
+	outflowValues, err := PrepareOutflowLimits(fact.Owner(), []types.Amount{am}, opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	return append(sts, outflowValues...), nil, nil
+}
+
+func (opp *TransferFromProcessor) Close() error {
+	transferFromProcessorPool.Put(opp)
+
+	return nil
+}
+
+func loadUsableAllowance(
+	fact TransferFromFact, height base.Height, getStateFunc base.GetStateFunc,
+) (currency.AllowanceStateValue, base.OperationProcessReasonError) {
+	cid := fact.Amount().Currency()
+
+	st, err := state.ExistsState(currency.AllowanceStateKey(fact.Owner(), fact.Sender(), cid), "allowance", getStateFunc)
+	if err != nil {
+		return currency.AllowanceStateValue{}, base.NewBaseOperationProcessReasonError(
+			common.ErrMStateNF.Errorf("%v", err))
+	}
+
+	v, err := currency.StateAllowanceValue(st)
+	if err != nil {
+		return currency.AllowanceStateValue{}, base.NewBaseOperationProcessReasonError(
+			common.ErrMStateValInvalid.Errorf("%v", err))
+	}
+
+	switch {
+	case v.Expired(height):
+		return currency.AllowanceStateValue{}, base.NewBaseOperationProcessReasonError(
+			common.ErrMValueInvalid.Errorf("allowance of spender, %v expired at height, %v", fact.Sender(), v.Expiry))
+	case v.Amount.Big().Compare(fact.Amount().Big()) < 0:
+		return currency.AllowanceStateValue{}, base.NewBaseOperationProcessReasonError(
+			common.ErrMValOOR.Errorf("amount over allowance of spender, %v, %v > %v",
+				fact.Sender(), fact.Amount().Big(), v.Amount.Big()))
+	}
+
+	return v, nil
+}
@@ -0,0 +1,304 @@
+package currency
+
+import (
+	"sort"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+// CheckTransferLimits checks the amount, which receiver receives, with the
+// transfer limits of currency policy.
+func CheckTransferLimits(receiver base.Address, am types.Amount, getStateFunc base.GetStateFunc) error {
+	limits, ok, err := currencyLimits(am.Currency(), getStateFunc)
+	if err != nil || !ok {
+		return err
+	}
+
+	if err := checkMaxTransfer(am, limits); err != nil {
+		return err
+	}
+
+	return checkMaxBalance(receiver, am, limits, getStateFunc)
+}
+
+// CheckMaxTransfer checks the amount with the max transfer of currency policy;
+// it is for the amount, which is received later, like transfer lock.
+func CheckMaxTransfer(am types.Amount, getStateFunc base.GetStateFunc) error {
+	limits, ok, err := currencyLimits(am.Currency(), getStateFunc)
+	if err != nil || !ok {
+		return err
+	}
+
+	return checkMaxTransfer(am, limits)
+}
+
+// ReceiverTotals sums the amounts, which receivers receive in an operation, by
+// receiver and currency. The max balance of currency policy is checked with
+// the totals, so several items to the same receiver can not pass over it.
+type ReceiverTotals struct {
+	receivers map[string]base.Address
+	amounts   map[string]types.Amount
+}
+
+func NewReceiverTotals() ReceiverTotals {
+	return ReceiverTotals{
+		receivers: map[string]base.Address{},
+		amounts:   map[string]types.Amount{},
+	}
+}
+
+func (r ReceiverTotals) Add(receiver base.Address, am types.Amount) {
+	key := currency.BalanceStateKey(receiver, am.Currency())
+
+	if total, found := r.amounts[key]; found {
+		r.amounts[key] = total.WithBig(total.Big().Add(am.Big()))
+
+		return
+	}
+
+	r.receivers[key] = receiver
+	r.amounts[key] = am
+}
+
+// CheckMaxBalance checks the balance of each receiver after receiving the
+// total with the max balance of currency policy.
+func (r ReceiverTotals) CheckMaxBalance(getStateFunc base.GetStateFunc) error {
+	keys := make([]string, 0, len(r.amounts))
+	for key := range r.amounts {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for i := range keys {
+		total := r.amounts[keys[i]]
+
+		limits, ok, err := currencyLimits(total.Currency(), getStateFunc)
+		switch {
+		case err != nil:
+			return err
+		case !ok:
+			continue
+		}
+
+		if err := checkMaxBalance(r.receivers[keys[i]], total, limits, getStateFunc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func currencyLimits(cid types.CurrencyID, getStateFunc base.GetStateFunc) (types.TransferLimits, bool, error) {
+	policy, err := state.ExistsCurrencyPolicy(cid, getStateFunc)
+	if err != nil {
+		return types.TransferLimits{}, false, err
+	}
+
+	limits, ok := policy.Limits()
+
+	return limits, ok, nil
+}
+
+func checkMaxTransfer(am types.Amount, limits types.TransferLimits) error {
+	if limits.MaxTransfer().OverZero() && am.Big().Compare(limits.MaxTransfer()) > 0 {
+		return common.ErrLimitExceeded.Wrap(
+			errors.Errorf("amount over max transfer of currency, %v, %v > %v",
+				am.Currency(), am.Big(), limits.MaxTransfer()))
+	}
+
+	return nil
+}
+
+func checkMaxBalance(
+	receiver base.Address, am types.Amount, limits types.TransferLimits, getStateFunc base.GetStateFunc,
+) error {
+	if !limits.MaxBalance().OverZero() {
+		return nil
+	}
+
+	balance := common.ZeroBig
+	switch st, found, err := getStateFunc(currency.BalanceStateKey(receiver, am.Currency())); {
+	case err != nil:
+		return err
+	case found:
+		b, err := currency.StateBalanceValue(st)
+		if err != nil {
+			return err
+		}
+
+		balance = b.Big()
+	}
+
+	if balance.Add(am.Big()).Compare(limits.MaxBalance()) > 0 {
+		return common.ErrLimitExceeded.Wrap(
+			errors.Errorf("balance of account, %v over max balance of currency, %v, %v",
+				receiver, am.Currency(), limits.MaxBalance()))
+	}
+
+	return nil
+}
+
+// PrepareOutflowLimits checks the total amounts, which holder sends out at
+// height, with the max outflow of currency policy and returns the state values
+// to count the outflows.
+func PrepareOutflowLimits(
+	holder base.Address,
+	amounts []types.Amount,
+	height base.Height,
+	getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	sorted := make([]types.Amount, len(amounts))
+	copy(sorted, amounts)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Currency() < sorted[j].Currency()
+	})
+
+	var sts []base.StateMergeValue // nolint:prealloc
+
+	for i := range sorted {
+		am := sorted[i]
+		cid := am.Currency()
+
+		policy, err := state.ExistsCurrencyPolicy(cid, getStateFunc)
+		if err != nil {
+			return nil, err
+		}
+
+		limits, ok := policy.Limits()
+		if !ok || !limits.MaxOutflow().OverZero() {
+			continue
+		}
+
+		window := limits.OutflowWindowStart(height)
+		key := currency.OutflowStateKey(holder, cid)
+
+		outflow, err := loadOutflow(key, window, getStateFunc)
+		if err != nil {
+			return nil, err
+		}
+
+		if outflow.Add(am.Big()).Compare(limits.MaxOutflow()) > 0 {
+			return nil, common.ErrLimitExceeded.Wrap(
+				errors.Errorf("outflow of account, %v over max outflow of currency, %v, %v + %v > %v",
+					holder, cid, outflow, am.Big(), limits.MaxOutflow()))
+		}
+
+		sts = append(sts, common.NewBaseStateMergeValue(
+			key,
+			currency.NewAddOutflowStateValue(am, window),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return currency.NewOutflowStateValueMerger(height, key, cid, st)
+			},
+		))
+	}
+
+	return sts, nil
+}
+
+// loadOutflow returns the outflow counter of the window; the counter of the
+// other window is zero.
+func loadOutflow(key string, window base.Height, getStateFunc base.GetStateFunc) (common.Big, error) {
+	switch st, found, err := getStateFunc(key); {
+	case err != nil:
+		return common.ZeroBig, err
+	case !found:
+		return common.ZeroBig, nil
+	default:
+		v, ok := st.Value().(currency.OutflowStateValue)
+		if !ok {
+			return common.ZeroBig, common.ErrTypeMismatch.Wrap(
+				errors.Errorf("expected OutflowStateValue, not %T", st.Value()))
+		}
+
+		if v.Window != window {
+			return common.ZeroBig, nil
+		}
+
+		return v.Amount.Big(), nil
+	}
+}
+
+// BlockOutflows sums the outflows of the processed operations in a block by
+// the outflow state key. PrepareOutflowLimits checks the outflow only with the
+// counter of previous block, so the operations in the same block are checked
+// again with the sum by Add.
+type BlockOutflows struct {
+	outflows map[string]currency.AddOutflowStateValue
+}
+
+func NewBlockOutflows() BlockOutflows {
+	return BlockOutflows{
+		outflows: map[string]currency.AddOutflowStateValue{},
+	}
+}
+
+// Add checks the outflows in values, with the outflows of the former
+// operations in block, with the max outflow of currency policy; the outflows
+// are added only when all of them pass.
+func (b BlockOutflows) Add(values []base.StateMergeValue, getStateFunc base.GetStateFunc) error {
+	added := map[string]currency.AddOutflowStateValue{}
+
+	for i := range values {
+		v, ok := values[i].Value().(currency.AddOutflowStateValue)
+		if !ok {
+			continue
+		}
+
+		key := values[i].Key()
+
+		total, found := added[key]
+		if !found {
+			total = currency.NewAddOutflowStateValue(v.Amount.WithBig(common.ZeroBig), v.Window)
+
+			if former, ok := b.outflows[key]; ok && former.Window == v.Window {
+				total = former
+			}
+		}
+
+		added[key] = currency.NewAddOutflowStateValue(total.Amount.WithBig(total.Amount.Big().Add(v.Amount.Big())), v.Window)
+	}
+
+	keys := make([]string, 0, len(added))
+	for key := range added {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for i := range keys {
+		total := added[keys[i]]
+		cid := total.Amount.Currency()
+
+		limits, ok, err := currencyLimits(cid, getStateFunc)
+		switch {
+		case err != nil:
+			return err
+		case !ok || !limits.MaxOutflow().OverZero():
+			continue
+		}
+
+		outflow, err := loadOutflow(keys[i], total.Window, getStateFunc)
+		if err != nil {
+			return err
+		}
+
+		if outflow.Add(total.Amount.Big()).Compare(limits.MaxOutflow()) > 0 {
+			return common.ErrLimitExceeded.Wrap(
+				errors.Errorf("outflow in block, %v over max outflow of currency, %v, %v + %v > %v",
+					keys[i], cid, outflow, total.Amount.Big(), limits.MaxOutflow()))
+		}
+	}
+
+	for key := range added {
+		b.outflows[key] = added[key]
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package currency_test
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/currency"
+	operationtest "github.com/imfact-labs/currency-model/operation/test"
+	ccstate "github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+)
+
+func newLimitedTestProcessor(t *testing.T, limits types.TransferLimits) *operationtest.TestProcessor {
+	t.Helper()
+
+	return newTestProcessor(t, nilFeePolicy().WithLimits(limits))
+}
+
+func newTestTransfer(
+	t *testing.T,
+	tp *operationtest.TestProcessor,
+	token string,
+	sender base.Address,
+	priv base.Privatekey,
+	items ...currency.TransferItem,
+) currency.Transfer {
+	t.Helper()
+
+	op, err := currency.NewTransfer(currency.NewTransferFact([]byte(token), sender, items, tp.GenesisCurrency))
+	if err != nil {
+		t.Fatalf("new transfer: %v", err)
+	}
+
+	sign(t, tp, &op, priv)
+
+	return op
+}
+
+func TestTransferRejectsAmountOverMaxTransfer(t *testing.T) {
+	tp := newLimitedTestProcessor(t, types.NewTransferLimits(common.NewBig(500), common.ZeroBig, 0, common.ZeroBig))
+
+	sender, _, priv := tp.NewTestAccountState(tp.NewPrivateKey("sender-max-transfer"), true)
+	tp.NewTestBalanceState(sender, tp.GenesisCurrency, 10000, true)
+	receiver, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("receiver-max-transfer"), true)
+
+	op := newTestTransfer(t, tp, "over-max-transfer", sender, priv,
+		currency.NewTransferItemMultiAmounts(receiver, []types.Amount{amount(tp, 501)}))
+
+	reason, err := tp.PreProcessAt(currency.NewTransferProcessor(), base.Height(10), op)
+	requireReason(t, reason, err, "over max transfer")
+
+	op = newTestTransfer(t, tp, "max-transfer", sender, priv,
+		currency.NewTransferItemMultiAmounts(receiver, []types.Amount{amount(tp, 500)}))
+
+	_, reason, err = tp.ProcessAt(currency.NewTransferProcessor(), base.Height(10), op)
+	requireNoReason(t, reason, err)
+}
+
+func TestTransferCountsOutflowInWindow(t *testing.T) {
+	// NOTE max outflow 300 per 10 blocks
+	tp := newLimitedTestProcessor(t, types.NewTransferLimits(common.ZeroBig, common.NewBig(300), 10, common.ZeroBig))
+
+	sender, _, priv := tp.NewTestAccountState(tp.NewPrivateKey("sender-outflow"), true)
+	tp.NewTestBalanceState(sender, tp.GenesisCurrency, 10000, true)
+	receiver, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("receiver-outflow"), true)
+
+	// NOTE 200 is already sent in window 10
+	tp.SetState(common.NewBaseState(
+		base.Height(12),
+		ccstate.OutflowStateKey(sender, tp.GenesisCurrency),
+		ccstate.NewOutflowStateValue(amount(tp, 200), base.Height(10)),
+		nil,
+		[]util.Hash{},
+	), true)
+
+	newTransfer := func(token string) currency.Transfer {
+		return newTestTransfer(t, tp, token, sender, priv,
+			currency.NewTransferItemMultiAmounts(receiver, []types.Amount{amount(tp, 200)}))
+	}
+
+	_, reason, err := tp.ProcessAt(currency.NewTransferProcessor(), base.Height(19), newTransfer("outflow-in-window"))
+	requireReason(t, reason, err, "over max outflow")
+
+	// NOTE counter is reset in the next window
+	_, reason, err = tp.ProcessAt(currency.NewTransferProcessor(), base.Height(20), newTransfer("outflow-next-window"))
+	requireNoReason(t, reason, err)
+
+	st, found, _ := tp.GetStateFunc(ccstate.OutflowStateKey(sender, tp.GenesisCurrency))
+	if !found {
+		t.Fatal("expected outflow state")
+	}
+
+	if v := st.Value().(ccstate.OutflowStateValue); v.Window != base.Height(20) || !v.Amount.Big().Equal(common.NewBig(200)) {
+		t.Fatalf("expected outflow counted from window 20, not %v in %v", v.Amount.Big(), v.Window)
+	}
+
+	_, reason, err = tp.ProcessAt(currency.NewTransferProcessor(), base.Height(25), newTransfer("outflow-over-next-window"))
+	requireReason(t, reason, err, "over max outflow")
+}
+
+func TestTransferRejectsBalanceOverMaxBalance(t *testing.T) {
+	tp := newLimitedTestProcessor(t, types.NewTransferLimits(common.ZeroBig, common.ZeroBig, 0, common.NewBig(1000)))
+
+	sender, _, priv := tp.NewTestAccountState(tp.NewPrivateKey("sender-max-balance"), true)
+	tp.NewTestBalanceState(sender, tp.GenesisCurrency, 10000, true)
+	receiver, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("receiver-max-balance"), true)
+	tp.NewTestBalanceState(receiver, tp.GenesisCurrency, 900, true)
+
+	op := newTestTransfer(t, tp, "over-max-balance", sender, priv,
+		currency.NewTransferItemMultiAmounts(receiver, []types.Amount{amount(tp, 101)}))
+
+	reason, err := tp.PreProcessAt(currency.NewTransferProcessor(), base.Height(10), op)
+	requireReason(t, reason, err, "over max balance")
+
+	op = newTestTransfer(t, tp, "max-balance", sender, priv,
+		currency.NewTransferItemMultiAmounts(receiver, []types.Amount{amount(tp, 100)}))
+
+	_, reason, err = tp.ProcessAt(currency.NewTransferProcessor(), base.Height(10), op)
+	requireNoReason(t, reason, err)
+
+	if b := tp.Balance(receiver, tp.GenesisCurrency); !b.Equal(common.NewBig(1000)) {
+		t.Fatalf("expected receiver balance 1000, not %v", b)
+	}
+}
+
+func TestReceiverTotalsRejectsSumOverMaxBalance(t *testing.T) {
+	tp := newLimitedTestProcessor(t, types.NewTransferLimits(common.ZeroBig, common.ZeroBig, 0, common.NewBig(100)))
+
+	receiver, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("receiver-totals"), true)
+	other, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("other-totals"), true)
+
+	totals := currency.NewReceiverTotals()
+	totals.Add(receiver, amount(tp, 60))
+	totals.Add(other, amount(tp, 60))
+
+	if err := totals.CheckMaxBalance(tp.GetStateFunc); err != nil {
+		t.Fatalf("expected amounts to different receivers allowed: %v", err)
+	}
+
+	// NOTE each amount is under max balance, but the sum is not
+	totals.Add(receiver, amount(tp, 60))
+
+	if err := totals.CheckMaxBalance(tp.GetStateFunc); err == nil {
+		t.Fatal("expected sum of amounts to same receiver over max balance")
+	}
+}
+
+func TestBlockOutflowsRejectsSumOverMaxOutflow(t *testing.T) {
+	// NOTE max outflow 300 per 10 blocks
+	tp := newLimitedTestProcessor(t, types.NewTransferLimits(common.ZeroBig, common.NewBig(300), 10, common.ZeroBig))
+
+	sender, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("sender-block-outflows"), true)
+	other, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("other-block-outflows"), true)
+
+	// NOTE 100 is already sent in window 10
+	tp.SetState(common.NewBaseState(
+		base.Height(11),
+		ccstate.OutflowStateKey(sender, tp.GenesisCurrency),
+		ccstate.NewOutflowStateValue(amount(tp, 100), base.Height(10)),
+		nil,
+		[]util.Hash{},
+	), true)
+
+	prepare := func(holder base.Address, n int64, height base.Height) []base.StateMergeValue {
+		values, err := currency.PrepareOutflowLimits(holder, []types.Amount{amount(tp, n)}, height, tp.GetStateFunc)
+		if err != nil {
+			t.Fatalf("prepare outflow limits: %v", err)
+		}
+
+		return values
+	}
+
+	outflows := currency.NewBlockOutflows()
+
+	if err := outflows.Add(prepare(sender, 150, 12), tp.GetStateFunc); err != nil {
+		t.Fatalf("expected outflow under max outflow: %v", err)
+	}
+
+	if err := outflows.Add(prepare(other, 300, 12), tp.GetStateFunc); err != nil {
+		t.Fatalf("expected outflow of other account counted separately: %v", err)
+	}
+
+	// NOTE each outflow passes with the counter of previous block, but the sum
+	// in block does not.
+	values := prepare(sender, 100, 12)
+
+	if err := outflows.Add(values, tp.GetStateFunc); err == nil {
+		t.Fatal("expected sum of outflows in block over max outflow")
+	}
+
+	// NOTE the rejected outflow is not added.
+	if err := outflows.Add(prepare(sender, 50, 12), tp.GetStateFunc); err != nil {
+		t.Fatalf("expected outflow up to max outflow: %v", err)
+	}
+
+	if err := outflows.Add(values, tp.GetStateFunc); err == nil {
+		t.Fatal("expected outflow over max outflow")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/imfact-labs/currency-model/common"
 	"github.com/imfact-labs/currency-model/state"
 	"github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/state/extension"
 	"github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util"
@@ -40,6 +41,21 @@ type TransferItemProcessor struct {
 func (opp *TransferItemProcessor) PreProcess(
 	_ context.Context, _ base.Operation, getStateFunc base.GetStateFunc,
 ) error {
+	if err := extension.CheckDepositAllowed(opp.item.Receiver(), getStateFunc); err != nil {
+		return err
+	}
+
+	amounts := opp.item.Amounts()
+	for i := range amounts {
+		if err := state.CheckCurrencyNotPaused(amounts[i].Currency(), getStateFunc); err != nil {
+			return err
+		}
+
+		if err := CheckTransferLimits(opp.item.Receiver(), amounts[i], getStateFunc); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -189,12 +205,39 @@ func (opp *TransferProcessor) PreProcess(
 		}
 	}
 
+	items := make([]AmountsItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	if err := CheckAmountsItemsFeeCurrency(fact.currency, items, getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	totals := NewReceiverTotals()
+	for i := range fact.items {
+		for _, am := range fact.items[i].Amounts() {
+			totals.Add(fact.items[i].Receiver(), am)
+		}
+	}
+
+	if err := totals.CheckMaxBalance(getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
 	for cid := range currencyID {
 		if err := state.CheckExistsState(currency.BalanceStateKey(fact.Sender(), cid), getStateFunc); err != nil {
 			return nil, base.NewBaseOperationProcessReasonError(
 					common.ErrMStateNF.Errorf("balance of currency, %v of account, %v", cid, fact.Sender())),
 				nil
 		}
+
+		if err := state.CheckAccountNotFrozen(fact.Sender(), []types.CurrencyID{cid}, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Errorf("%v", err)), nil
+		}
 	}
 
 	return ctx, nil, nil
@@ -278,6 +321,18 @@ func (opp *TransferProcessor) Process( // nolint:dupl
 		)
 	}
 
+	outflows := make([]types.Amount, 0, len(totalAmounts))
+	for key := range totalAmounts {
+		outflows = append(outflows, totalAmounts[key])
+	}
+
+	outflowValues, err := PrepareOutflowLimits(fact.Sender(), outflows, opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	stateMergeValues = append(stateMergeValues, outflowValues...)
+
 	return stateMergeValues, nil, nil
 }
 
@@ -0,0 +1,50 @@
+package currency_test
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+func TestTransferRejectsOtherCurrencyChargedByAmount(t *testing.T) {
+	tp := newTestProcessor(t, nilFeePolicy())
+
+	sender, _, priv := tp.NewTestAccountState(tp.NewPrivateKey("sender-fee-currency"), true)
+	tp.NewTestBalanceState(sender, tp.GenesisCurrency, 1000, true)
+	receiver, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("receiver-fee-currency"), true)
+
+	usd := types.CurrencyID("USD")
+	tp.NewTestBalanceState(sender, usd, 1000, true)
+
+	transfer := func(token string) currency.Transfer {
+		return newTestTransfer(t, tp, token, sender, priv,
+			currency.NewTransferItemMultiAmounts(receiver, []types.Amount{types.NewAmount(common.NewBig(100), usd)}))
+	}
+
+	for name, feeer := range map[string]types.Feeer{
+		"ratio": types.NewRatioFeeer(tp.GenesisAddr, 100, common.NewBig(1), common.NewBig(10)),
+		"tiered": types.NewTieredFeeer(tp.GenesisAddr, []types.FeeBracket{
+			types.NewFeeBracket(common.ZeroBig, common.ZeroBig, common.NewBig(1))}),
+		"split ratio": types.NewSplitFeeer(
+			types.NewRatioFeeer(tp.GenesisAddr, 100, common.NewBig(1), common.NewBig(10)), nil, 100),
+	} {
+		tp.NewTestCurrencyDesignState(types.NewCurrencyDesign(
+			common.NewBig(100000), usd, common.NewBig(9), tp.GenesisAddr, types.NewCurrencyPolicy(common.ZeroBig, feeer),
+		), true)
+
+		reason, err := tp.PreProcessAt(currency.NewTransferProcessor(), base.Height(10), transfer("transfer-"+name))
+		requireReason(t, reason, err, "charges fee by amount")
+	}
+
+	// NOTE fixed fee does not depend on the amount of fact currency.
+	tp.NewTestCurrencyDesignState(types.NewCurrencyDesign(
+		common.NewBig(100000), usd, common.NewBig(9), tp.GenesisAddr,
+		types.NewCurrencyPolicy(common.ZeroBig, types.NewFixedFeeer(tp.GenesisAddr, common.NewBig(1))),
+	), true)
+
+	reason, err := tp.PreProcessAt(currency.NewTransferProcessor(), base.Height(10), transfer("transfer-fixed"))
+	requireNoReason(t, reason, err)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/imfact-labs/mitum2/util"
 	"github.com/imfact-labs/mitum2/util/hint"
 	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
 )
 
 var (
@@ -17,15 +18,25 @@ var (
 
 type UpdateCurrencyFact struct {
 	base.BaseFact
-	currency types.CurrencyID
-	policy   types.CurrencyPolicy
+	currency     types.CurrencyID
+	policy       types.CurrencyPolicy
+	activeHeight base.Height
+	maxSupply    common.Big
 }
 
-func NewUpdateCurrencyFact(token []byte, currency types.CurrencyID, policy types.CurrencyPolicy) UpdateCurrencyFact {
+// NewUpdateCurrencyFact creates UpdateCurrencyFact. The policy is applied at
+// once if activeHeight is zero or already passed; otherwise it is kept as the
+// pending policy until the block of activeHeight. The max supply of currency
+// is replaced at once by maxSupply, if it is over zero.
+func NewUpdateCurrencyFact(
+	token []byte, currency types.CurrencyID, policy types.CurrencyPolicy, activeHeight base.Height, maxSupply common.Big,
+) UpdateCurrencyFact {
 	fact := UpdateCurrencyFact{
-		BaseFact: base.NewBaseFact(UpdateCurrencyFactHint, token),
-		currency: currency,
-		policy:   policy,
+		BaseFact:     base.NewBaseFact(UpdateCurrencyFactHint, token),
+		currency:     currency,
+		policy:       policy,
+		activeHeight: activeHeight,
+		maxSupply:    maxSupply,
 	}
 
 	fact.SetHash(fact.GenerateHash())
@@ -38,10 +49,22 @@ func (fact UpdateCurrencyFact) Hash() util.Hash {
 }
 
 func (fact UpdateCurrencyFact) Bytes() []byte {
+	var hb []byte
+	if fact.activeHeight > base.GenesisHeight {
+		hb = fact.activeHeight.Bytes()
+	}
+
+	var mb []byte
+	if ms, ok := fact.MaxSupply(); ok {
+		mb = ms.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		fact.Token(),
 		fact.currency.Bytes(),
 		fact.policy.Bytes(),
+		hb,
+		mb,
 	)
 }
 
@@ -54,6 +77,21 @@ func (fact UpdateCurrencyFact) IsValid(b []byte) error {
 		return common.ErrFactInvalid.Wrap(err)
 	}
 
+	if fc, ok := fact.policy.FeeCurrency(); ok && fc.Currency() == fact.currency {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(
+			errors.Errorf("fee currency is same with currency, %v", fact.currency)))
+	}
+
+	if fact.activeHeight < base.GenesisHeight {
+		return common.ErrFactInvalid.Wrap(common.ErrValueInvalid.Wrap(
+			errors.Errorf("invalid active height, %v", fact.activeHeight)))
+	}
+
+	if fact.maxSupply.Int != nil && !fact.maxSupply.OverNil() {
+		return common.ErrFactInvalid.Wrap(common.ErrValOOR.Wrap(
+			errors.Errorf("under zero max supply, %v", fact.maxSupply)))
+	}
+
 	if err := common.IsValidOperationFact(fact, b); err != nil {
 		return common.ErrFactInvalid.Wrap(err)
 	}
@@ -77,6 +115,20 @@ func (fact UpdateCurrencyFact) Policy() types.CurrencyPolicy {
 	return fact.policy
 }
 
+func (fact UpdateCurrencyFact) ActiveHeight() base.Height {
+	return fact.activeHeight
+}
+
+// MaxSupply returns the new max supply of currency; false if the max supply is
+// not changed.
+func (fact UpdateCurrencyFact) MaxSupply() (common.Big, bool) {
+	if fact.maxSupply.Int == nil || !fact.maxSupply.OverZero() {
+		return common.ZeroBig, false
+	}
+
+	return fact.maxSupply, true
+}
+
 func (fact UpdateCurrencyFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
 	r := make(map[types.DuplicationKeyType][]string)
 	r[extras.DuplicationKeyTypeCurrency] = []string{fact.Currency().String()}
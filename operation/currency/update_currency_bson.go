@@ -5,6 +5,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/bson"
 
 	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util/hint"
 	"github.com/imfact-labs/mitum2/util/valuehash"
 )
@@ -12,19 +13,23 @@ import (
 func (fact UpdateCurrencyFact) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
-			"_hint":    fact.Hint().String(),
-			"currency": fact.currency,
-			"policy":   fact.policy,
-			"hash":     fact.BaseFact.Hash().String(),
-			"token":    fact.BaseFact.Token(),
+			"_hint":         fact.Hint().String(),
+			"currency":      fact.currency,
+			"policy":        fact.policy,
+			"active_height": fact.activeHeight,
+			"max_supply":    fact.maxSupplyString(),
+			"hash":          fact.BaseFact.Hash().String(),
+			"token":         fact.BaseFact.Token(),
 		},
 	)
 }
 
 type UpdateCurrencyFactBSONUnmarshaler struct {
-	Hint     string   `bson:"_hint"`
-	Currency string   `bson:"currency"`
-	Policy   bson.Raw `bson:"policy"`
+	Hint         string   `bson:"_hint"`
+	Currency     string   `bson:"currency"`
+	Policy       bson.Raw `bson:"policy"`
+	ActiveHeight int64    `bson:"active_height,omitempty"`
+	MaxSupply    string   `bson:"max_supply,omitempty"`
 }
 
 func (fact *UpdateCurrencyFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -54,7 +59,7 @@ func (fact *UpdateCurrencyFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error
 	}
 	fact.BaseHinter = hint.NewBaseHinter(ht)
 
-	if err := fact.unpack(enc, uf.Currency, uf.Policy); err != nil {
+	if err := fact.unpack(enc, uf.Currency, uf.Policy, base.Height(uf.ActiveHeight), uf.MaxSupply); err != nil {
 		return common.DecorateError(err, common.ErrDecodeBson, *fact)
 	}
 
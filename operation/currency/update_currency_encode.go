@@ -3,11 +3,14 @@ package currency
 import (
 	"github.com/imfact-labs/currency-model/common"
 	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util/encoder"
 	"github.com/pkg/errors"
 )
 
-func (fact *UpdateCurrencyFact) unpack(enc encoder.Encoder, cid string, bpo []byte) error {
+func (fact *UpdateCurrencyFact) unpack(
+	enc encoder.Encoder, cid string, bpo []byte, activeHeight base.Height, ms string,
+) error {
 	if hinter, err := enc.Decode(bpo); err != nil {
 		return err
 	} else if po, ok := hinter.(types.CurrencyPolicy); !ok {
@@ -17,6 +20,25 @@ func (fact *UpdateCurrencyFact) unpack(enc encoder.Encoder, cid string, bpo []by
 	}
 
 	fact.currency = types.CurrencyID(cid)
+	fact.activeHeight = activeHeight
+
+	fact.maxSupply = common.ZeroBig
+	if len(ms) > 0 {
+		big, err := common.NewBigFromString(ms)
+		if err != nil {
+			return err
+		}
+
+		fact.maxSupply = big
+	}
 
 	return nil
 }
+
+func (fact UpdateCurrencyFact) maxSupplyString() string {
+	if ms, ok := fact.MaxSupply(); ok {
+		return ms.String()
+	}
+
+	return ""
+}
@@ -11,8 +11,10 @@ import (
 
 type UpdateCurrencyFactJSONMarshaler struct {
 	base.BaseFactJSONMarshaler
-	Currency types.CurrencyID     `json:"currency"`
-	Policy   types.CurrencyPolicy `json:"policy"`
+	Currency     types.CurrencyID     `json:"currency"`
+	Policy       types.CurrencyPolicy `json:"policy"`
+	ActiveHeight base.Height          `json:"active_height,omitempty"`
+	MaxSupply    string               `json:"max_supply,omitempty"`
 }
 
 func (fact UpdateCurrencyFact) MarshalJSON() ([]byte, error) {
@@ -20,13 +22,17 @@ func (fact UpdateCurrencyFact) MarshalJSON() ([]byte, error) {
 		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
 		Currency:              fact.currency,
 		Policy:                fact.policy,
+		ActiveHeight:          fact.activeHeight,
+		MaxSupply:             fact.maxSupplyString(),
 	})
 }
 
 type UpdateCurrencyFactJSONUnMarshaler struct {
 	base.BaseFactJSONUnmarshaler
-	Currency string          `json:"currency"`
-	Policy   json.RawMessage `json:"policy"`
+	Currency     string          `json:"currency"`
+	Policy       json.RawMessage `json:"policy"`
+	ActiveHeight base.Height     `json:"active_height"`
+	MaxSupply    string          `json:"max_supply"`
 }
 
 func (fact *UpdateCurrencyFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -37,7 +43,7 @@ func (fact *UpdateCurrencyFact) DecodeJSON(b []byte, enc encoder.Encoder) error
 
 	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
 
-	if err := fact.unpack(enc, uf.Currency, uf.Policy); err != nil {
+	if err := fact.unpack(enc, uf.Currency, uf.Policy, uf.ActiveHeight, uf.MaxSupply); err != nil {
 		return common.DecorateError(err, common.ErrDecodeJson, *fact)
 	}
 
@@ -104,13 +104,40 @@ func (opp *UpdateCurrencyProcessor) PreProcess(
 			common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id %q", fact.Currency())), nil
 	}
 
-	if receiver := fact.Policy().Feeer().Receiver(); receiver != nil {
+	for _, receiver := range types.FeeerReceivers(fact.Policy().Feeer()) {
 		if _, err := state.ExistsAccount(receiver, "feeer receiver", true, getStateFunc); err != nil {
 			return ctx, base.NewBaseOperationProcessReasonError(
 				common.ErrMPreProcess.Errorf("%v", err)), nil
 		}
 	}
 
+	if fc, ok := fact.Policy().FeeCurrency(); ok {
+		if err := state.CheckExistsState(ccstate.DesignStateKey(fc.Currency()), getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("fee currency id %q", fc.Currency())), nil
+		}
+	}
+
+	if ms, ok := fact.MaxSupply(); ok {
+		st, err := state.ExistsState(ccstate.DesignStateKey(fact.Currency()), "currency design", getStateFunc)
+		if err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id %q", fact.Currency())), nil
+		}
+
+		de, err := ccstate.GetDesignFromState(st)
+		if err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMStateValInvalid).Errorf("%v", err)), nil
+		}
+
+		if de.TotalSupply().Compare(ms) > 0 {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Wrap(common.ErrMValOOR).Errorf(
+					"max supply, %v under total supply, %v of currency, %v", ms, de.TotalSupply(), fact.Currency())), nil
+		}
+	}
+
 	if err := state.CheckExistsState(ccstate.DesignStateKey(fact.Currency()), getStateFunc); err != nil {
 		return ctx, nil, base.NewBaseOperationProcessReasonError(
 			common.ErrMPreProcess.Wrap(common.ErrMCurrencyNF).Errorf("currency id %q", fact.Currency()))
@@ -128,7 +155,7 @@ func (opp *UpdateCurrencyProcessor) Process(
 		return nil, nil, errors.Errorf("expected %T, not %T", UpdateCurrencyFact{}, op.Fact())
 	}
 
-	sts := make([]base.StateMergeValue, 1)
+	var sts []base.StateMergeValue
 
 	st, err := state.ExistsState(ccstate.DesignStateKey(fact.Currency()), fmt.Sprintf("currency design, %v", fact.Currency()), getStateFunc)
 	if err != nil {
@@ -140,13 +167,37 @@ func (opp *UpdateCurrencyProcessor) Process(
 		return nil, base.NewBaseOperationProcessReasonError("get currency design of %v; %w", fact.Currency(), err), nil
 	}
 
-	de.SetPolicy(fact.Policy())
+	ms, updateMaxSupply := fact.MaxSupply()
+	if updateMaxSupply {
+		de.SetMaxSupply(ms)
+	}
+
+	if fact.ActiveHeight() > opp.Height() {
+		pkey := ccstate.PendingPolicyStateKey(fact.Currency())
+
+		sts = append(sts, common.NewBaseStateMergeValue(
+			pkey,
+			ccstate.NewPendingPolicyStateValue(fact.Policy(), fact.ActiveHeight()),
+			func(height base.Height, nst base.State) base.StateValueMerger {
+				return ccstate.NewPendingPolicyStateValueMerger(height, pkey, nst)
+			},
+		))
+
+		if !updateMaxSupply {
+			return sts, nil, nil
+		}
+	} else {
+		de.SetPolicy(fact.Policy())
+	}
 
-	c := state.NewStateMergeValue(
+	c := common.NewBaseStateMergeValue(
 		st.Key(),
 		ccstate.NewCurrencyDesignStateValue(de),
+		func(height base.Height, nst base.State) base.StateValueMerger {
+			return ccstate.NewDesignStateValueMerger(height, st.Key(), nst)
+		},
 	)
-	sts[0] = c
+	sts = append(sts, c)
 
 	return sts, nil, nil
 }
@@ -0,0 +1,154 @@
+package currency_test
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/currency"
+	operationtest "github.com/imfact-labs/currency-model/operation/test"
+	ccstate "github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+func updateCurrency(
+	t *testing.T, tp *operationtest.TestProcessor, token string, policy types.CurrencyPolicy, activeHeight base.Height,
+) currency.UpdateCurrency {
+	t.Helper()
+
+	return updateCurrencyMaxSupply(t, tp, token, policy, activeHeight, common.ZeroBig)
+}
+
+func updateCurrencyMaxSupply(
+	t *testing.T, tp *operationtest.TestProcessor, token string, policy types.CurrencyPolicy, activeHeight base.Height,
+	maxSupply common.Big,
+) currency.UpdateCurrency {
+	t.Helper()
+
+	op, err := currency.NewUpdateCurrency(currency.NewUpdateCurrencyFact(
+		[]byte(token), tp.GenesisCurrency, policy, activeHeight, maxSupply))
+	if err != nil {
+		t.Fatalf("new update currency: %v", err)
+	}
+
+	if err := op.NodeSign(tp.NodePriv, tp.NetworkID, tp.NodeAddr); err != nil {
+		t.Fatalf("sign update currency: %v", err)
+	}
+
+	return op
+}
+
+func fixedFeePolicy(tp *operationtest.TestProcessor, fee int64) types.CurrencyPolicy {
+	return types.NewCurrencyPolicy(common.ZeroBig, types.NewFixedFeeer(tp.GenesisAddr, common.NewBig(fee)))
+}
+
+func designPolicyFee(t *testing.T, tp *operationtest.TestProcessor) string {
+	t.Helper()
+
+	return currencyDesign(t, tp, tp.GenesisCurrency).Policy().Feeer().Fee().String()
+}
+
+func TestUpdateCurrencyFeeCurrency(t *testing.T) {
+	tp := newTestProcessor(t, nilFeePolicy())
+
+	same := nilFeePolicy().WithFeeCurrency(types.NewFeeCurrency(tp.GenesisCurrency, common.NewBig(1), common.NewBig(1)))
+	if err := updateCurrency(t, tp, "same-fee-currency", same, base.GenesisHeight).IsValid(tp.NetworkID); err == nil {
+		t.Fatal("expected fee currency same with currency invalid")
+	}
+
+	unknown := nilFeePolicy().WithFeeCurrency(types.NewFeeCurrency(types.CurrencyID("USD"), common.NewBig(1), common.NewBig(1)))
+
+	reason, err := tp.PreProcessAt(currency.NewUpdateCurrencyProcessor(base.MaxThreshold), base.Height(10),
+		updateCurrency(t, tp, "unknown-fee-currency", unknown, base.GenesisHeight))
+	requireReason(t, reason, err, "fee currency id")
+
+	tp.NewTestCurrencyDesignState(types.NewCurrencyDesign(
+		common.NewBig(100000), types.CurrencyID("USD"), common.NewBig(9), tp.GenesisAddr, nilFeePolicy(),
+	), true)
+
+	reason, err = tp.PreProcessAt(currency.NewUpdateCurrencyProcessor(base.MaxThreshold), base.Height(10),
+		updateCurrency(t, tp, "fee-currency", unknown, base.GenesisHeight))
+	requireNoReason(t, reason, err)
+}
+
+func TestUpdateCurrencyAtActiveHeight(t *testing.T) {
+	tp := newTestProcessor(t, nilFeePolicy())
+
+	if err := updateCurrency(t, tp, "negative-active-height", fixedFeePolicy(tp, 20), base.NilHeight).
+		IsValid(tp.NetworkID); err == nil {
+		t.Fatal("expected negative active height invalid")
+	}
+
+	_, reason, err := tp.ProcessAt(currency.NewUpdateCurrencyProcessor(base.MaxThreshold), base.Height(10),
+		updateCurrency(t, tp, "pending", fixedFeePolicy(tp, 20), base.Height(20)))
+	requireNoReason(t, reason, err)
+
+	if fee := designPolicyFee(t, tp); fee != "0" {
+		t.Fatalf("expected policy not changed before active height, not fee %v", fee)
+	}
+
+	st, found, err := tp.GetStateFunc(ccstate.PendingPolicyStateKey(tp.GenesisCurrency))
+	if err != nil || !found {
+		t.Fatalf("expected pending policy state, %v", err)
+	}
+
+	if v := st.Value().(ccstate.PendingPolicyStateValue); v.Height != base.Height(20) ||
+		v.Policy.Feeer().Fee().String() != "20" {
+		t.Fatalf("unexpected pending policy, %v at %v", v.Policy, v.Height)
+	}
+
+	// NOTE active height already passed
+	_, reason, err = tp.ProcessAt(currency.NewUpdateCurrencyProcessor(base.MaxThreshold), base.Height(30),
+		updateCurrency(t, tp, "passed", fixedFeePolicy(tp, 30), base.Height(20)))
+	requireNoReason(t, reason, err)
+
+	if fee := designPolicyFee(t, tp); fee != "30" {
+		t.Fatalf("expected policy replaced at once, not fee %v", fee)
+	}
+}
+
+func TestUpdateCurrencyMaxSupply(t *testing.T) {
+	tp := newMaxSupplyTestProcessor(t)
+
+	if err := updateCurrencyMaxSupply(t, tp, "negative-max-supply", nilFeePolicy(), base.GenesisHeight,
+		common.NewBig(-1)).IsValid(tp.NetworkID); err == nil {
+		t.Fatal("expected negative max supply invalid")
+	}
+
+	reason, err := tp.PreProcessAt(currency.NewUpdateCurrencyProcessor(base.MaxThreshold), base.Height(10),
+		updateCurrencyMaxSupply(t, tp, "under-total-supply", nilFeePolicy(), base.GenesisHeight, common.NewBig(999)))
+	requireReason(t, reason, err, "under total supply")
+
+	_, reason, err = tp.ProcessAt(currency.NewUpdateCurrencyProcessor(base.MaxThreshold), base.Height(10),
+		updateCurrencyMaxSupply(t, tp, "max-supply", nilFeePolicy(), base.GenesisHeight, common.NewBig(2000)))
+	requireNoReason(t, reason, err)
+
+	if ms, ok := currencyDesign(t, tp, tp.GenesisCurrency).MaxSupply(); !ok || !ms.Equal(common.NewBig(2000)) {
+		t.Fatalf("expected max supply 2000, not %v", ms)
+	}
+}
+
+func TestUpdateCurrencyFactRoundTrip(t *testing.T) {
+	tp := newTestProcessor(t, nilFeePolicy())
+
+	fact := updateCurrencyMaxSupply(t, tp, "round-trip", fixedFeePolicy(tp, 20), base.Height(20), common.NewBig(2000)).Fact()
+
+	j, b := roundTrip(t, fact)
+	for _, got := range []base.Fact{j, b} {
+		if err := got.IsValid(nil); err != nil {
+			t.Fatalf("invalid decoded %T: %v", got, err)
+		}
+
+		if !got.Hash().Equal(fact.Hash()) {
+			t.Fatalf("decoded %T not matched", got)
+		}
+
+		if h := got.(currency.UpdateCurrencyFact).ActiveHeight(); h != base.Height(20) {
+			t.Fatalf("expected decoded active height 20, not %v", h)
+		}
+
+		if ms, ok := got.(currency.UpdateCurrencyFact).MaxSupply(); !ok || !ms.Equal(common.NewBig(2000)) {
+			t.Fatalf("expected decoded max supply 2000, not %v", ms)
+		}
+	}
+}
@@ -20,6 +20,8 @@ type UpdateKeyFact struct {
 	sender   base.Address
 	keys     types.AccountKeys
 	currency types.CurrencyID
+	// grace is the number of blocks the replaced keys are still accepted.
+	grace uint64
 }
 
 func NewUpdateKeyFact(
@@ -27,6 +29,7 @@ func NewUpdateKeyFact(
 	sender base.Address,
 	keys types.AccountKeys,
 	currency types.CurrencyID,
+	grace uint64,
 ) UpdateKeyFact {
 	bf := base.NewBaseFact(UpdateKeyFactHint, token)
 	fact := UpdateKeyFact{
@@ -34,6 +37,7 @@ func NewUpdateKeyFact(
 		sender:   sender,
 		keys:     keys,
 		currency: currency,
+		grace:    grace,
 	}
 	fact.SetHash(fact.GenerateHash())
 
@@ -49,11 +53,19 @@ func (fact UpdateKeyFact) GenerateHash() util.Hash {
 }
 
 func (fact UpdateKeyFact) Bytes() []byte {
+	var gb []byte
+	if fact.grace > 0 {
+		// NOTE without grace, the bytes are same with the fact before grace
+		// was added.
+		gb = util.Uint64ToBytes(fact.grace)
+	}
+
 	return util.ConcatBytesSlice(
 		fact.Token(),
 		fact.sender.Bytes(),
 		fact.keys.Bytes(),
 		fact.currency.Bytes(),
+		gb,
 	)
 }
 
@@ -103,6 +115,10 @@ func (fact UpdateKeyFact) Currency() types.CurrencyID {
 	return fact.currency
 }
 
+func (fact UpdateKeyFact) Grace() uint64 {
+	return fact.grace
+}
+
 func (fact UpdateKeyFact) Rebuild() UpdateKeyFact {
 	fact.SetHash(fact.Hash())
 	return fact
@@ -17,6 +17,7 @@ func (fact UpdateKeyFact) MarshalBSON() ([]byte, error) {
 			"sender":   fact.sender,
 			"keys":     fact.keys,
 			"currency": fact.currency,
+			"grace":    fact.grace,
 			"hash":     fact.BaseFact.Hash().String(),
 			"token":    fact.BaseFact.Token(),
 		},
@@ -28,6 +29,7 @@ type UpdateKeyFactBSONUnmarshaler struct {
 	Sender   string   `bson:"sender"`
 	Keys     bson.Raw `bson:"keys"`
 	Currency string   `bson:"currency"`
+	Grace    uint64   `bson:"grace"`
 }
 
 func (fact *UpdateKeyFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -57,7 +59,7 @@ func (fact *UpdateKeyFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 	}
 	fact.BaseHinter = hint.NewBaseHinter(ht)
 
-	if err := fact.unpack(enc, uf.Sender, uf.Keys, uf.Currency); err != nil {
+	if err := fact.unpack(enc, uf.Sender, uf.Keys, uf.Currency, uf.Grace); err != nil {
 		return common.DecorateError(err, common.ErrDecodeBson, *fact)
 	}
 
@@ -8,7 +8,7 @@ import (
 	"github.com/pkg/errors"
 )
 
-func (fact *UpdateKeyFact) unpack(enc encoder.Encoder, sd string, bks []byte, cid string) error {
+func (fact *UpdateKeyFact) unpack(enc encoder.Encoder, sd string, bks []byte, cid string, grace uint64) error {
 	switch ad, err := base.DecodeAddress(sd, enc); {
 	case err != nil:
 		return err
@@ -25,6 +25,7 @@ func (fact *UpdateKeyFact) unpack(enc encoder.Encoder, sd string, bks []byte, ci
 	}
 
 	fact.currency = types.CurrencyID(cid)
+	fact.grace = grace
 
 	return nil
 }
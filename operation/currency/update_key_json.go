@@ -15,6 +15,7 @@ type UpdateKeyFactJSONMarshaler struct {
 	Sender   base.Address      `json:"sender"`
 	Keys     types.AccountKeys `json:"keys"`
 	Currency types.CurrencyID  `json:"currency"`
+	Grace    uint64            `json:"grace,omitempty"`
 }
 
 func (fact UpdateKeyFact) MarshalJSON() ([]byte, error) {
@@ -23,6 +24,7 @@ func (fact UpdateKeyFact) MarshalJSON() ([]byte, error) {
 		Sender:                fact.sender,
 		Keys:                  fact.keys,
 		Currency:              fact.currency,
+		Grace:                 fact.grace,
 	})
 }
 
@@ -31,6 +33,7 @@ type UpdateKeyFactJSONUnMarshaler struct {
 	Sender   string          `json:"sender"`
 	Keys     json.RawMessage `json:"keys"`
 	Currency string          `json:"currency"`
+	Grace    uint64          `json:"grace"`
 }
 
 func (fact *UpdateKeyFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -41,7 +44,7 @@ func (fact *UpdateKeyFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
 
 	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
 
-	if err := fact.unpack(enc, uf.Sender, uf.Keys, uf.Currency); err != nil {
+	if err := fact.unpack(enc, uf.Sender, uf.Keys, uf.Currency, uf.Grace); err != nil {
 		return common.DecorateError(err, common.ErrDecodeJson, *fact)
 	}
 
@@ -83,6 +83,11 @@ func (opp *UpdateKeyProcessor) PreProcess(
 			common.ErrMPreProcess.Wrap(common.ErrMValueInvalid).Errorf("sender keys is same with keys to update, keys hash %v", fact.keys.Hash())), nil
 	}
 
+	if err := state.CheckAccountNotFrozen(fact.Sender(), []types.CurrencyID{fact.Currency()}, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
 	return ctx, nil, nil
 }
 
@@ -109,9 +114,41 @@ func (opp *UpdateKeyProcessor) Process( // nolint:dupl
 	}
 	stmvs = append(stmvs, state.NewStateMergeValue(tgAccSt.Key(), currency.NewAccountStateValue(uac)))
 
+	// NOTE the replaced keys are accepted until the grace period ends; keys
+	// replaced by an earlier update are no longer accepted.
+	pk := currency.PreviousKeysStateKey(fact.Sender())
+	switch pst, found, err := getStateFunc(pk); {
+	case err != nil:
+		return nil, nil, err
+	case fact.Grace() > 0:
+		stmvs = append(stmvs, state.NewStateMergeValue(
+			pk, currency.NewPreviousKeysStateValue(fact.Sender(), ac.Keys(), opp.Height()+base.Height(fact.Grace())),
+		))
+	case found:
+		stv, err := expirePreviousKeys(pst, opp.Height())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		stmvs = append(stmvs, stv)
+	}
+
 	return stmvs, nil, nil
 }
 
+// expirePreviousKeys ends the grace period of the previous keys state at
+// height, so the previous keys are no longer accepted.
+func expirePreviousKeys(st base.State, height base.Height) (base.StateMergeValue, error) {
+	pv, err := currency.StatePreviousKeysValue(st)
+	if err != nil {
+		return nil, err
+	}
+
+	return state.NewStateMergeValue(
+		st.Key(), currency.NewPreviousKeysStateValue(pv.Account, pv.Keys, height),
+	), nil
+}
+
 func (opp *UpdateKeyProcessor) Close() error {
 	updateKeyProcessorPool.Put(opp)
 
@@ -0,0 +1,143 @@
+package currency_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/imfact-labs/currency-model/operation/currency"
+	operationtest "github.com/imfact-labs/currency-model/operation/test"
+	ccstate "github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type testUpdateKey struct {
+	tp      *operationtest.TestProcessor
+	account base.Address
+	keys    types.AccountKeys
+	priv    base.Privatekey
+}
+
+func newTestUpdateKey(t *testing.T) testUpdateKey {
+	t.Helper()
+
+	tp := newTestProcessor(t, nilFeePolicy())
+
+	u := testUpdateKey{tp: tp}
+	u.account, u.keys, u.priv = tp.NewTestAccountState(tp.NewPrivateKey("account-update-key"), true)
+
+	return u
+}
+
+func (u testUpdateKey) update(t *testing.T, token string, keys types.AccountKeys, grace uint64) currency.UpdateKey {
+	t.Helper()
+
+	op, err := currency.NewUpdateKey(currency.NewUpdateKeyFact(
+		[]byte(token), u.account, keys, u.tp.GenesisCurrency, grace))
+	if err != nil {
+		t.Fatalf("new update key: %v", err)
+	}
+
+	sign(t, u.tp, &op, u.priv)
+
+	return op
+}
+
+func (u testUpdateKey) previous(t *testing.T) (ccstate.PreviousKeysStateValue, bool) {
+	t.Helper()
+
+	st, found, err := u.tp.GetStateFunc(ccstate.PreviousKeysStateKey(u.account))
+	if err != nil {
+		t.Fatalf("get previous keys state: %v", err)
+	} else if !found {
+		return ccstate.PreviousKeysStateValue{}, false
+	}
+
+	return st.Value().(ccstate.PreviousKeysStateValue), true
+}
+
+func TestUpdateKeyFactBytesWithoutGrace(t *testing.T) {
+	u := newTestUpdateKey(t)
+	keys, _ := newTestKeys(t, u.tp, 100, 100, "rotated-key")
+
+	withoutGrace := u.update(t, "update-key", keys, 0).Fact().(currency.UpdateKeyFact)
+	withGrace := u.update(t, "update-key", keys, 5).Fact().(currency.UpdateKeyFact)
+
+	// NOTE the fact without grace keeps the bytes of the fact before grace.
+	if !bytes.HasPrefix(withGrace.Bytes(), withoutGrace.Bytes()) || bytes.Equal(withGrace.Bytes(), withoutGrace.Bytes()) {
+		t.Fatal("expected grace appended to the fact bytes")
+	}
+}
+
+func TestUpdateKeyRejections(t *testing.T) {
+	u := newTestUpdateKey(t)
+
+	reason, err := u.tp.PreProcessAt(currency.NewUpdateKeyProcessor(), base.Height(10), u.update(t, "same-keys", u.keys, 5))
+	requireReason(t, reason, err, "same with keys to update")
+
+	keys, _ := newTestKeys(t, u.tp, 100, 100, "rotated-key")
+	u.account, u.priv = u.tp.NewTestContractAccountState(u.tp.GenesisAddr, u.tp.NewPrivateKey("contract-update-key"), true)
+
+	reason, err = u.tp.PreProcessAt(currency.NewUpdateKeyProcessor(), base.Height(10), u.update(t, "contract", keys, 5))
+	requireReason(t, reason, err, "Contract account not allowed")
+}
+
+func TestUpdateKeyGracePeriod(t *testing.T) {
+	u := newTestUpdateKey(t)
+	rotated, _ := newTestKeys(t, u.tp, 100, 100, "rotated-key")
+	next, _ := newTestKeys(t, u.tp, 100, 100, "next-rotated-key")
+
+	_, reason, err := u.tp.ProcessAt(currency.NewUpdateKeyProcessor(), base.Height(10), u.update(t, "rotate", rotated, 5))
+	requireNoReason(t, reason, err)
+
+	v, found := u.previous(t)
+	switch {
+	case !found:
+		t.Fatal("expected previous keys state")
+	case !v.Keys.Equal(u.keys) || v.Until != base.Height(15):
+		t.Fatalf("expected replaced keys until 15, not until %v", v.Until)
+	case !v.Active(base.Height(14)) || v.Active(base.Height(15)):
+		t.Fatalf("expected replaced keys active below 15")
+	}
+
+	// NOTE the update without grace ends the grace period of the earlier
+	// update.
+	_, reason, err = u.tp.ProcessAt(currency.NewUpdateKeyProcessor(), base.Height(12), u.update(t, "rotate-next", next, 0))
+	requireNoReason(t, reason, err)
+
+	if v, _ := u.previous(t); v.Active(base.Height(12)) {
+		t.Fatalf("expected previous keys expired at 12, not until %v", v.Until)
+	}
+}
+
+func TestUpdateKeyWithoutGrace(t *testing.T) {
+	u := newTestUpdateKey(t)
+	keys, _ := newTestKeys(t, u.tp, 100, 100, "rotated-key")
+
+	_, reason, err := u.tp.ProcessAt(currency.NewUpdateKeyProcessor(), base.Height(10), u.update(t, "rotate", keys, 0))
+	requireNoReason(t, reason, err)
+
+	if _, found := u.previous(t); found {
+		t.Fatal("expected no previous keys state without grace")
+	}
+}
+
+func TestUpdateKeyFactRoundTrip(t *testing.T) {
+	u := newTestUpdateKey(t)
+	keys, _ := newTestKeys(t, u.tp, 100, 100, "rotated-key")
+
+	for _, grace := range []uint64{0, 5} {
+		fact := u.update(t, "update-key-round-trip", keys, grace).Fact().(currency.UpdateKeyFact)
+
+		j, b := roundTrip(t, fact)
+		for _, got := range []currency.UpdateKeyFact{j, b} {
+			if err := got.IsValid(nil); err != nil {
+				t.Fatalf("invalid decoded update key: %v", err)
+			}
+
+			if !got.Hash().Equal(fact.Hash()) || got.Grace() != grace {
+				t.Fatalf("decoded update key not matched, grace %d", got.Grace())
+			}
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package extension
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	AcceptOwnerFactHint = hint.MustNewHint("mitum-extension-accept-owner-operation-fact-v0.0.1")
+	AcceptOwnerHint     = hint.MustNewHint("mitum-extension-accept-owner-operation-v0.0.1")
+)
+
+// AcceptOwnerFact accepts the ownership of contract account nominated by
+// UpdateOwner; the sender must be the pending owner of the contract account.
+type AcceptOwnerFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	currency types.CurrencyID
+}
+
+func NewAcceptOwnerFact(
+	token []byte,
+	sender,
+	contract base.Address,
+	currency types.CurrencyID,
+) AcceptOwnerFact {
+	fact := AcceptOwnerFact{
+		BaseFact: base.NewBaseFact(AcceptOwnerFactHint, token),
+		sender:   sender,
+		contract: contract,
+		currency: currency,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact AcceptOwnerFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact AcceptOwnerFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact AcceptOwnerFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.contract, fact.currency); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("sender %v is same with contract account", fact.sender)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact AcceptOwnerFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AcceptOwnerFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact AcceptOwnerFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact AcceptOwnerFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact AcceptOwnerFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact AcceptOwnerFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact AcceptOwnerFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.contract}, nil
+}
+
+func (fact AcceptOwnerFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact AcceptOwnerFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact AcceptOwnerFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact AcceptOwnerFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeContractStatus] = []string{fact.Contract().String()}
+
+	return r, nil
+}
+
+type AcceptOwner struct {
+	extras.ExtendedOperation
+}
+
+func (op AcceptOwner) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewAcceptOwner(fact AcceptOwnerFact) (AcceptOwner, error) {
+	return AcceptOwner{
+		ExtendedOperation: extras.NewExtendedOperation(AcceptOwnerHint, fact),
+	}, nil
+}
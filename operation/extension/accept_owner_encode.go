@@ -0,0 +1,27 @@
+package extension
+
+import (
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+func (fact *AcceptOwnerFact) unpack(enc encoder.Encoder, sd, ct, cid string) error {
+	switch ad, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.sender = ad
+	}
+
+	switch ad, err := base.DecodeAddress(ct, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.contract = ad
+	}
+
+	fact.currency = types.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package extension
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/extension"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var AcceptOwnerProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AcceptOwnerProcessor)
+	},
+}
+
+func (AcceptOwner) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type AcceptOwnerProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewAcceptOwnerProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("create new AcceptOwnerProcessor")
+
+		nopp := AcceptOwnerProcessorPool.Get()
+		opp, ok := nopp.(*AcceptOwnerProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected AcceptOwnerProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		return opp, nil
+	}
+}
+
+func (opp *AcceptOwnerProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(AcceptOwnerFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected AcceptOwnerFact, not %T", op.Fact())), nil
+	}
+
+	_, cSt, aErr, cErr := state.ExistsCAccount(fact.Contract(), "contract", true, true, getStateFunc)
+	switch {
+	case aErr != nil:
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	case cErr != nil:
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMCAccountNF).
+				Errorf("%v", cErr)), nil
+	}
+
+	status, err := extension.StateContractAccountValue(cSt)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMStateValInvalid).
+				Errorf("%v", err)), nil
+	}
+
+	if status.PendingOwner() == nil || !status.PendingOwner().Equal(fact.Sender()) {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMAccountNAth).
+				Errorf("sender %v is not pending owner of contract account %v", fact.Sender(), fact.Contract())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *AcceptOwnerProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(AcceptOwnerFact)
+
+	ctAccSt, err := state.ExistsState(extension.StateKeyContractAccount(fact.Contract()), "contract account status", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("check existence of contract account status %v ; %w", fact.Contract(), err), nil
+	}
+
+	status, err := extension.StateContractAccountValue(ctAccSt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := status.SetOwner(fact.Sender()); err != nil {
+		return nil, nil, err
+	}
+
+	if err := status.SetPendingOwner(nil); err != nil {
+		return nil, nil, err
+	}
+
+	return []base.StateMergeValue{
+		state.NewStateMergeValue(ctAccSt.Key(), extension.NewContractAccountStateValue(status)),
+	}, nil, nil
+}
+
+func (opp *AcceptOwnerProcessor) Close() error {
+	AcceptOwnerProcessorPool.Put(opp)
+
+	return nil
+}
@@ -28,6 +28,8 @@ type CreateContractAccountItem interface {
 	Bytes() []byte
 	Keys() types.AccountKeys
 	Address() (base.Address, error)
+	Salt() string
+	ContractAddress(owner base.Address) (base.Address, error)
 	Rebuild() CreateContractAccountItem
 }
 
@@ -93,25 +95,26 @@ func (fact CreateContractAccountFact) IsValid(b []byte) error {
 		return common.ErrFactInvalid.Wrap(err)
 	}
 
-	foundKeys := map[string]struct{}{}
+	foundTargets := map[string]struct{}{}
 	for i := range fact.items {
 		if err := util.CheckIsValiders(nil, false, fact.items[i]); err != nil {
 			return common.ErrFactInvalid.Wrap(err)
 		}
 
 		it := fact.items[i]
-		k := it.Keys().Hash().String()
-		if _, found := foundKeys[k]; found {
-			return common.ErrFactInvalid.Wrap(common.ErrDupVal.Wrap(errors.Errorf("account Keys, %s", k)))
+		a, err := it.ContractAddress(fact.sender)
+		if err != nil {
+			return common.ErrFactInvalid.Wrap(err)
 		}
 
-		switch a, err := it.Address(); {
-		case err != nil:
-			return common.ErrFactInvalid.Wrap(err)
+		k := a.String()
+		switch _, found := foundTargets[k]; {
+		case found:
+			return common.ErrFactInvalid.Wrap(common.ErrDupVal.Wrap(errors.Errorf("target account, %s", k)))
 		case fact.sender.Equal(a):
 			return common.ErrFactInvalid.Wrap(common.ErrSelfTarget.Wrap(errors.Errorf("target account is same with sender account, %v", fact.sender)))
 		default:
-			foundKeys[k] = struct{}{}
+			foundTargets[k] = struct{}{}
 		}
 	}
 
@@ -145,7 +148,7 @@ func (fact CreateContractAccountFact) Currency() types.CurrencyID {
 func (fact CreateContractAccountFact) Targets() ([]base.Address, error) {
 	as := make([]base.Address, len(fact.items))
 	for i := range fact.items {
-		a, err := fact.items[i].Address()
+		a, err := fact.items[i].ContractAddress(fact.sender)
 		if err != nil {
 			return nil, err
 		}
@@ -13,24 +13,34 @@ type BaseCreateContractAccountItem struct {
 	hint.BaseHinter
 	keys    types.AccountKeys
 	amounts []types.Amount
+	salt    string
 }
 
-func NewBaseCreateContractAccountItem(ht hint.Hint, keys types.AccountKeys, amounts []types.Amount) BaseCreateContractAccountItem {
+func NewBaseCreateContractAccountItem(
+	ht hint.Hint, keys types.AccountKeys, amounts []types.Amount, salt string,
+) BaseCreateContractAccountItem {
 	return BaseCreateContractAccountItem{
 		BaseHinter: hint.NewBaseHinter(ht),
 		keys:       keys,
 		amounts:    amounts,
+		salt:       salt,
 	}
 }
 
 func (it BaseCreateContractAccountItem) Bytes() []byte {
 	length := 1
-	bs := make([][]byte, len(it.amounts)+1)
+	bs := make([][]byte, len(it.amounts)+2)
 	bs[0] = it.keys.Bytes()
 	for i := range it.amounts {
 		bs[i+length] = it.amounts[i].Bytes()
 	}
 
+	// NOTE salt is appended only when set, so items without salt keep the
+	// same bytes
+	if len(it.salt) > 0 {
+		bs[len(bs)-1] = []byte(it.salt)
+	}
+
 	return util.ConcatBytesSlice(bs...)
 }
 
@@ -43,6 +53,10 @@ func (it BaseCreateContractAccountItem) IsValid([]byte) error {
 		return err
 	}
 
+	if n := len(it.salt); n > types.MaxContractAddressSaltLength {
+		return common.ErrValOOR.Wrap(errors.Errorf("salt length over max, %d > %d", n, types.MaxContractAddressSaltLength))
+	}
+
 	founds := map[types.CurrencyID]struct{}{}
 	for i := range it.amounts {
 		am := it.amounts[i]
@@ -65,10 +79,27 @@ func (it BaseCreateContractAccountItem) Keys() types.AccountKeys {
 	return it.keys
 }
 
+// Address returns the address derived from keys. It is the address of the new
+// contract account only when salt is empty; use ContractAddress instead.
 func (it BaseCreateContractAccountItem) Address() (base.Address, error) {
 	return types.NewAddressFromKeys(it.keys)
 }
 
+func (it BaseCreateContractAccountItem) Salt() string {
+	return it.salt
+}
+
+// ContractAddress returns the address of the new contract account created by
+// owner. With salt it is derived by types.NewContractAddress, otherwise from
+// keys.
+func (it BaseCreateContractAccountItem) ContractAddress(owner base.Address) (base.Address, error) {
+	if len(it.salt) < 1 {
+		return it.Address()
+	}
+
+	return types.NewContractAddress(owner, it.salt)
+}
+
 func (it BaseCreateContractAccountItem) Amounts() []types.Amount {
 	return it.amounts
 }
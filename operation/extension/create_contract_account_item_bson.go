@@ -12,6 +12,7 @@ func (it BaseCreateContractAccountItem) MarshalBSON() ([]byte, error) {
 			"_hint":   it.Hint().String(),
 			"keys":    it.keys,
 			"amounts": it.amounts,
+			"salt":    it.salt,
 		},
 	)
 }
@@ -20,6 +21,7 @@ type CreateContractAccountItemBSONUnmarshaler struct {
 	Hint    string   `bson:"_hint"`
 	Keys    bson.Raw `bson:"keys"`
 	Amounts bson.Raw `bson:"amounts"`
+	Salt    string   `bson:"salt,omitempty"`
 }
 
 func (it *BaseCreateContractAccountItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -33,5 +35,5 @@ func (it *BaseCreateContractAccountItem) DecodeBSON(b []byte, enc *bsonenc.Encod
 		return err
 	}
 
-	return it.unpack(enc, ht, uit.Keys, uit.Amounts)
+	return it.unpack(enc, ht, uit.Keys, uit.Amounts, uit.Salt)
 }
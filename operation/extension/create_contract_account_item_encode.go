@@ -8,7 +8,7 @@ import (
 	"github.com/pkg/errors"
 )
 
-func (it *BaseCreateContractAccountItem) unpack(enc encoder.Encoder, ht hint.Hint, bks []byte, bam []byte) error {
+func (it *BaseCreateContractAccountItem) unpack(enc encoder.Encoder, ht hint.Hint, bks []byte, bam []byte, salt string) error {
 	it.BaseHinter = hint.NewBaseHinter(ht)
 
 	if hinter, err := enc.Decode(bks); err != nil {
@@ -35,6 +35,7 @@ func (it *BaseCreateContractAccountItem) unpack(enc encoder.Encoder, ht hint.Hin
 	}
 
 	it.amounts = amounts
+	it.salt = salt
 
 	return nil
 }
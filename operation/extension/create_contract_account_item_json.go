@@ -13,6 +13,7 @@ type CreateContractAccountItemJSONMarshaler struct {
 	hint.BaseHinter
 	Keys    types.AccountKeys `json:"keys"`
 	Amounts []types.Amount    `json:"amounts"`
+	Salt    string            `json:"salt,omitempty"`
 }
 
 func (it BaseCreateContractAccountItem) MarshalJSON() ([]byte, error) {
@@ -20,6 +21,7 @@ func (it BaseCreateContractAccountItem) MarshalJSON() ([]byte, error) {
 		BaseHinter: it.BaseHinter,
 		Keys:       it.keys,
 		Amounts:    it.amounts,
+		Salt:       it.salt,
 	})
 }
 
@@ -27,6 +29,7 @@ type CreateContractAccountItemJSONUnMarshaler struct {
 	Hint    hint.Hint       `json:"_hint"`
 	Keys    json.RawMessage `json:"keys"`
 	Amounts json.RawMessage `json:"amounts"`
+	Salt    string          `json:"salt,omitempty"`
 }
 
 func (it *BaseCreateContractAccountItem) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -35,5 +38,5 @@ func (it *BaseCreateContractAccountItem) DecodeJSON(b []byte, enc encoder.Encode
 		return err
 	}
 
-	return it.unpack(enc, uit.Hint, uit.Keys, uit.Amounts)
+	return it.unpack(enc, uit.Hint, uit.Keys, uit.Amounts, uit.Salt)
 }
@@ -17,9 +17,9 @@ type CreateContractAccountItemMultiAmounts struct {
 	BaseCreateContractAccountItem
 }
 
-func NewCreateContractAccountItemMultiAmounts(keys types.AccountKeys, amounts []types.Amount) CreateContractAccountItemMultiAmounts {
+func NewCreateContractAccountItemMultiAmounts(keys types.AccountKeys, amounts []types.Amount, salt string) CreateContractAccountItemMultiAmounts {
 	return CreateContractAccountItemMultiAmounts{
-		BaseCreateContractAccountItem: NewBaseCreateContractAccountItem(CreateContractAccountItemMultiAmountsHint, keys, amounts),
+		BaseCreateContractAccountItem: NewBaseCreateContractAccountItem(CreateContractAccountItemMultiAmountsHint, keys, amounts, salt),
 	}
 }
 
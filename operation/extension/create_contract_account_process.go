@@ -38,6 +38,7 @@ type CreateContractAccountItemProcessor struct {
 	h      util.Hash
 	sender base.Address
 	item   CreateContractAccountItem
+	target base.Address
 	ns     base.StateMergeValue
 	oas    base.StateMergeValue
 	nb     map[types.CurrencyID]base.StateMergeValue
@@ -48,10 +49,11 @@ func (opp *CreateContractAccountItemProcessor) PreProcess(
 ) error {
 	e := util.StringError("preprocess CreateContractAccountItemProcessor")
 
-	target, err := opp.item.Address()
+	target, err := opp.item.ContractAddress(opp.sender)
 	if err != nil {
 		return e.Wrap(err)
 	}
+	opp.target = target
 
 	ast, cst, aErr, cErr := state.ExistsCAccount(target, "target", false, false, getStateFunc)
 	if aErr != nil {
@@ -72,6 +74,9 @@ func (opp *CreateContractAccountItemProcessor) PreProcess(
 		if err != nil {
 			return e.Wrap(err)
 		}
+		if err := state.CheckCurrencyNotPaused(cid, getStateFunc); err != nil {
+			return e.Wrap(err)
+		}
 		if am.Big().Compare(policy.MinBalance()) < 0 {
 			return e.Wrap(common.ErrValOOR.Wrap(errors.Errorf("amount under new account minimum balance, %v < %v", am.Big(), policy.MinBalance())))
 
@@ -106,7 +111,7 @@ func (opp *CreateContractAccountItemProcessor) Process(
 	e := util.StringError("process for CreateContractAccountItemProcessor")
 
 	sts := make([]base.StateMergeValue, len(opp.item.Amounts())+2)
-	nac, err := types.NewAccountFromKeys(opp.item.Keys())
+	nac, err := types.NewAccount(opp.target, opp.item.Keys())
 	if err != nil {
 		return nil, e.Wrap(err)
 	}
@@ -149,6 +154,7 @@ func (opp *CreateContractAccountItemProcessor) Process(
 func (opp *CreateContractAccountItemProcessor) Close() {
 	opp.h = nil
 	opp.item = nil
+	opp.target = nil
 	opp.ns = nil
 	opp.nb = nil
 	opp.sender = nil
@@ -256,6 +262,11 @@ func (opp *CreateContractAccountProcessor) PreProcess(
 					common.ErrMStateNF.Errorf("balance of currency, %v of account, %v", cid, fact.Sender())),
 				nil
 		}
+
+		if err := state.CheckAccountNotFrozen(fact.Sender(), []types.CurrencyID{cid}, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.Errorf("%v", err)), nil
+		}
 	}
 
 	return ctx, nil, nil
@@ -15,9 +15,9 @@ type CreateContractAccountItemSingleAmount struct {
 	BaseCreateContractAccountItem
 }
 
-func NewCreateContractAccountItemSingleAmount(keys types.AccountKeys, amount types.Amount) CreateContractAccountItemSingleAmount {
+func NewCreateContractAccountItemSingleAmount(keys types.AccountKeys, amount types.Amount, salt string) CreateContractAccountItemSingleAmount {
 	return CreateContractAccountItemSingleAmount{
-		BaseCreateContractAccountItem: NewBaseCreateContractAccountItem(CreateContractAccountItemSingleAmountHint, keys, []types.Amount{amount}),
+		BaseCreateContractAccountItem: NewBaseCreateContractAccountItem(CreateContractAccountItemSingleAmountHint, keys, []types.Amount{amount}, salt),
 	}
 }
 
@@ -0,0 +1,148 @@
+package extension_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extension"
+	operationtest "github.com/imfact-labs/currency-model/operation/test"
+	ccstate "github.com/imfact-labs/currency-model/state/currency"
+	cestate "github.com/imfact-labs/currency-model/state/extension"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type testCreateContract struct {
+	tp        *operationtest.TestProcessor
+	owner     base.Address
+	ownerPriv base.Privatekey
+	keys      types.AccountKeys // NOTE keys of the new contract accounts
+}
+
+func newTestCreateContract(t *testing.T) testCreateContract {
+	t.Helper()
+
+	tp := newTestProcessor(t, nilFeePolicy())
+
+	c := testCreateContract{tp: tp}
+	c.owner, c.keys, c.ownerPriv = tp.NewTestAccountState(tp.NewPrivateKey("owner-salted-contract"), true)
+	tp.NewTestBalanceState(c.owner, tp.GenesisCurrency, 1000, true)
+
+	return c
+}
+
+func (c testCreateContract) create(t *testing.T, token string, salts ...string) extension.CreateContractAccount {
+	t.Helper()
+
+	items := make([]extension.CreateContractAccountItem, len(salts))
+	for i := range salts {
+		items[i] = extension.NewCreateContractAccountItemMultiAmounts(
+			c.keys, []types.Amount{amount(c.tp, 100)}, salts[i])
+	}
+
+	op, err := extension.NewCreateContractAccount(extension.NewCreateContractAccountFact(
+		[]byte(token), c.owner, items, c.tp.GenesisCurrency))
+	if err != nil {
+		t.Fatalf("new create contract account: %v", err)
+	}
+
+	sign(t, c.tp, &op, c.ownerPriv)
+
+	return op
+}
+
+func TestCreateContractAccountSaltValidation(t *testing.T) {
+	c := newTestCreateContract(t)
+
+	if err := c.create(t, "salted", "salt-a", "salt-b").IsValid(c.tp.NetworkID); err != nil {
+		t.Fatalf("expected items with keys of sender and different salts valid: %v", err)
+	}
+
+	if err := c.create(t, "same-salt", "salt-a", "salt-a").IsValid(c.tp.NetworkID); err == nil {
+		t.Fatal("expected items with same salt invalid")
+	}
+
+	long := strings.Repeat("s", types.MaxContractAddressSaltLength+1)
+	if err := c.create(t, "long-salt", long).IsValid(c.tp.NetworkID); err == nil {
+		t.Fatal("expected salt over max length invalid")
+	}
+}
+
+func TestCreateContractAccountAtSaltedAddress(t *testing.T) {
+	c := newTestCreateContract(t)
+
+	expected, err := types.NewContractAddress(c.owner, "salt-a")
+	if err != nil {
+		t.Fatalf("new contract address: %v", err)
+	}
+
+	op := c.create(t, "create-salted", "salt-a")
+
+	if a, err := op.Fact().(extension.CreateContractAccountFact).Targets(); err != nil {
+		t.Fatalf("targets: %v", err)
+	} else if len(a) != 1 || !a[0].Equal(expected) {
+		t.Fatalf("expected target %v, not %v", expected, a)
+	}
+
+	_, reason, err := c.tp.ProcessAt(extension.NewCreateContractAccountProcessor(), base.Height(3), op)
+	requireNoReason(t, reason, err)
+
+	st, found, err := c.tp.GetStateFunc(ccstate.AccountStateKey(expected))
+	if err != nil || !found {
+		t.Fatalf("expected account state at salted address, %v", err)
+	} else if ac, err := ccstate.LoadAccountStateValue(st); err != nil || !ac.Address().Equal(expected) {
+		t.Fatalf("expected account at salted address, %v", err)
+	}
+
+	st, _, _ = c.tp.GetStateFunc(cestate.StateKeyContractAccount(expected))
+	if cs, err := cestate.StateContractAccountValue(st); err != nil || !cs.Owner().Equal(c.owner) {
+		t.Fatalf("expected contract account owned by %v, %v", c.owner, err)
+	}
+
+	if !c.tp.Balance(expected, c.tp.GenesisCurrency).Equal(common.NewBig(100)) {
+		t.Fatalf("expected balance 100, not %v", c.tp.Balance(expected, c.tp.GenesisCurrency))
+	}
+
+	// NOTE same owner and salt can not create again.
+	reason, err = c.tp.PreProcessAt(extension.NewCreateContractAccountProcessor(), base.Height(4), c.create(t, "create-salted-again", "salt-a"))
+	requireReason(t, reason, err, string(common.ErrMAccountE))
+
+	reason, err = c.tp.PreProcessAt(extension.NewCreateContractAccountProcessor(), base.Height(4), c.create(t, "create-other-salt", "salt-b"))
+	requireNoReason(t, reason, err)
+}
+
+func TestCreateContractAccountFactRoundTrip(t *testing.T) {
+	c := newTestCreateContract(t)
+
+	// NOTE without salt, the address comes from the keys, so the keys of
+	// sender can not be used.
+	unsalted := c
+	_, unsalted.keys, _ = c.tp.NewTestAccountState(c.tp.NewPrivateKey("unsalted-contract"), false)
+
+	for _, salts := range [][]string{{""}, {"salt-a", "salt-b"}} {
+		cc := c
+		if len(salts[0]) < 1 {
+			cc = unsalted
+		}
+
+		fact := cc.create(t, "create-round-trip", salts...).Fact().(extension.CreateContractAccountFact)
+
+		j, b := roundTrip(t, fact)
+		for _, got := range []extension.CreateContractAccountFact{j, b} {
+			if err := got.IsValid(nil); err != nil {
+				t.Fatalf("invalid decoded create contract account: %v", err)
+			}
+
+			if !got.Hash().Equal(fact.Hash()) {
+				t.Fatal("decoded create contract account not matched")
+			}
+
+			for i := range salts {
+				if s := got.Items()[i].Salt(); s != salts[i] {
+					t.Fatalf("expected salt %q, not %q", salts[i], s)
+				}
+			}
+		}
+	}
+}
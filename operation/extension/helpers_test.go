@@ -0,0 +1,115 @@
+package extension_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/imfact-labs/currency-model/app/runtime/steps"
+	"github.com/imfact-labs/currency-model/common"
+	operationtest "github.com/imfact-labs/currency-model/operation/test"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	jsonenc "github.com/imfact-labs/mitum2/util/encoder/json"
+)
+
+// newTestProcessor sets up the states with the genesis currency of policy.
+func newTestProcessor(t *testing.T, policy types.CurrencyPolicy) *operationtest.TestProcessor {
+	t.Helper()
+
+	var tp operationtest.TestProcessor
+	tp.Setup(operationtest.NewMockStateGetter())
+
+	tp.NewTestCurrencyDesignState(types.NewCurrencyDesign(
+		common.NewBig(100000), tp.GenesisCurrency, common.NewBig(9), tp.GenesisAddr, policy,
+	), true)
+
+	return &tp
+}
+
+func nilFeePolicy() types.CurrencyPolicy {
+	return types.NewCurrencyPolicy(common.ZeroBig, types.NewNilFeeer())
+}
+
+func amount(tp *operationtest.TestProcessor, n int64) types.Amount {
+	return types.NewAmount(common.NewBig(n), tp.GenesisCurrency)
+}
+
+type signer interface {
+	Sign(base.Privatekey, base.NetworkID) error
+}
+
+func sign(t *testing.T, tp *operationtest.TestProcessor, op signer, priv base.Privatekey) {
+	t.Helper()
+
+	if err := op.Sign(priv, tp.NetworkID); err != nil {
+		t.Fatalf("sign operation: %v", err)
+	}
+}
+
+func requireNoReason(t *testing.T, reason base.OperationProcessReasonError, err error) {
+	t.Helper()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if reason != nil {
+		t.Fatalf("unexpected reason: %v", reason)
+	}
+}
+
+func requireReason(t *testing.T, reason base.OperationProcessReasonError, err error, contains string) {
+	t.Helper()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if reason == nil || !strings.Contains(reason.Error(), contains) {
+		t.Fatalf("expected reason with %q, not %v", contains, reason)
+	}
+}
+
+func newTestEncoders(t *testing.T) (*encoder.Encoders, *bsonenc.Encoder) {
+	t.Helper()
+
+	jenc := jsonenc.NewEncoder()
+	encs := encoder.NewEncoders(jenc, jenc)
+	benc := bsonenc.NewEncoder()
+
+	if err := encs.AddEncoder(benc); err != nil {
+		t.Fatalf("add bson encoder: %v", err)
+	}
+
+	if err := steps.LoadHinters(encs); err != nil {
+		t.Fatalf("load hinters: %v", err)
+	}
+
+	return encs, benc
+}
+
+// roundTrip encodes v by json and bson, and returns the decoded ones.
+func roundTrip[T any](t *testing.T, v T) (fromJSON, fromBSON T) {
+	t.Helper()
+
+	encs, benc := newTestEncoders(t)
+
+	decode := func(name string, enc encoder.Encoder) T {
+		b, err := enc.Marshal(v)
+		if err != nil {
+			t.Fatalf("marshal %s: %v", name, err)
+		}
+
+		i, err := enc.Decode(b)
+		if err != nil {
+			t.Fatalf("decode %s: %v", name, err)
+		}
+
+		got, ok := i.(T)
+		if !ok {
+			t.Fatalf("decoded %s type = %T", name, i)
+		}
+
+		return got
+	}
+
+	return decode("json", encs.JSON()), decode("bson", benc)
+}
@@ -69,7 +69,7 @@ func (t *TestCreateContractAccountProcessor) Print(fileName string) *TestCreateC
 func (t *TestCreateContractAccountProcessor) MakeItem(
 	target test.Account, amounts []types.Amount, targetItems []CreateContractAccountItem,
 ) *TestCreateContractAccountProcessor {
-	item := NewCreateContractAccountItemMultiAmounts(target.Keys(), amounts)
+	item := NewCreateContractAccountItemMultiAmounts(target.Keys(), amounts, "")
 	test.UpdateSlice[CreateContractAccountItem](item, targetItems)
 
 	return t
@@ -82,7 +82,7 @@ func (t *TestUpdateHandlerProcessor) MakeOperation(
 
 	op, _ := NewUpdateHandler(
 		NewUpdateHandlerFact(
-			[]byte("token"), sender, contract, oprs, currency,
+			[]byte("token"), sender, contract, oprs, nil, currency,
 		),
 	)
 	_ = op.Sign(privatekey, t.NetworkID)
@@ -0,0 +1,157 @@
+package extension
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+)
+
+var (
+	UpdateActivationFactHint = hint.MustNewHint("mitum-extension-update-activation-operation-fact-v0.0.1")
+	UpdateActivationHint     = hint.MustNewHint("mitum-extension-update-activation-operation-v0.0.1")
+)
+
+// UpdateActivationFact activates or deactivates contract account. Deactivated
+// contract account rejects the operations for its service until it is
+// activated again.
+type UpdateActivationFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	active   bool
+	currency types.CurrencyID
+}
+
+func NewUpdateActivationFact(
+	token []byte,
+	sender,
+	contract base.Address,
+	active bool,
+	currency types.CurrencyID,
+) UpdateActivationFact {
+	fact := UpdateActivationFact{
+		BaseFact: base.NewBaseFact(UpdateActivationFactHint, token),
+		sender:   sender,
+		contract: contract,
+		active:   active,
+		currency: currency,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateActivationFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UpdateActivationFact) Bytes() []byte {
+	var active int8
+	if fact.active {
+		active = 1
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte{byte(active)},
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact UpdateActivationFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.contract, fact.currency); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact UpdateActivationFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateActivationFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UpdateActivationFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact UpdateActivationFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateActivationFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateActivationFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact UpdateActivationFact) Active() bool {
+	return fact.active
+}
+
+func (fact UpdateActivationFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.contract}, nil
+}
+
+func (fact UpdateActivationFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact UpdateActivationFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateActivationFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateActivationFact) ContractOwnerOnly() [][2]base.Address {
+	return [][2]base.Address{{fact.contract, fact.sender}}
+}
+
+func (fact UpdateActivationFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeContractStatus] = []string{fact.Contract().String()}
+
+	return r, nil
+}
+
+type UpdateActivation struct {
+	extras.ExtendedOperation
+}
+
+func (op UpdateActivation) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewUpdateActivation(fact UpdateActivationFact) (UpdateActivation, error) {
+	return UpdateActivation{
+		ExtendedOperation: extras.NewExtendedOperation(UpdateActivationHint, fact),
+	}, nil
+}
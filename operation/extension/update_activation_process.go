@@ -0,0 +1,130 @@
+package extension
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/extension"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var UpdateActivationProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(UpdateActivationProcessor)
+	},
+}
+
+func (UpdateActivation) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type UpdateActivationProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewUpdateActivationProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("create new UpdateActivationProcessor")
+
+		nopp := UpdateActivationProcessorPool.Get()
+		opp, ok := nopp.(*UpdateActivationProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected UpdateActivationProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		return opp, nil
+	}
+}
+
+func (opp *UpdateActivationProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(UpdateActivationFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected UpdateActivationFact, not %T", op.Fact())), nil
+	}
+
+	st, err := state.ExistsState(extension.StateKeyContractAccount(fact.Contract()), "contract account status", getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	status, err := extension.StateContractAccountValue(st)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", err)), nil
+	}
+
+	switch {
+	case status.IsActive() == fact.Active():
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMValueInvalid).
+				Errorf("contract account %v is already in the activation, %v", fact.Contract(), fact.Active())), nil
+	case fact.Active() && status.RegisterOperation() == nil:
+		// NOTE contract account, which has no registered service, can not be
+		// activated.
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMServiceNF).
+				Errorf("contract account %v has no registered service", fact.Contract())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *UpdateActivationProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(UpdateActivationFact)
+
+	ctAccSt, err := state.ExistsState(extension.StateKeyContractAccount(fact.Contract()), "contract account status", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("check existence of contract account status %v ; %w", fact.Contract(), err), nil
+	}
+
+	status, err := extension.StateContractAccountValue(ctAccSt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status.SetActive(fact.Active())
+
+	return []base.StateMergeValue{
+		state.NewStateMergeValue(ctAccSt.Key(), extension.NewContractAccountStateValue(status)),
+	}, nil, nil
+}
+
+func (opp *UpdateActivationProcessor) Close() error {
+	UpdateActivationProcessorPool.Put(opp)
+
+	return nil
+}
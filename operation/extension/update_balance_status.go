@@ -0,0 +1,152 @@
+package extension
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+)
+
+var (
+	UpdateBalanceStatusFactHint = hint.MustNewHint("mitum-extension-update-balance-status-operation-fact-v0.0.1")
+	UpdateBalanceStatusHint     = hint.MustNewHint("mitum-extension-update-balance-status-operation-v0.0.1")
+)
+
+// UpdateBalanceStatusFact sets the balance status of contract account, which
+// blocks the withdrawals or the deposits of contract account.
+type UpdateBalanceStatusFact struct {
+	base.BaseFact
+	sender        base.Address
+	contract      base.Address
+	balanceStatus types.BalanceStatus
+	currency      types.CurrencyID
+}
+
+func NewUpdateBalanceStatusFact(
+	token []byte,
+	sender,
+	contract base.Address,
+	balanceStatus types.BalanceStatus,
+	currency types.CurrencyID,
+) UpdateBalanceStatusFact {
+	fact := UpdateBalanceStatusFact{
+		BaseFact:      base.NewBaseFact(UpdateBalanceStatusFactHint, token),
+		sender:        sender,
+		contract:      contract,
+		balanceStatus: balanceStatus,
+		currency:      currency,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateBalanceStatusFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UpdateBalanceStatusFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.balanceStatus.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact UpdateBalanceStatusFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender, fact.contract, fact.balanceStatus, fact.currency); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact UpdateBalanceStatusFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateBalanceStatusFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UpdateBalanceStatusFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact UpdateBalanceStatusFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateBalanceStatusFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateBalanceStatusFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact UpdateBalanceStatusFact) BalanceStatus() types.BalanceStatus {
+	return fact.balanceStatus
+}
+
+func (fact UpdateBalanceStatusFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.contract}, nil
+}
+
+func (fact UpdateBalanceStatusFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact UpdateBalanceStatusFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateBalanceStatusFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateBalanceStatusFact) ContractOwnerOnly() [][2]base.Address {
+	return [][2]base.Address{{fact.contract, fact.sender}}
+}
+
+func (fact UpdateBalanceStatusFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeContractStatus] = []string{fact.Contract().String()}
+
+	return r, nil
+}
+
+type UpdateBalanceStatus struct {
+	extras.ExtendedOperation
+}
+
+func (op UpdateBalanceStatus) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewUpdateBalanceStatus(fact UpdateBalanceStatusFact) (UpdateBalanceStatus, error) {
+	return UpdateBalanceStatus{
+		ExtendedOperation: extras.NewExtendedOperation(UpdateBalanceStatusHint, fact),
+	}, nil
+}
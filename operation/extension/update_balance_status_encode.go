@@ -0,0 +1,28 @@
+package extension
+
+import (
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+func (fact *UpdateBalanceStatusFact) unpack(enc encoder.Encoder, sd, ct string, bs uint8, cid string) error {
+	switch ad, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.sender = ad
+	}
+
+	switch ad, err := base.DecodeAddress(ct, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.contract = ad
+	}
+
+	fact.balanceStatus = types.BalanceStatus(bs)
+	fact.currency = types.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package extension
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+type UpdateBalanceStatusFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender        base.Address        `json:"sender"`
+	Contract      base.Address        `json:"contract"`
+	BalanceStatus types.BalanceStatus `json:"balance_status"`
+	Currency      types.CurrencyID    `json:"currency"`
+}
+
+func (fact UpdateBalanceStatusFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateBalanceStatusFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		BalanceStatus:         fact.balanceStatus,
+		Currency:              fact.currency,
+	})
+}
+
+type UpdateBalanceStatusFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender        string `json:"sender"`
+	Contract      string `json:"contract"`
+	BalanceStatus uint8  `json:"balance_status"`
+	Currency      string `json:"currency"`
+}
+
+func (fact *UpdateBalanceStatusFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var uf UpdateBalanceStatusFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	if err := fact.unpack(enc, uf.Sender, uf.Contract, uf.BalanceStatus, uf.Currency); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *fact)
+	}
+
+	return nil
+}
+
+func (op UpdateBalanceStatus) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperationMarshaler{
+		BaseOperationJSONMarshaler:           op.BaseOperation.JSONMarshaler(),
+		BaseOperationExtensionsJSONMarshaler: op.BaseOperationExtensions.JSONMarshaler(),
+	})
+}
+
+func (op *UpdateBalanceStatus) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperation = ubo
+
+	var ueo extras.BaseOperationExtensions
+	if err := ueo.DecodeJSON(b, enc); err != nil {
+		return common.DecorateError(err, common.ErrDecodeJson, *op)
+	}
+
+	op.BaseOperationExtensions = &ueo
+
+	return nil
+}
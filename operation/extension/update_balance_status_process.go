@@ -0,0 +1,100 @@
+package extension
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/extension"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var UpdateBalanceStatusProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(UpdateBalanceStatusProcessor)
+	},
+}
+
+func (UpdateBalanceStatus) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type UpdateBalanceStatusProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewUpdateBalanceStatusProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("create new UpdateBalanceStatusProcessor")
+
+		nopp := UpdateBalanceStatusProcessorPool.Get()
+		opp, ok := nopp.(*UpdateBalanceStatusProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected UpdateBalanceStatusProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		return opp, nil
+	}
+}
+
+func (opp *UpdateBalanceStatusProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	if _, ok := op.Fact().(UpdateBalanceStatusFact); !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected UpdateBalanceStatusFact, not %T", op.Fact())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *UpdateBalanceStatusProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(UpdateBalanceStatusFact)
+
+	ctAccSt, err := state.ExistsState(extension.StateKeyContractAccount(fact.Contract()), "contract account status", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("check existence of contract account status %v ; %w", fact.Contract(), err), nil
+	}
+
+	status, err := extension.StateContractAccountValue(ctAccSt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status.SetBalanceStatus(fact.BalanceStatus())
+
+	return []base.StateMergeValue{
+		state.NewStateMergeValue(ctAccSt.Key(), extension.NewContractAccountStateValue(status)),
+	}, nil, nil
+}
+
+func (opp *UpdateBalanceStatusProcessor) Close() error {
+	UpdateBalanceStatusProcessorPool.Put(opp)
+
+	return nil
+}
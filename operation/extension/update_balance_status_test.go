@@ -0,0 +1,200 @@
+package extension_test
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/currency"
+	"github.com/imfact-labs/currency-model/operation/extension"
+	operationtest "github.com/imfact-labs/currency-model/operation/test"
+	cestate "github.com/imfact-labs/currency-model/state/extension"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+)
+
+type testContractStatus struct {
+	tp        *operationtest.TestProcessor
+	owner     base.Address
+	ownerPriv base.Privatekey
+	contract  base.Address
+}
+
+// newTestContractStatus sets the contract account of owner; both have
+// balance 1000.
+func newTestContractStatus(t *testing.T) testContractStatus {
+	t.Helper()
+
+	tp := newTestProcessor(t, nilFeePolicy())
+
+	c := testContractStatus{tp: tp}
+	c.owner, _, c.ownerPriv = tp.NewTestAccountState(tp.NewPrivateKey("status-owner"), true)
+	tp.NewTestBalanceState(c.owner, tp.GenesisCurrency, 1000, true)
+	c.contract, _ = tp.NewTestContractAccountState(c.owner, tp.NewPrivateKey("status-contract"), true)
+	tp.NewTestBalanceState(c.contract, tp.GenesisCurrency, 1000, true)
+
+	return c
+}
+
+func (c testContractStatus) updateBalanceStatus(t *testing.T, bs types.BalanceStatus) extension.UpdateBalanceStatus {
+	t.Helper()
+
+	op, err := extension.NewUpdateBalanceStatus(extension.NewUpdateBalanceStatusFact(
+		[]byte(bs.String()), c.owner, c.contract, bs, c.tp.GenesisCurrency))
+	if err != nil {
+		t.Fatalf("new update balance status: %v", err)
+	}
+
+	sign(t, c.tp, &op, c.ownerPriv)
+
+	return op
+}
+
+func (c testContractStatus) updateActivation(t *testing.T, token string, active bool) extension.UpdateActivation {
+	t.Helper()
+
+	op, err := extension.NewUpdateActivation(extension.NewUpdateActivationFact(
+		[]byte(token), c.owner, c.contract, active, c.tp.GenesisCurrency))
+	if err != nil {
+		t.Fatalf("new update activation: %v", err)
+	}
+
+	sign(t, c.tp, &op, c.ownerPriv)
+
+	return op
+}
+
+func (c testContractStatus) status(t *testing.T) types.ContractAccountStatus {
+	t.Helper()
+
+	st, _, _ := c.tp.GetStateFunc(cestate.StateKeyContractAccount(c.contract))
+
+	cs, err := cestate.StateContractAccountValue(st)
+	if err != nil {
+		t.Fatalf("contract account status: %v", err)
+	}
+
+	return cs
+}
+
+func TestUpdateBalanceStatusValidation(t *testing.T) {
+	c := newTestContractStatus(t)
+
+	if err := c.updateBalanceStatus(t, types.FullyFrozen).IsValid(c.tp.NetworkID); err != nil {
+		t.Fatalf("invalid update balance status: %v", err)
+	}
+
+	if err := c.updateBalanceStatus(t, types.BalanceStatus(types.MaxBalanceStatus)).IsValid(c.tp.NetworkID); err == nil {
+		t.Fatal("expected unknown balance status invalid")
+	}
+}
+
+func TestUpdateBalanceStatusBlocksBalance(t *testing.T) {
+	c := newTestContractStatus(t)
+
+	deposit := func(token string) currency.Transfer {
+		op, err := currency.NewTransfer(currency.NewTransferFact([]byte(token), c.owner,
+			[]currency.TransferItem{currency.NewTransferItemMultiAmounts(c.contract, []types.Amount{amount(c.tp, 10)})},
+			c.tp.GenesisCurrency))
+		if err != nil {
+			t.Fatalf("new transfer: %v", err)
+		}
+
+		sign(t, c.tp, &op, c.ownerPriv)
+
+		return op
+	}
+
+	withdraw := func(token string) extension.Withdraw {
+		return newTestWithdraw(t, c.tp, token, c.owner, c.ownerPriv,
+			extension.NewWithdrawItemMultiAmounts(c.contract, []types.Amount{amount(c.tp, 10)}))
+	}
+
+	for _, s := range []struct {
+		status   types.BalanceStatus
+		deposit  bool
+		withdraw bool
+	}{
+		{status: types.DepositBlocked, withdraw: true},
+		{status: types.WithdrawalBlocked, deposit: true},
+		{status: types.FullyFrozen},
+		{status: types.Allowed, deposit: true, withdraw: true},
+	} {
+		_, reason, err := c.tp.ProcessAt(extension.NewUpdateBalanceStatusProcessor(), base.Height(2), c.updateBalanceStatus(t, s.status))
+		requireNoReason(t, reason, err)
+
+		if bs := c.status(t).BalanceStatus(); bs != s.status {
+			t.Fatalf("expected balance status %v, not %v", s.status, bs)
+		}
+
+		reason, err = c.tp.PreProcessAt(currency.NewTransferProcessor(), base.Height(3), deposit("deposit-"+s.status.String()))
+		if s.deposit {
+			requireNoReason(t, reason, err)
+		} else {
+			requireReason(t, reason, err, "not allowed to deposit")
+		}
+
+		reason, err = c.tp.PreProcessAt(extension.NewWithdrawProcessor(), base.Height(3), withdraw("withdraw-"+s.status.String()))
+		if s.withdraw {
+			requireNoReason(t, reason, err)
+		} else {
+			requireReason(t, reason, err, "not allowed to withdraw")
+		}
+	}
+}
+
+func TestUpdateActivation(t *testing.T) {
+	c := newTestContractStatus(t)
+
+	reason, err := c.tp.PreProcessAt(extension.NewUpdateActivationProcessor(), base.Height(2), c.updateActivation(t, "deactivate", false))
+	requireReason(t, reason, err, "already in the activation")
+
+	// NOTE contract account without registered service can not be activated.
+	reason, err = c.tp.PreProcessAt(extension.NewUpdateActivationProcessor(), base.Height(2), c.updateActivation(t, "activate", true))
+	requireReason(t, reason, err, "no registered service")
+
+	status := c.status(t)
+	registered := extension.WithdrawHint
+	status.SetRegisterOperation(&registered)
+	c.tp.SetState(common.NewBaseState(base.Height(1), cestate.StateKeyContractAccount(c.contract),
+		cestate.NewContractAccountStateValue(status), nil, []util.Hash{}), true)
+
+	_, reason, err = c.tp.ProcessAt(extension.NewUpdateActivationProcessor(), base.Height(2), c.updateActivation(t, "activate", true))
+	requireNoReason(t, reason, err)
+
+	if !c.status(t).IsActive() {
+		t.Fatal("expected active contract account")
+	}
+
+	reason, err = c.tp.PreProcessAt(extension.NewUpdateActivationProcessor(), base.Height(3), c.updateActivation(t, "activate-again", true))
+	requireReason(t, reason, err, "already in the activation")
+
+	unknown, _ := c.tp.NewTestContractAccountState(c.owner, c.tp.NewPrivateKey("unknown-status-contract"), false)
+	c.contract = unknown
+
+	reason, err = c.tp.PreProcessAt(extension.NewUpdateActivationProcessor(), base.Height(3), c.updateActivation(t, "unknown-contract", true))
+	requireReason(t, reason, err, "contract account status")
+}
+
+func TestContractStatusFactsRoundTrip(t *testing.T) {
+	c := newTestContractStatus(t)
+
+	facts := []base.Fact{
+		c.updateBalanceStatus(t, types.DepositBlocked).Fact(),
+		c.updateActivation(t, "activation-round-trip", true).Fact(),
+	}
+
+	for i := range facts {
+		j, b := roundTrip(t, facts[i])
+
+		for _, got := range []base.Fact{j, b} {
+			if err := got.IsValid(nil); err != nil {
+				t.Fatalf("invalid decoded %T: %v", got, err)
+			}
+
+			if !got.Hash().Equal(facts[i].Hash()) {
+				t.Fatalf("decoded %T not matched", got)
+			}
+		}
+	}
+}
@@ -21,14 +21,18 @@ type UpdateHandlerFact struct {
 	sender   base.Address
 	contract base.Address
 	handlers []base.Address
+	scopes   []types.HandlerScope
 	currency types.CurrencyID
 }
 
+// NewUpdateHandlerFact replaces the handlers and the scopes of handlers of
+// contract account; the handlers without scope are not restricted.
 func NewUpdateHandlerFact(
 	token []byte,
 	sender,
 	contract base.Address,
 	handlers []base.Address,
+	scopes []types.HandlerScope,
 	currency types.CurrencyID,
 ) UpdateHandlerFact {
 	fact := UpdateHandlerFact{
@@ -36,6 +40,7 @@ func NewUpdateHandlerFact(
 		sender:   sender,
 		contract: contract,
 		handlers: handlers,
+		scopes:   scopes,
 		currency: currency,
 	}
 
@@ -58,6 +63,10 @@ func (fact UpdateHandlerFact) Bytes() []byte {
 		bs[4+i] = fact.handlers[i].Bytes()
 	}
 
+	for i := range fact.scopes {
+		bs = append(bs, fact.scopes[i].Bytes())
+	}
+
 	return util.ConcatBytesSlice(bs...)
 }
 
@@ -89,6 +98,23 @@ func (fact UpdateHandlerFact) IsValid(b []byte) error {
 		}
 	}
 
+	scopesMap := make(map[string]struct{})
+	for i := range fact.scopes {
+		if err := fact.scopes[i].IsValid(nil); err != nil {
+			return common.ErrFactInvalid.Wrap(err)
+		}
+
+		k := fact.scopes[i].Handler().String()
+		if _, found := handlersMap[k]; !found {
+			return common.ErrFactInvalid.Wrap(
+				common.ErrValueInvalid.Wrap(errors.Errorf("scope of %v, which is not handler", k)))
+		} else if _, found := scopesMap[k]; found {
+			return common.ErrFactInvalid.Wrap(common.ErrDupVal.Wrap(errors.Errorf("scope of handler %v", k)))
+		}
+
+		scopesMap[k] = struct{}{}
+	}
+
 	if err := common.IsValidOperationFact(fact, b); err != nil {
 		return common.ErrFactInvalid.Wrap(err)
 	}
@@ -124,6 +150,10 @@ func (fact UpdateHandlerFact) Handlers() []base.Address {
 	return fact.handlers
 }
 
+func (fact UpdateHandlerFact) Scopes() []types.HandlerScope {
+	return fact.scopes
+}
+
 func (fact UpdateHandlerFact) Addresses() ([]base.Address, error) {
 	as := make([]base.Address, len(fact.handlers)+2)
 
@@ -13,13 +13,14 @@ import (
 func (fact UpdateHandlerFact) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
-			"_hint":    fact.Hint().String(),
-			"sender":   fact.sender,
-			"contract": fact.contract,
-			"handlers": fact.handlers,
-			"currency": fact.currency,
-			"hash":     fact.BaseFact.Hash().String(),
-			"token":    fact.BaseFact.Token(),
+			"_hint":          fact.Hint().String(),
+			"sender":         fact.sender,
+			"contract":       fact.contract,
+			"handlers":       fact.handlers,
+			"handler_scopes": fact.scopes,
+			"currency":       fact.currency,
+			"hash":           fact.BaseFact.Hash().String(),
+			"token":          fact.BaseFact.Token(),
 		},
 	)
 }
@@ -29,6 +30,7 @@ type UpdateHandlerFactBSONUnmarshaler struct {
 	Sender   string   `bson:"sender"`
 	Contract string   `bson:"contract"`
 	Handlers []string `bson:"handlers"`
+	Scopes   bson.Raw `bson:"handler_scopes"`
 	Currency string   `bson:"currency"`
 }
 
@@ -59,7 +61,7 @@ func (fact *UpdateHandlerFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error
 	}
 	fact.BaseHinter = hint.NewBaseHinter(ht)
 
-	if err := fact.unpack(enc, uf.Sender, uf.Contract, uf.Handlers, uf.Currency); err != nil {
+	if err := fact.unpack(enc, uf.Sender, uf.Contract, uf.Handlers, uf.Scopes, uf.Currency); err != nil {
 		return common.DecorateError(err, common.ErrDecodeBson, *fact)
 	}
 
@@ -1,12 +1,14 @@
 package extension
 
 import (
+	"github.com/imfact-labs/currency-model/common"
 	"github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/pkg/errors"
 )
 
-func (fact *UpdateHandlerFact) unpack(enc encoder.Encoder, sd, ct string, hds []string, cid string) error {
+func (fact *UpdateHandlerFact) unpack(enc encoder.Encoder, sd, ct string, hds []string, bsc []byte, cid string) error {
 	switch ad, err := base.DecodeAddress(sd, enc); {
 	case err != nil:
 		return err
@@ -32,6 +34,22 @@ func (fact *UpdateHandlerFact) unpack(enc encoder.Encoder, sd, ct string, hds []
 	}
 	fact.handlers = handlers
 
+	hsc, err := enc.DecodeSlice(bsc)
+	if err != nil {
+		return err
+	}
+
+	var scopes []types.HandlerScope
+	for i := range hsc {
+		j, ok := hsc[i].(types.HandlerScope)
+		if !ok {
+			return common.ErrTypeMismatch.Wrap(errors.Errorf("expected HandlerScope, not %T", hsc[i]))
+		}
+
+		scopes = append(scopes, j)
+	}
+	fact.scopes = scopes
+
 	fact.currency = types.CurrencyID(cid)
 
 	return nil
@@ -1,6 +1,8 @@
 package extension
 
 import (
+	"encoding/json"
+
 	"github.com/imfact-labs/currency-model/common"
 	"github.com/imfact-labs/currency-model/operation/extras"
 	"github.com/imfact-labs/currency-model/types"
@@ -11,10 +13,11 @@ import (
 
 type UpdateHandlerFactJSONMarshaler struct {
 	base.BaseFactJSONMarshaler
-	Sender   base.Address     `json:"sender"`
-	Contract base.Address     `json:"contract"`
-	Handlers []base.Address   `json:"handlers"`
-	Currency types.CurrencyID `json:"currency"`
+	Sender   base.Address         `json:"sender"`
+	Contract base.Address         `json:"contract"`
+	Handlers []base.Address       `json:"handlers"`
+	Scopes   []types.HandlerScope `json:"handler_scopes,omitempty"`
+	Currency types.CurrencyID     `json:"currency"`
 }
 
 func (fact UpdateHandlerFact) MarshalJSON() ([]byte, error) {
@@ -23,16 +26,18 @@ func (fact UpdateHandlerFact) MarshalJSON() ([]byte, error) {
 		Sender:                fact.sender,
 		Contract:              fact.contract,
 		Handlers:              fact.handlers,
+		Scopes:                fact.scopes,
 		Currency:              fact.currency,
 	})
 }
 
 type UpdatHandlerFactJSONUnMarshaler struct {
 	base.BaseFactJSONUnmarshaler
-	Sender   string   `json:"sender"`
-	Contract string   `json:"contract"`
-	Handlers []string `json:"handlers"`
-	Currency string   `json:"currency"`
+	Sender   string          `json:"sender"`
+	Contract string          `json:"contract"`
+	Handlers []string        `json:"handlers"`
+	Scopes   json.RawMessage `json:"handler_scopes"`
+	Currency string          `json:"currency"`
 }
 
 func (fact *UpdateHandlerFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -43,7 +48,7 @@ func (fact *UpdateHandlerFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
 
 	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
 
-	if err := fact.unpack(enc, uf.Sender, uf.Contract, uf.Handlers, uf.Currency); err != nil {
+	if err := fact.unpack(enc, uf.Sender, uf.Contract, uf.Handlers, uf.Scopes, uf.Currency); err != nil {
 		return common.DecorateError(err, common.ErrDecodeJson, *fact)
 	}
 
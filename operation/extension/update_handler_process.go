@@ -124,6 +124,10 @@ func (opp *UpdateHandlerProcessor) Process( // nolint:dupl
 		return nil, nil, err
 	}
 
+	if err := status.SetHandlerScopes(fact.Scopes()); err != nil {
+		return nil, nil, err
+	}
+
 	stmvs = append(stmvs, state.NewStateMergeValue(ctAccSt.Key(), extension.NewContractAccountStateValue(status)))
 
 	return stmvs, nil, nil
@@ -0,0 +1,80 @@
+package extension_test
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/operation/extension"
+	cestate "github.com/imfact-labs/currency-model/state/extension"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+func TestUpdateHandlerScopes(t *testing.T) {
+	tp := newTestProcessor(t, nilFeePolicy())
+
+	owner, _, ownerPriv := tp.NewTestAccountState(tp.NewPrivateKey("owner-update-handler"), true)
+	scoped, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("scoped-update-handler"), true)
+	unscoped, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("unscoped-update-handler"), true)
+	contract, _ := tp.NewTestContractAccountState(owner, tp.NewPrivateKey("contract-update-handler"), true)
+
+	scope := types.NewHandlerScope(scoped, []hint.Type{extension.WithdrawHint.Type()}, []types.Amount{amount(tp, 100)})
+
+	update := func(token string, handlers []base.Address, scopes ...types.HandlerScope) extension.UpdateHandler {
+		op, err := extension.NewUpdateHandler(extension.NewUpdateHandlerFact(
+			[]byte(token), owner, contract, handlers, scopes, tp.GenesisCurrency))
+		if err != nil {
+			t.Fatalf("new update handler: %v", err)
+		}
+
+		sign(t, tp, &op, ownerPriv)
+
+		return op
+	}
+
+	if err := update("scope-of-not-handler", []base.Address{unscoped}, scope).IsValid(tp.NetworkID); err == nil {
+		t.Fatal("expected scope of not handler invalid")
+	}
+
+	if err := update("duplicated-scope", []base.Address{scoped}, scope, scope).IsValid(tp.NetworkID); err == nil {
+		t.Fatal("expected duplicated scope invalid")
+	}
+
+	other, _ := tp.NewTestContractAccountState(owner, tp.NewPrivateKey("contract-handler"), true)
+
+	reason, err := tp.PreProcessAt(extension.NewUpdateHandlerProcessor(), base.Height(10),
+		update("contract-handler", []base.Address{other}))
+	requireReason(t, reason, err, "is contract account")
+
+	op := update("update-handler", []base.Address{scoped, unscoped}, scope)
+
+	_, reason, err = tp.ProcessAt(extension.NewUpdateHandlerProcessor(), base.Height(10), op)
+	requireNoReason(t, reason, err)
+
+	st, _, _ := tp.GetStateFunc(cestate.StateKeyContractAccount(contract))
+
+	status, err := cestate.StateContractAccountValue(st)
+	if err != nil {
+		t.Fatalf("contract account status: %v", err)
+	}
+
+	if got, found := status.HandlerScope(scoped); !found || !got.Equal(scope) {
+		t.Fatalf("expected scope of handler, %v", got)
+	} else if _, found := status.HandlerScope(unscoped); found {
+		t.Fatal("expected handler without scope")
+	}
+
+	// NOTE processing does not change the handlers and scopes of the fact.
+	j, b := roundTrip(t, op.Fact())
+	for _, got := range []base.Fact{j, b} {
+		if err := got.IsValid(nil); err != nil {
+			t.Fatalf("invalid decoded %T: %v", got, err)
+		}
+
+		if !got.Hash().Equal(op.Fact().Hash()) {
+			t.Fatalf("decoded %T not matched", got)
+		} else if scopes := got.(extension.UpdateHandlerFact).Scopes(); len(scopes) != 1 || !scopes[0].Equal(scope) {
+			t.Fatalf("decoded scopes not matched, %v", scopes)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package extension
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extras"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+var (
+	UpdateOwnerFactHint = hint.MustNewHint("mitum-extension-update-owner-operation-fact-v0.0.1")
+	UpdateOwnerHint     = hint.MustNewHint("mitum-extension-update-owner-operation-v0.0.1")
+)
+
+// UpdateOwnerFact nominates the new owner of contract account. The owner is
+// changed when the nominee accepts it by AcceptOwner. Nominating the current
+// owner cancels the pending nomination.
+type UpdateOwnerFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	owner    base.Address
+	currency types.CurrencyID
+}
+
+func NewUpdateOwnerFact(
+	token []byte,
+	sender,
+	contract,
+	owner base.Address,
+	currency types.CurrencyID,
+) UpdateOwnerFact {
+	fact := UpdateOwnerFact{
+		BaseFact: base.NewBaseFact(UpdateOwnerFactHint, token),
+		sender:   sender,
+		contract: contract,
+		owner:    owner,
+		currency: currency,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateOwnerFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UpdateOwnerFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.owner.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact UpdateOwnerFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.contract, fact.owner, fact.currency); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	if fact.owner.Equal(fact.contract) {
+		return common.ErrFactInvalid.Wrap(
+			common.ErrSelfTarget.Wrap(errors.Errorf("owner %v is same with contract account", fact.owner)))
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return common.ErrFactInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fact UpdateOwnerFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateOwnerFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UpdateOwnerFact) Currency() types.CurrencyID {
+	return fact.currency
+}
+
+func (fact UpdateOwnerFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateOwnerFact) Signer() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateOwnerFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact UpdateOwnerFact) Owner() base.Address {
+	return fact.owner
+}
+
+func (fact UpdateOwnerFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.contract, fact.owner}, nil
+}
+
+func (fact UpdateOwnerFact) FeeBase() (types.CurrencyID, int, int, bool) {
+	return fact.Currency(), extras.NoItemFeeBaseItemCount, len(fact.Bytes()), extras.HasNoItem
+}
+
+func (fact UpdateOwnerFact) FeePayer() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateOwnerFact) FactUser() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateOwnerFact) ContractOwnerOnly() [][2]base.Address {
+	return [][2]base.Address{{fact.contract, fact.sender}}
+}
+
+func (fact UpdateOwnerFact) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+	r[extras.DuplicationKeyTypeContractStatus] = []string{fact.Contract().String()}
+
+	return r, nil
+}
+
+type UpdateOwner struct {
+	extras.ExtendedOperation
+}
+
+func (op UpdateOwner) DupKey() (map[types.DuplicationKeyType][]string, error) {
+	r := make(map[types.DuplicationKeyType][]string)
+
+	if err := extras.AddOperationFeePayerDupKeys(r, op); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func NewUpdateOwner(fact UpdateOwnerFact) (UpdateOwner, error) {
+	return UpdateOwner{
+		ExtendedOperation: extras.NewExtendedOperation(UpdateOwnerHint, fact),
+	}, nil
+}
@@ -0,0 +1,34 @@
+package extension
+
+import (
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+func (fact *UpdateOwnerFact) unpack(enc encoder.Encoder, sd, ct, ow, cid string) error {
+	switch ad, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.sender = ad
+	}
+
+	switch ad, err := base.DecodeAddress(ct, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.contract = ad
+	}
+
+	switch ad, err := base.DecodeAddress(ow, enc); {
+	case err != nil:
+		return err
+	default:
+		fact.owner = ad
+	}
+
+	fact.currency = types.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package extension
+
+import (
+	"context"
+	"sync"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/state"
+	"github.com/imfact-labs/currency-model/state/extension"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var UpdateOwnerProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(UpdateOwnerProcessor)
+	},
+}
+
+func (UpdateOwner) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type UpdateOwnerProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewUpdateOwnerProcessor() types.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("create new UpdateOwnerProcessor")
+
+		nopp := UpdateOwnerProcessorPool.Get()
+		opp, ok := nopp.(*UpdateOwnerProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected UpdateOwnerProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		return opp, nil
+	}
+}
+
+func (opp *UpdateOwnerProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	fact, ok := op.Fact().(UpdateOwnerFact)
+	if !ok {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMTypeMismatch).
+				Errorf("expected UpdateOwnerFact, not %T", op.Fact())), nil
+	}
+
+	if _, _, aErr, cErr := state.ExistsCAccount(
+		fact.Owner(), "owner", true, false, getStateFunc); aErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Errorf("%v", aErr)), nil
+	} else if cErr != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.
+				Wrap(common.ErrMCAccountNA).
+				Errorf("%v: owner %v is contract account", cErr, fact.Owner())), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *UpdateOwnerProcessor) Process( // nolint:dupl
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(UpdateOwnerFact)
+
+	ctAccSt, err := state.ExistsState(extension.StateKeyContractAccount(fact.Contract()), "contract account status", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("check existence of contract account status %v ; %w", fact.Contract(), err), nil
+	}
+
+	status, err := extension.StateContractAccountValue(ctAccSt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var owner base.Address
+	if !status.Owner().Equal(fact.Owner()) {
+		owner = fact.Owner()
+	}
+
+	if err := status.SetPendingOwner(owner); err != nil {
+		return nil, nil, err
+	}
+
+	return []base.StateMergeValue{
+		state.NewStateMergeValue(ctAccSt.Key(), extension.NewContractAccountStateValue(status)),
+	}, nil, nil
+}
+
+func (opp *UpdateOwnerProcessor) Close() error {
+	UpdateOwnerProcessorPool.Put(opp)
+
+	return nil
+}
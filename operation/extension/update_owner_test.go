@@ -0,0 +1,176 @@
+package extension_test
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/operation/extension"
+	operationtest "github.com/imfact-labs/currency-model/operation/test"
+	cestate "github.com/imfact-labs/currency-model/state/extension"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+type testOwner struct {
+	tp          *operationtest.TestProcessor
+	owner       base.Address
+	ownerPriv   base.Privatekey
+	nominee     base.Address
+	nomineePriv base.Privatekey
+	contract    base.Address
+}
+
+func newTestOwner(t *testing.T) testOwner {
+	t.Helper()
+
+	tp := newTestProcessor(t, nilFeePolicy())
+
+	o := testOwner{tp: tp}
+	o.owner, _, o.ownerPriv = tp.NewTestAccountState(tp.NewPrivateKey("contract-owner"), true)
+	o.nominee, _, o.nomineePriv = tp.NewTestAccountState(tp.NewPrivateKey("contract-nominee"), true)
+	o.contract, _ = tp.NewTestContractAccountState(o.owner, tp.NewPrivateKey("owned-contract"), true)
+
+	return o
+}
+
+func (o testOwner) update(t *testing.T, token string, nominee base.Address) extension.UpdateOwner {
+	t.Helper()
+
+	op, err := extension.NewUpdateOwner(extension.NewUpdateOwnerFact(
+		[]byte(token), o.owner, o.contract, nominee, o.tp.GenesisCurrency))
+	if err != nil {
+		t.Fatalf("new update owner: %v", err)
+	}
+
+	sign(t, o.tp, &op, o.ownerPriv)
+
+	return op
+}
+
+func (o testOwner) accept(t *testing.T, token string, sender base.Address, priv base.Privatekey) extension.AcceptOwner {
+	t.Helper()
+
+	op, err := extension.NewAcceptOwner(extension.NewAcceptOwnerFact(
+		[]byte(token), sender, o.contract, o.tp.GenesisCurrency))
+	if err != nil {
+		t.Fatalf("new accept owner: %v", err)
+	}
+
+	sign(t, o.tp, &op, priv)
+
+	return op
+}
+
+func (o testOwner) status(t *testing.T) types.ContractAccountStatus {
+	t.Helper()
+
+	st, _, _ := o.tp.GetStateFunc(cestate.StateKeyContractAccount(o.contract))
+
+	cs, err := cestate.StateContractAccountValue(st)
+	if err != nil {
+		t.Fatalf("contract account status: %v", err)
+	}
+
+	return cs
+}
+
+func TestUpdateOwnerValidation(t *testing.T) {
+	o := newTestOwner(t)
+
+	if err := o.update(t, "owner-is-contract", o.contract).IsValid(o.tp.NetworkID); err == nil {
+		t.Fatal("expected contract account as owner invalid")
+	}
+
+	if err := o.accept(t, "contract-accepts", o.contract, o.ownerPriv).IsValid(o.tp.NetworkID); err == nil {
+		t.Fatal("expected contract account as sender invalid")
+	}
+}
+
+func TestUpdateOwnerRejections(t *testing.T) {
+	o := newTestOwner(t)
+
+	unknown, _, _ := o.tp.NewTestAccountState(o.tp.NewPrivateKey("unknown-nominee"), false)
+
+	reason, err := o.tp.PreProcessAt(extension.NewUpdateOwnerProcessor(), base.Height(3), o.update(t, "unknown-nominee", unknown))
+	requireReason(t, reason, err, "owner")
+
+	other, _ := o.tp.NewTestContractAccountState(o.owner, o.tp.NewPrivateKey("other-contract"), true)
+
+	reason, err = o.tp.PreProcessAt(extension.NewUpdateOwnerProcessor(), base.Height(3), o.update(t, "contract-nominee", other))
+	requireReason(t, reason, err, "is contract account")
+}
+
+func TestAcceptOwner(t *testing.T) {
+	o := newTestOwner(t)
+
+	reason, err := o.tp.PreProcessAt(extension.NewAcceptOwnerProcessor(), base.Height(3),
+		o.accept(t, "accept-before-nomination", o.nominee, o.nomineePriv))
+	requireReason(t, reason, err, "not pending owner")
+
+	_, reason, err = o.tp.ProcessAt(extension.NewUpdateOwnerProcessor(), base.Height(3), o.update(t, "nominate", o.nominee))
+	requireNoReason(t, reason, err)
+
+	// NOTE the owner is not changed until the nominee accepts it.
+	if cs := o.status(t); !cs.Owner().Equal(o.owner) || cs.PendingOwner() == nil || !cs.PendingOwner().Equal(o.nominee) {
+		t.Fatalf("expected owner %v with pending owner %v, not %v with %v", o.owner, o.nominee, cs.Owner(), cs.PendingOwner())
+	}
+
+	stranger, _, strangerPriv := o.tp.NewTestAccountState(o.tp.NewPrivateKey("contract-stranger"), true)
+
+	reason, err = o.tp.PreProcessAt(extension.NewAcceptOwnerProcessor(), base.Height(3),
+		o.accept(t, "stranger-accepts", stranger, strangerPriv))
+	requireReason(t, reason, err, "not pending owner")
+
+	_, reason, err = o.tp.ProcessAt(extension.NewAcceptOwnerProcessor(), base.Height(3),
+		o.accept(t, "nominee-accepts", o.nominee, o.nomineePriv))
+	requireNoReason(t, reason, err)
+
+	if cs := o.status(t); !cs.Owner().Equal(o.nominee) || cs.PendingOwner() != nil {
+		t.Fatalf("expected owner %v without pending owner, not %v with %v", o.nominee, cs.Owner(), cs.PendingOwner())
+	}
+
+	reason, err = o.tp.PreProcessAt(extension.NewAcceptOwnerProcessor(), base.Height(3),
+		o.accept(t, "nominee-accepts-again", o.nominee, o.nomineePriv))
+	requireReason(t, reason, err, "not pending owner")
+}
+
+func TestUpdateOwnerCancelsNomination(t *testing.T) {
+	o := newTestOwner(t)
+
+	_, reason, err := o.tp.ProcessAt(extension.NewUpdateOwnerProcessor(), base.Height(3), o.update(t, "nominate", o.nominee))
+	requireNoReason(t, reason, err)
+
+	// NOTE nominating the current owner cancels the pending owner.
+	_, reason, err = o.tp.ProcessAt(extension.NewUpdateOwnerProcessor(), base.Height(3), o.update(t, "cancel-nomination", o.owner))
+	requireNoReason(t, reason, err)
+
+	if cs := o.status(t); cs.PendingOwner() != nil {
+		t.Fatalf("expected no pending owner, not %v", cs.PendingOwner())
+	}
+
+	reason, err = o.tp.PreProcessAt(extension.NewAcceptOwnerProcessor(), base.Height(3),
+		o.accept(t, "accept-cancelled", o.nominee, o.nomineePriv))
+	requireReason(t, reason, err, "not pending owner")
+}
+
+func TestOwnerFactsRoundTrip(t *testing.T) {
+	o := newTestOwner(t)
+
+	facts := []base.Fact{
+		o.update(t, "update-owner-round-trip", o.nominee).Fact(),
+		o.accept(t, "accept-owner-round-trip", o.nominee, o.nomineePriv).Fact(),
+	}
+
+	for i := range facts {
+		j, b := roundTrip(t, facts[i])
+
+		for _, got := range []base.Fact{j, b} {
+			if err := got.IsValid(nil); err != nil {
+				t.Fatalf("invalid decoded %T: %v", got, err)
+			}
+
+			if !got.Hash().Equal(facts[i].Hash()) {
+				t.Fatalf("decoded %T not matched", got)
+			}
+		}
+	}
+}
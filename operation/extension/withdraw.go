@@ -165,6 +165,15 @@ func (fact WithdrawFact) FeeBase() (types.CurrencyID, int, int, bool) {
 	return fact.Currency(), len(fact.items), len(fact.Bytes()), extras.HasItem
 }
 
+func (fact WithdrawFact) FeeAmounts() []common.Big {
+	items := make([]currency.AmountsItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return currency.AmountsItemsFeeAmounts(fact.currency, items)
+}
+
 func (fact WithdrawFact) FeePayer() base.Address {
 	return fact.sender
 }
@@ -173,7 +182,7 @@ func (fact WithdrawFact) FactUser() base.Address {
 	return fact.sender
 }
 
-func (fact WithdrawFact) ContractOwnerOnly() [][2]base.Address {
+func (fact WithdrawFact) ContractOwnerHandlerOnly() [][2]base.Address {
 	var arr [][2]base.Address
 	for i := range fact.items {
 		arr = append(arr, [2]base.Address{fact.items[i].Target(), fact.sender})
@@ -47,7 +47,7 @@ func (opp *WithdrawItemProcessor) PreProcess(
 	for i := range opp.item.Amounts() {
 		_, cSt, _, _ := cstate.ExistsCAccount(opp.item.Target(), "target contract", true, true, getStateFunc)
 		status, _ := cestate.StateContractAccountValue(cSt)
-		if status.BalanceStatus() != types.Allowed {
+		if !status.BalanceStatus().AllowWithdraw() {
 			return e.Wrap(
 				common.ErrCAccountRS.Errorf(
 					"balance of contract account, %v is not allowed to withdraw", opp.item.Target()))
@@ -55,6 +55,14 @@ func (opp *WithdrawItemProcessor) PreProcess(
 
 		am := opp.item.Amounts()[i]
 
+		if err := cstate.CheckCurrencyNotPaused(am.Currency(), getStateFunc); err != nil {
+			return e.Wrap(err)
+		}
+
+		if err := cstate.CheckAccountNotFrozen(opp.sender, []types.CurrencyID{am.Currency()}, getStateFunc); err != nil {
+			return e.Wrap(err)
+		}
+
 		st, found, err := getStateFunc(ccstate.BalanceStateKey(opp.item.Target(), am.Currency()))
 		if err != nil {
 			return e.Wrap(err)
@@ -70,6 +78,10 @@ func (opp *WithdrawItemProcessor) PreProcess(
 		if balance.Big().Compare(am.Big()) < 0 {
 			return e.Wrap(common.ErrValueInvalid.Errorf("insufficient balance of currency, %v of contract account, %v", am.Currency(), opp.item.Target()))
 		}
+
+		if err := currency.CheckTransferLimits(opp.sender, am, getStateFunc); err != nil {
+			return e.Wrap(err)
+		}
 	}
 
 	return nil
@@ -169,6 +181,33 @@ func (opp *WithdrawProcessor) PreProcess(
 		c.Close()
 	}
 
+	items := make([]currency.AmountsItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	if err := currency.CheckAmountsItemsFeeCurrency(fact.currency, items, getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	if _, err := prepareHandlerWithdrawn(fact.Sender(), fact.items, getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	totals := currency.NewReceiverTotals()
+	for i := range fact.items {
+		for _, am := range fact.items[i].Amounts() {
+			totals.Add(fact.Sender(), am)
+		}
+	}
+
+	if err := totals.CheckMaxBalance(getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
 	return ctx, nil, nil
 }
 
@@ -234,9 +273,134 @@ func (opp *WithdrawProcessor) Process( // nolint:dupl
 		)
 	}
 
+	var targets []base.Address // nolint:prealloc
+	outflows := map[string][]types.Amount{}
+	for i := range fact.items {
+		target := fact.items[i].Target()
+		if _, found := outflows[target.String()]; !found {
+			targets = append(targets, target)
+		}
+
+		outflows[target.String()] = append(outflows[target.String()], fact.items[i].Amounts()...)
+	}
+
+	for i := range targets {
+		outflowValues, err := currency.PrepareOutflowLimits(
+			targets[i], sumAmounts(outflows[targets[i].String()]), opp.Height(), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		}
+
+		stateMergeValues = append(stateMergeValues, outflowValues...)
+	}
+
+	withdrawnValues, err := prepareHandlerWithdrawn(fact.Sender(), fact.items, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	stateMergeValues = append(stateMergeValues, withdrawnValues...)
+
 	return stateMergeValues, nil, nil
 }
 
+// prepareHandlerWithdrawn checks the total amounts, which the handler has
+// withdrawn from the contract accounts, with the withdraw limits of its scope
+// and returns the state values to count the withdrawn amounts. The owner and
+// the handler without scope are not limited.
+func prepareHandlerWithdrawn(
+	sender base.Address, items []WithdrawItem, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	var sts []base.StateMergeValue // nolint:prealloc
+
+	for i := range items {
+		target := items[i].Target()
+
+		st, err := cstate.ExistsState(cestate.StateKeyContractAccount(target), "contract account", getStateFunc)
+		if err != nil {
+			return nil, err
+		}
+
+		status, err := cestate.StateContractAccountValue(st)
+		if err != nil {
+			return nil, err
+		}
+
+		if status.Owner().Equal(sender) {
+			continue
+		}
+
+		scope, found := status.HandlerScope(sender)
+		if !found {
+			continue
+		}
+
+		amounts := sumAmounts(items[i].Amounts())
+		for j := range amounts {
+			am := amounts[j]
+
+			limit, found := scope.WithdrawLimit(am.Currency())
+			if !found {
+				return nil, common.ErrAccountNAth.Errorf(
+					"currency, %v is out of withdraw limits of handler, %v", am.Currency(), sender)
+			}
+
+			key := cestate.StateKeyHandlerWithdrawn(target, sender, am.Currency())
+
+			var wst base.State
+			switch st, found, err := getStateFunc(key); {
+			case err != nil:
+				return nil, err
+			case found:
+				wst = st
+			}
+
+			withdrawn, err := cestate.StateHandlerWithdrawnValue(wst, am.Currency())
+			if err != nil {
+				return nil, err
+			}
+
+			if withdrawn.Big().Add(am.Big()).Compare(limit) > 0 {
+				return nil, common.ErrValOOR.Errorf(
+					"amount withdrawn by handler, %v over withdraw limit, %v + %v > %v",
+					sender, withdrawn.Big(), am.Big(), limit)
+			}
+
+			sts = append(sts, common.NewBaseStateMergeValue(
+				key,
+				cestate.NewAddHandlerWithdrawnStateValue(am),
+				func(height base.Height, st base.State) base.StateValueMerger {
+					return cestate.NewHandlerWithdrawnStateValueMerger(height, key, am.Currency(), st)
+				},
+			))
+		}
+	}
+
+	return sts, nil
+}
+
+func sumAmounts(amounts []types.Amount) []types.Amount {
+	var cids []types.CurrencyID // nolint:prealloc
+	totals := map[types.CurrencyID]common.Big{}
+	for i := range amounts {
+		cid := amounts[i].Currency()
+		total, found := totals[cid]
+		if !found {
+			cids = append(cids, cid)
+			total = common.ZeroBig
+		}
+
+		totals[cid] = total.Add(amounts[i].Big())
+	}
+
+	sums := make([]types.Amount, len(cids))
+	for i := range cids {
+		sums[i] = types.NewAmount(totals[cids[i]], cids[i])
+	}
+
+	return sums
+}
+
 func (opp *WithdrawProcessor) Close() error {
 	for i := range opp.ns {
 		opp.ns[i].Close()
@@ -0,0 +1,130 @@
+package extension_test
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/operation/extension"
+	operationtest "github.com/imfact-labs/currency-model/operation/test"
+	cestate "github.com/imfact-labs/currency-model/state/extension"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+func newTestWithdraw(
+	t *testing.T,
+	tp *operationtest.TestProcessor,
+	token string,
+	sender base.Address,
+	priv base.Privatekey,
+	items ...extension.WithdrawItem,
+) extension.Withdraw {
+	t.Helper()
+
+	op, err := extension.NewWithdraw(extension.NewWithdrawFact([]byte(token), sender, items, tp.GenesisCurrency))
+	if err != nil {
+		t.Fatalf("new withdraw: %v", err)
+	}
+
+	sign(t, tp, &op, priv)
+
+	return op
+}
+
+func TestWithdrawRejectsTotalOverMaxBalance(t *testing.T) {
+	tp := newTestProcessor(t, nilFeePolicy().WithLimits(
+		types.NewTransferLimits(common.ZeroBig, common.ZeroBig, 0, common.NewBig(100))))
+
+	owner, _, priv := tp.NewTestAccountState(tp.NewPrivateKey("owner-withdraw-totals"), true)
+
+	var contracts [2]base.Address
+	for i, seed := range []string{"contract-withdraw-totals-a", "contract-withdraw-totals-b"} {
+		contracts[i], _ = tp.NewTestContractAccountState(owner, tp.NewPrivateKey(seed), true)
+		tp.NewTestBalanceState(contracts[i], tp.GenesisCurrency, 1000, true)
+	}
+
+	// NOTE each item is under max balance of owner, but the sum is not
+	op := newTestWithdraw(t, tp, "withdraw-totals", owner, priv,
+		extension.NewWithdrawItemMultiAmounts(contracts[0], []types.Amount{amount(tp, 60)}),
+		extension.NewWithdrawItemMultiAmounts(contracts[1], []types.Amount{amount(tp, 60)}),
+	)
+
+	reason, err := tp.PreProcessAt(extension.NewWithdrawProcessor(), base.Height(10), op)
+	requireReason(t, reason, err, "over max balance")
+
+	op = newTestWithdraw(t, tp, "withdraw-under-totals", owner, priv,
+		extension.NewWithdrawItemMultiAmounts(contracts[0], []types.Amount{amount(tp, 50)}),
+		extension.NewWithdrawItemMultiAmounts(contracts[1], []types.Amount{amount(tp, 50)}),
+	)
+
+	_, reason, err = tp.ProcessAt(extension.NewWithdrawProcessor(), base.Height(10), op)
+	requireNoReason(t, reason, err)
+
+	if b := tp.Balance(owner, tp.GenesisCurrency); !b.Equal(common.NewBig(100)) {
+		t.Fatalf("expected owner balance 100, not %v", b)
+	}
+}
+
+func TestWithdrawCountsHandlerWithdrawLimit(t *testing.T) {
+	tp := newTestProcessor(t, nilFeePolicy())
+
+	owner, _, ownerPriv := tp.NewTestAccountState(tp.NewPrivateKey("owner-handler-limit"), true)
+	scoped, _, scopedPriv := tp.NewTestAccountState(tp.NewPrivateKey("scoped-handler-limit"), true)
+	unscoped, _, unscopedPriv := tp.NewTestAccountState(tp.NewPrivateKey("unscoped-handler-limit"), true)
+
+	contract, _ := tp.NewTestContractAccountState(owner, tp.NewPrivateKey("contract-handler-limit"), true)
+	tp.NewTestBalanceState(contract, tp.GenesisCurrency, 1000, true)
+
+	status := types.NewContractAccountStatus(owner, []base.Address{scoped, unscoped})
+	if err := status.SetHandlerScopes([]types.HandlerScope{
+		types.NewHandlerScope(scoped, []hint.Type{extension.WithdrawHint.Type()}, []types.Amount{amount(tp, 100)}),
+	}); err != nil {
+		t.Fatalf("set handler scopes: %v", err)
+	}
+
+	tp.SetState(common.NewBaseState(
+		base.Height(1),
+		cestate.StateKeyContractAccount(contract),
+		cestate.NewContractAccountStateValue(status),
+		nil,
+		[]util.Hash{},
+	), true)
+
+	withdraw := func(token string, sender base.Address, priv base.Privatekey, n int64) extension.Withdraw {
+		return newTestWithdraw(t, tp, token, sender, priv,
+			extension.NewWithdrawItemMultiAmounts(contract, []types.Amount{amount(tp, n)}))
+	}
+
+	_, reason, err := tp.ProcessAt(extension.NewWithdrawProcessor(), base.Height(10), withdraw("first", scoped, scopedPriv, 60))
+	requireNoReason(t, reason, err)
+
+	// NOTE each withdraw is under the limit, but the total is not
+	reason, err = tp.PreProcessAt(extension.NewWithdrawProcessor(), base.Height(11), withdraw("second", scoped, scopedPriv, 60))
+	requireReason(t, reason, err, "over withdraw limit")
+
+	_, reason, err = tp.ProcessAt(extension.NewWithdrawProcessor(), base.Height(11), withdraw("rest", scoped, scopedPriv, 40))
+	requireNoReason(t, reason, err)
+
+	st, found, _ := tp.GetStateFunc(cestate.StateKeyHandlerWithdrawn(contract, scoped, tp.GenesisCurrency))
+	if !found {
+		t.Fatal("expected handler withdrawn state")
+	} else if v := st.Value().(cestate.HandlerWithdrawnStateValue); !v.Amount.Big().Equal(common.NewBig(100)) {
+		t.Fatalf("expected 100 withdrawn by handler, not %v", v.Amount.Big())
+	}
+
+	reason, err = tp.PreProcessAt(extension.NewWithdrawProcessor(), base.Height(12), withdraw("over", scoped, scopedPriv, 1))
+	requireReason(t, reason, err, "over withdraw limit")
+
+	// NOTE the owner and the handler without scope are not limited.
+	_, reason, err = tp.ProcessAt(extension.NewWithdrawProcessor(), base.Height(12), withdraw("owner", owner, ownerPriv, 500))
+	requireNoReason(t, reason, err)
+
+	_, reason, err = tp.ProcessAt(extension.NewWithdrawProcessor(), base.Height(13), withdraw("unscoped", unscoped, unscopedPriv, 300))
+	requireNoReason(t, reason, err)
+
+	if b := tp.Balance(contract, tp.GenesisCurrency); !b.Equal(common.NewBig(100)) {
+		t.Fatalf("expected contract balance 100, not %v", b)
+	}
+}
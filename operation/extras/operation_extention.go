@@ -173,9 +173,9 @@ func (ba BaseAuthentication) Verify(op base.Operation, getStateFunc base.GetStat
 	}
 
 	switch vrfMethod.Type() {
-	case types.AuthTypeECDSASECP, types.AuthTypeImFact:
+	case types.AuthTypeECDSASECP, types.AuthTypeImFact, types.AuthTypeEd25519:
 		if vrfMethod.PublicKey() == nil {
-			return common.ErrValueInvalid.Errorf("missing public key in EcdsaSecp256k1VerificationKey2019 type")
+			return common.ErrValueInvalid.Errorf("missing public key in %v type", vrfMethod.Type())
 		}
 		pubKey := vrfMethod.PublicKey()
 		signature := base58.Decode(ba.ProofData())
@@ -251,9 +251,9 @@ func (ba BaseAuthentication) Verify(op base.Operation, getStateFunc base.GetStat
 		}
 
 		switch vrfMethod.Type() {
-		case types.AuthTypeECDSASECP, types.AuthTypeImFact:
+		case types.AuthTypeECDSASECP, types.AuthTypeImFact, types.AuthTypeEd25519:
 			if vrfMethod.PublicKey() == nil {
-				return common.ErrValueInvalid.Errorf("missing public key in EcdsaSecp256k1VerificationKey2019 type")
+				return common.ErrValueInvalid.Errorf("missing public key in %v type", vrfMethod.Type())
 			}
 			pubKey := vrfMethod.PublicKey()
 			signature := base58.Decode(ba.ProofData())
@@ -586,6 +586,12 @@ const (
 	DuplicationKeyTypeContractStatus   types.DuplicationKeyType = "contract-status"
 	DuplicationKeyTypeContractWithdraw types.DuplicationKeyType = "contract-withdraw"
 	DuplicationKeyTypeDIDAccount       types.DuplicationKeyType = "did-account"
+	DuplicationKeyTypeVesting          types.DuplicationKeyType = "currency-vesting"
+	DuplicationKeyTypeTransferLock     types.DuplicationKeyType = "currency-transfer-lock"
+	DuplicationKeyTypeSchedule         types.DuplicationKeyType = "currency-schedule"
+	DuplicationKeyTypeProposal         types.DuplicationKeyType = "currency-proposal"
+	DuplicationKeyTypeRecovery         types.DuplicationKeyType = "currency-recovery"
+	DuplicationKeyTypeAllowance        types.DuplicationKeyType = "currency-allowance"
 )
 
 type DeDupeKeyer interface {
@@ -688,6 +694,12 @@ type FeeAble interface {
 	FeePayer() base.Address
 }
 
+// AmountFeeAble is an interface type for operations whose fee depends on the amount moved by each item.
+// FeeAmounts returns the amount of the fee currency moved by each item(e.g., Transfer, Withdraw, CreateAccount).
+type AmountFeeAble interface {
+	FeeAmounts() []common.Big
+}
+
 const (
 	ZeroItem = 0
 	// NoItemFeeBaseItemCount is the synthetic item count used for fee calculation when an operation has no explicit items.
@@ -749,7 +761,7 @@ func VerifyFactUser(fact FactUser, getStateFunc base.GetStateFunc) base.Operatio
 }
 
 // ContractOwnerOnly is an interface type for operations that must be controlled by contract owner
-// Withdraw, UpdateHandler, UpdateRecipient
+// UpdateHandler, UpdateRecipient, UpdateOwner, UpdateBalanceStatus, UpdateActivation
 type ContractOwnerOnly interface {
 	ContractOwnerOnly() [][2]base.Address // contract, sender
 }
@@ -796,6 +808,55 @@ func VerifyContractOwnerOnly(fact ContractOwnerOnly, getStateFunc base.GetStateF
 	return nil
 }
 
+// ContractOwnerHandlerOnly is an interface type for operations that must be controlled by contract owner
+// or handler within its scope (e.g., Withdraw)
+type ContractOwnerHandlerOnly interface {
+	ContractOwnerHandlerOnly() [][2]base.Address // contract, sender
+}
+
+// VerifyContractOwnerHandlerOnly function checks
+// existence of contract account
+// sender is owner of contract account or handler within its scope of operation ht
+func VerifyContractOwnerHandlerOnly(
+	fact ContractOwnerHandlerOnly, ht hint.Hint, getStateFunc base.GetStateFunc,
+) base.OperationProcessReasonError {
+	for _, addresses := range fact.ContractOwnerHandlerOnly() {
+		contract := addresses[0]
+		sender := addresses[1]
+
+		if contract == nil {
+			return base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.
+					Errorf("failed to get contract account, empty contract account"))
+		}
+		if sender == nil {
+			return base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.
+					Errorf("failed to get sender, empty sender account"))
+		}
+
+		_, cSt, aErr, cErr := state.ExistsCAccount(contract, "contract", true, true, getStateFunc)
+		if aErr != nil {
+			return base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.
+					Errorf("%v", aErr))
+		} else if cErr != nil {
+			return base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.
+					Errorf("%v", cErr))
+		}
+
+		if _, err := estate.CheckCAOperationAuthFromState(cSt, sender, ht); err != nil {
+			return base.NewBaseOperationProcessReasonError(
+				common.ErrMPreProcess.
+					Wrap(common.ErrMAccountNAth).
+					Errorf("%v", err))
+		}
+	}
+
+	return nil
+}
+
 // InActiveContractOwnerHandlerOnly is an interface type for operations that activate an inactive contract
 // and must be authorized by owner or handler (e.g., RegisterModel)
 type InActiveContractOwnerHandlerOnly interface {
@@ -803,8 +864,11 @@ type InActiveContractOwnerHandlerOnly interface {
 }
 
 // VerifyInActiveContractOwnerHandlerOnly function checks existence of contract account
-// sender is owner of contract account inactive contract account
-func VerifyInActiveContractOwnerHandlerOnly(fact InActiveContractOwnerHandlerOnly, getStateFunc base.GetStateFunc) base.OperationProcessReasonError {
+// sender is owner of contract account or handler within its scope of operation ht
+// inactive contract account
+func VerifyInActiveContractOwnerHandlerOnly(
+	fact InActiveContractOwnerHandlerOnly, ht hint.Hint, getStateFunc base.GetStateFunc,
+) base.OperationProcessReasonError {
 	for _, addresses := range fact.InActiveContractOwnerHandlerOnly() {
 		contract := addresses[0]
 		sender := addresses[1]
@@ -831,7 +895,7 @@ func VerifyInActiveContractOwnerHandlerOnly(fact InActiveContractOwnerHandlerOnl
 					Errorf("%v", cErr))
 		}
 
-		ca, err := estate.CheckCAAuthFromState(cSt, sender)
+		ca, err := estate.CheckCAOperationAuthFromState(cSt, sender, ht)
 		if err != nil {
 			return base.NewBaseOperationProcessReasonError(
 				common.ErrMPreProcess.
@@ -864,8 +928,10 @@ type ActiveContractOwnerHandlerOnly interface {
 
 // VerifyActiveContractOwnerHandlerOnly function checks
 // existence of contract account
-// sender is owner of contract account
-func VerifyActiveContractOwnerHandlerOnly(fact ActiveContractOwnerHandlerOnly, getStateFunc base.GetStateFunc) base.OperationProcessReasonError {
+// sender is owner of contract account or handler within its scope of operation ht
+func VerifyActiveContractOwnerHandlerOnly(
+	fact ActiveContractOwnerHandlerOnly, ht hint.Hint, getStateFunc base.GetStateFunc,
+) base.OperationProcessReasonError {
 	for _, addresses := range fact.ActiveContractOwnerHandlerOnly() {
 		contract := addresses[0]
 		sender := addresses[1]
@@ -892,7 +958,7 @@ func VerifyActiveContractOwnerHandlerOnly(fact ActiveContractOwnerHandlerOnly, g
 					Errorf("%v", cErr))
 		}
 
-		ca, err := estate.CheckCAAuthFromState(cSt, sender)
+		ca, err := estate.CheckCAOperationAuthFromState(cSt, sender, ht)
 		if err != nil {
 			return base.NewBaseOperationProcessReasonError(
 				common.ErrMPreProcess.
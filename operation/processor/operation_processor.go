@@ -12,6 +12,7 @@ import (
 	isaacoperation "github.com/imfact-labs/currency-model/operation/isaac"
 	"github.com/imfact-labs/currency-model/state"
 	ccstate "github.com/imfact-labs/currency-model/state/currency"
+	cestate "github.com/imfact-labs/currency-model/state/extension"
 	"github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util"
@@ -51,6 +52,9 @@ type OperationProcessor struct {
 	CheckDuplicationFunc         func(*OperationProcessor, base.Operation) error
 	GetNewProcessorFunc          func(*OperationProcessor, base.Operation) (base.OperationProcessor, bool, error)
 	receipt                      base.OperationReceipt
+	outflows                     currency.BlockOutflows
+	blockDuplicatedHeight        base.Height
+	blockDuplicated              map[string]struct{}
 }
 
 func NewOperationProcessor() *OperationProcessor {
@@ -65,6 +69,7 @@ func NewOperationProcessor() *OperationProcessor {
 		Duplicated:                   map[string]struct{}{},
 		duplicatedNewAddress:         map[string]struct{}{},
 		processorClosers:             &m,
+		outflows:                     currency.NewBlockOutflows(),
 	}
 }
 
@@ -88,6 +93,14 @@ func (opr *OperationProcessor) New(
 		nopr.Duplicated = make(map[string]struct{})
 	}
 
+	opr.RLock()
+	if height == opr.blockDuplicatedHeight {
+		for k := range opr.blockDuplicated {
+			nopr.Duplicated[k] = struct{}{}
+		}
+	}
+	opr.RUnlock()
+
 	if nopr.proposal == nil && opr.proposal != nil {
 		nopr.proposal = opr.proposal
 	}
@@ -115,10 +128,23 @@ func (opr *OperationProcessor) New(
 	nopr.CheckDuplicationFunc = opr.CheckDuplicationFunc
 	nopr.GetNewProcessorFunc = opr.GetNewProcessorFunc
 	nopr.receipt = nil
+	nopr.outflows = currency.NewBlockOutflows()
 
 	return nopr, nil
 }
 
+// SetBlockDuplicated sets the duplication keys of the operations, which are
+// processed before the operations of proposal at height, like the runs of due
+// schedules; the new processors at height start with them, so the operations
+// of proposal are deduplicated against them.
+func (opr *OperationProcessor) SetBlockDuplicated(height base.Height, keys map[string]struct{}) {
+	opr.Lock()
+	defer opr.Unlock()
+
+	opr.blockDuplicatedHeight = height
+	opr.blockDuplicated = keys
+}
+
 func (opr *OperationProcessor) OperationReceipt() base.OperationReceipt {
 	opr.RLock()
 	defer opr.RUnlock()
@@ -199,6 +225,9 @@ func (opr *OperationProcessor) SetGetNewProcessorFunc(
 func (opr *OperationProcessor) PreProcess(ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (context.Context, base.OperationProcessReasonError, error) {
 	e := util.StringError("preprocess for OperationProcessor")
 
+	getStateFunc, _ = pendingPolicyStateFunc(opr.Height(), getStateFunc) //revive:disable-line:modifies-parameter
+	getStateFunc = previousKeysStateFunc(opr.Height(), getStateFunc)     //revive:disable-line:modifies-parameter
+
 	if err := opr.CheckDuplicationFunc(opr, op); err != nil {
 		return ctx, base.NewBaseOperationProcessReasonError("duplication found; %w", err), nil
 	}
@@ -259,13 +288,13 @@ func (opr *OperationProcessor) PreProcess(ctx context.Context, op base.Operation
 	}
 
 	if fact, ok := op.Fact().(extras.InActiveContractOwnerHandlerOnly); ok {
-		if err := extras.VerifyInActiveContractOwnerHandlerOnly(fact, getStateFunc); err != nil {
+		if err := extras.VerifyInActiveContractOwnerHandlerOnly(fact, op.Hint(), getStateFunc); err != nil {
 			return ctx, err, nil
 		}
 	}
 
 	if fact, ok := op.Fact().(extras.ActiveContractOwnerHandlerOnly); ok {
-		if err := extras.VerifyActiveContractOwnerHandlerOnly(fact, getStateFunc); err != nil {
+		if err := extras.VerifyActiveContractOwnerHandlerOnly(fact, op.Hint(), getStateFunc); err != nil {
 			return ctx, err, nil
 		}
 	}
@@ -276,6 +305,12 @@ func (opr *OperationProcessor) PreProcess(ctx context.Context, op base.Operation
 		}
 	}
 
+	if fact, ok := op.Fact().(extras.ContractOwnerHandlerOnly); ok {
+		if err := extras.VerifyContractOwnerHandlerOnly(fact, op.Hint(), getStateFunc); err != nil {
+			return ctx, err, nil
+		}
+	}
+
 	if fact, ok := op.Fact().(extras.ActiveContract); ok {
 		if err := extras.VerifyActiveContract(fact, getStateFunc); err != nil {
 			return ctx, err, nil
@@ -289,6 +324,17 @@ func (opr *OperationProcessor) PreProcess(ctx context.Context, op base.Operation
 		return ctx, reasonErr, nil
 	}
 
+	// NOTE the approval, which passes the threshold, executes the proposed
+	// operation with the authority of account; the proposed operation is not
+	// extended operation, so signs are not checked.
+	switch pop, approved, err := currency.ApprovedOperation(op, getStateFunc); {
+	case err != nil:
+		return ctx, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	case approved:
+		return opr.PreProcess(ctx, pop, getStateFunc)
+	}
+
 	return ctx, nil, nil
 }
 
@@ -299,6 +345,9 @@ func (opr *OperationProcessor) Process(
 
 	opr.setOperationReceipt(nil)
 
+	getStateFunc, activated := pendingPolicyStateFunc(opr.Height(), getStateFunc) //revive:disable-line:modifies-parameter
+	getStateFunc = previousKeysStateFunc(opr.Height(), getStateFunc)              //revive:disable-line:modifies-parameter
+
 	var sp base.OperationProcessor
 	if opr.GetNewProcessorFunc == nil {
 		return nil, base.NewBaseOperationProcessReasonError(
@@ -324,6 +373,25 @@ func (opr *OperationProcessor) Process(
 		return nil, nil, e.Wrap(err)
 	}
 
+	// NOTE the approved operation checks its own outflows.
+	outflows := stateMergeValues
+
+	switch pop, approved, err := currency.ApprovedOperation(op, getStateFunc); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	case approved:
+		values, reasonErr, err := opr.Process(ctx, pop, getStateFunc)
+		switch {
+		case err != nil:
+			return nil, nil, e.Wrap(err)
+		case reasonErr != nil:
+			return nil, reasonErr, nil
+		}
+
+		stateMergeValues = append(stateMergeValues, values...)
+	}
+
 	var receipt base.OperationReceipt
 	if i, ok := sp.(base.OperationReceiptProvider); ok {
 		receipt = i.OperationReceipt()
@@ -332,7 +400,7 @@ func (opr *OperationProcessor) Process(
 	var payer base.Address
 	switch i := op.Fact().(type) {
 	case extras.FeeAble:
-		cid, items, dSize, _ := i.FeeBase()
+		cid, _, _, _ := i.FeeBase()
 		payer = i.FeePayer()
 
 		if p, _, _, err := extras.FetchFeePayerHelper(op); err != nil {
@@ -341,40 +409,25 @@ func (opr *OperationProcessor) Process(
 			payer = p
 		}
 
-		policy, err := state.ExistsCurrencyPolicy(cid, getStateFunc)
-		if err != nil {
-			return nil, base.NewBaseOperationProcessReasonError(
-				common.ErrMPreProcess.
-					Errorf("%v", err)), nil
+		policyFeeer, feeReceipt, feeRequired, feeCID, reasonErr := CalculateFee(op, getStateFunc)
+		if reasonErr != nil {
+			return nil, reasonErr, nil
 		}
 
-		policyFeeer := policy.Feeer()
-		receiver := policyFeeer.Receiver()
-		feeReceipt, feeRequired := types.NewFeeReceiptFromFeeer(cid, policyFeeer, items, dSize)
 		receipt = mergeOperationReceipt(receipt, policyFeeer.Hint().String(), feeReceipt)
 
-		if receiver == nil {
-			break
-		}
-
-		if err := state.CheckExistsState(ccstate.AccountStateKey(receiver), getStateFunc); err != nil {
-			return nil, base.NewBaseOperationProcessReasonError(
-					common.ErrMAccountNF.Errorf("Feeer receiver, %v", receiver)),
-				nil
+		receivers := types.FeeerReceivers(policyFeeer)
+		shares := []common.Big{feeRequired}
+		burned := common.ZeroBig
+		if sf, ok := policyFeeer.(types.SplitFeeer); ok {
+			shares, burned = sf.Split(feeRequired)
 		}
 
-		feeReceiveSt, found, err := getStateFunc(ccstate.BalanceStateKey(receiver, cid))
-		if err != nil {
-			return nil, base.NewBaseOperationProcessReasonError(
-					common.ErrMStateNF.Errorf("Feeer receiver, %v BalanceState: %v", receiver, err)),
-				nil
-		} else if !found {
-			return nil, base.NewBaseOperationProcessReasonError(
-					common.ErrMStateNF.Errorf("Feeer receiver, %v BalanceState", receiver)),
-				nil
+		if len(receivers) < 1 && !burned.OverZero() {
+			break
 		}
 
-		payerSt, err := state.ExistsState(ccstate.BalanceStateKey(payer, cid), fmt.Sprintf("balance of fee payer, %v", payer), getStateFunc)
+		payerSt, err := state.ExistsState(ccstate.BalanceStateKey(payer, feeCID), fmt.Sprintf("balance of fee payer, %v", payer), getStateFunc)
 		if err != nil {
 			return nil, base.NewBaseOperationProcessReasonError(
 					common.ErrMStateNF.Errorf("fee payer, %v BalanceState: %v", payer, err)),
@@ -391,34 +444,91 @@ func (opr *OperationProcessor) Process(
 				nil
 		}
 
-		if payerSt.Key() != feeReceiveSt.Key() {
-			stateMergeValues = append(stateMergeValues, common.NewBaseStateMergeValue(
-				payerSt.Key(),
-				ccstate.NewDeductBalanceStateValue(payerBalValue.Amount.WithBig(feeRequired)),
-				func(height base.Height, st base.State) base.StateValueMerger {
-					return ccstate.NewBalanceStateValueMerger(height, st.Key(), cid, st)
-				},
-			))
-			r, ok := feeReceiveSt.Value().(ccstate.BalanceStateValue)
-			if !ok {
+		deducted := burned
+		var feeReceiveValues []base.StateMergeValue
+		for i := range receivers {
+			receiver := receivers[i]
+
+			if err := state.CheckExistsState(ccstate.AccountStateKey(receiver), getStateFunc); err != nil {
 				return nil, base.NewBaseOperationProcessReasonError(
-						"expected %T, not %T",
-						ccstate.BalanceStateValue{},
-						feeReceiveSt.Value()),
+						common.ErrMAccountNF.Errorf("Feeer receiver, %v", receiver)),
 					nil
 			}
-			stateMergeValues = append(
-				stateMergeValues,
+
+			feeReceiveKey := ccstate.BalanceStateKey(receiver, feeCID)
+			feeReceiveSt, found, err := getStateFunc(feeReceiveKey)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError(
+						common.ErrMStateNF.Errorf("Feeer receiver, %v BalanceState: %v", receiver, err)),
+					nil
+			} else if !found && feeCID == cid {
+				return nil, base.NewBaseOperationProcessReasonError(
+						common.ErrMStateNF.Errorf("Feeer receiver, %v BalanceState", receiver)),
+					nil
+			}
+
+			if found {
+				if _, ok := feeReceiveSt.Value().(ccstate.BalanceStateValue); !ok {
+					return nil, base.NewBaseOperationProcessReasonError(
+							"expected %T, not %T",
+							ccstate.BalanceStateValue{},
+							feeReceiveSt.Value()),
+						nil
+				}
+			}
+
+			// NOTE the share of fee payer itself is not moved.
+			if feeReceiveKey == payerSt.Key() || !shares[i].OverZero() {
+				continue
+			}
+
+			if err := cestate.CheckDepositAllowed(receiver, getStateFunc); err != nil {
+				return nil, base.NewBaseOperationProcessReasonError(
+						common.ErrMPreProcess.Errorf("Feeer receiver, %v: %v", receiver, err)),
+					nil
+			}
+
+			deducted = deducted.Add(shares[i])
+			feeReceiveValues = append(
+				feeReceiveValues,
 				common.NewBaseStateMergeValue(
-					feeReceiveSt.Key(),
-					ccstate.NewAddBalanceStateValue(r.Amount.WithBig(feeRequired)),
+					feeReceiveKey,
+					ccstate.NewAddBalanceStateValue(types.NewAmount(shares[i], feeCID)),
 					func(height base.Height, st base.State) base.StateValueMerger {
-						return ccstate.NewBalanceStateValueMerger(height, feeReceiveSt.Key(), cid, st)
+						return ccstate.NewBalanceStateValueMerger(height, feeReceiveKey, feeCID, st)
 					},
 				),
 			)
 		}
-	case currency.RegisterCurrencyFact, currency.UpdateCurrencyFact, currency.MintFact,
+
+		if deducted.OverZero() {
+			if err := state.CheckAccountNotFrozen(payer, []types.CurrencyID{feeCID}, getStateFunc); err != nil {
+				return nil, base.NewBaseOperationProcessReasonError(
+						common.ErrMPreProcess.Errorf("fee payer, %v: %v", payer, err)),
+					nil
+			}
+
+			stateMergeValues = append(stateMergeValues, common.NewBaseStateMergeValue(
+				payerSt.Key(),
+				ccstate.NewDeductBalanceStateValue(payerBalValue.Amount.WithBig(deducted)),
+				func(height base.Height, st base.State) base.StateValueMerger {
+					return ccstate.NewBalanceStateValueMerger(height, st.Key(), feeCID, st)
+				},
+			))
+			stateMergeValues = append(stateMergeValues, feeReceiveValues...)
+		}
+
+		if burned.OverZero() {
+			stateMergeValues = append(stateMergeValues, common.NewBaseStateMergeValue(
+				ccstate.DesignStateKey(feeCID),
+				ccstate.NewBurnTotalSupplyStateValue(types.NewAmount(burned, feeCID)),
+				func(height base.Height, st base.State) base.StateValueMerger {
+					return ccstate.NewDesignStateValueMerger(height, ccstate.DesignStateKey(feeCID), st)
+				},
+			))
+		}
+	case currency.RegisterCurrencyFact, currency.UpdateCurrencyFact, currency.MintFact, currency.PauseCurrencyFact,
+		currency.SkipScheduleFact,
 		isaacoperation.NetworkPolicyFact, isaacoperation.GenesisNetworkPolicyFact,
 		isaacoperation.SuffrageCandidateFact, isaacoperation.SuffrageDisjoinFact,
 		isaacoperation.SuffrageGenesisJoinFact, isaacoperation.SuffrageJoinFact,
@@ -437,11 +547,174 @@ func (opr *OperationProcessor) Process(
 		return nil, nil, e.Wrap(err)
 	}
 
+	if err := opr.addOutflows(outflows, getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err)), nil
+	}
+
+	// NOTE the activated pending policies are stored before the state values
+	// of operation, which may update the same currency design.
+	if values := activated(); len(values) > 0 {
+		stateMergeValues = append(values, stateMergeValues...)
+	}
+
 	opr.setOperationReceipt(receipt)
 
 	return stateMergeValues, reasonErr, e.Wrap(err)
 }
 
+// addOutflows checks the outflows of operation with the ones of the former
+// operations in the same block.
+func (opr *OperationProcessor) addOutflows(values []base.StateMergeValue, getStateFunc base.GetStateFunc) error {
+	opr.Lock()
+	defer opr.Unlock()
+
+	return opr.outflows.Add(values, getStateFunc)
+}
+
+// CalculateFee calculates the fee of the FeeAble operation by the currency
+// policy in the given state. The returned currency id is the currency the fee
+// is charged in; it differs from the fact currency when the policy has
+// FeeCurrency.
+func CalculateFee(op base.Operation, getStateFunc base.GetStateFunc) (
+	types.Feeer, types.FeeReceipt, common.Big, types.CurrencyID, base.OperationProcessReasonError,
+) {
+	fact, ok := op.Fact().(extras.FeeAble)
+	if !ok {
+		return nil, nil, common.ZeroBig, "", base.NewBaseOperationProcessReasonError(
+			common.ErrMTypeMismatch.Errorf("expected FeeAble, not %T", op.Fact()))
+	}
+
+	cid, items, dSize, _ := fact.FeeBase()
+
+	policy, err := state.ExistsCurrencyPolicy(cid, getStateFunc)
+	if err != nil {
+		return nil, nil, common.ZeroBig, "", base.NewBaseOperationProcessReasonError(
+			common.ErrMPreProcess.Errorf("%v", err))
+	}
+
+	policyFeeer := policy.Feeer()
+	var feeAmounts []common.Big
+	if j, ok := op.Fact().(extras.AmountFeeAble); ok {
+		feeAmounts = j.FeeAmounts()
+	}
+
+	feeReceipt, feeRequired := types.NewFeeReceiptFromFeeerWithAmounts(cid, policyFeeer, items, dSize, feeAmounts)
+
+	feeCID := cid
+	if fc, ok := policy.FeeCurrency(); ok && feeReceipt != nil {
+		if _, err := state.ExistsCurrencyPolicy(fc.Currency(), getStateFunc); err != nil {
+			return nil, nil, common.ZeroBig, "", base.NewBaseOperationProcessReasonError(
+				common.ErrMCurrencyNF.Errorf("fee currency, %v", fc.Currency()))
+		}
+
+		feeReceipt, feeRequired = types.NewConvertedFeeReceipt(fc, feeReceipt)
+		feeCID = fc.Currency()
+	}
+
+	return policyFeeer, feeReceipt, feeRequired, feeCID, nil
+}
+
+// pendingPolicyStateFunc wraps getStateFunc; the currency design is returned
+// with the pending policy, which is activated at height. The returned function
+// gives the state values to store the activated policies; only the policy is
+// merged into the currency design, so the other updates of the design in the
+// same block are kept, and the pending policy is marked as applied.
+func pendingPolicyStateFunc(height base.Height, getStateFunc base.GetStateFunc) (
+	base.GetStateFunc, func() []base.StateMergeValue,
+) {
+	var l sync.Mutex
+	activated := map[string][]base.StateMergeValue{}
+	var keys []string
+
+	return func(key string) (base.State, bool, error) {
+			st, found, err := getStateFunc(key)
+			if err != nil || !found || !ccstate.IsDesignStateKey(key) {
+				return st, found, err
+			}
+
+			pkey := key + ccstate.PendingPolicyStateKeySuffix
+
+			pst, pfound, err := getStateFunc(pkey)
+			switch {
+			case err != nil:
+				return nil, false, err
+			case !pfound:
+				return st, found, nil
+			}
+
+			de, ok, err := ccstate.ActivatePendingPolicy(st, pst, height)
+			switch {
+			case err != nil:
+				return nil, false, err
+			case !ok:
+				return st, found, nil
+			}
+
+			l.Lock()
+			if _, ok := activated[key]; !ok {
+				pv := pst.Value().(ccstate.PendingPolicyStateValue) //nolint:forcetypeassert //...
+				pv.Applied = true
+
+				keys = append(keys, key)
+				activated[key] = []base.StateMergeValue{
+					common.NewBaseStateMergeValue(
+						key,
+						ccstate.NewActivatePolicyStateValue(de.Policy()),
+						func(height base.Height, nst base.State) base.StateValueMerger {
+							return ccstate.NewDesignStateValueMerger(height, key, nst)
+						},
+					),
+					common.NewBaseStateMergeValue(
+						pkey,
+						pv,
+						func(height base.Height, nst base.State) base.StateValueMerger {
+							return ccstate.NewPendingPolicyStateValueMerger(height, pkey, nst)
+						},
+					),
+				}
+			}
+			l.Unlock()
+
+			return common.NewBaseState(
+				st.Height(), key, ccstate.NewCurrencyDesignStateValue(de), st.Previous(), st.Operations(),
+			), true, nil
+		}, func() []base.StateMergeValue {
+			l.Lock()
+			defer l.Unlock()
+
+			var values []base.StateMergeValue
+			for i := range keys {
+				values = append(values, activated[keys[i]]...)
+			}
+
+			return values
+		}
+}
+
+// previousKeysStateFunc wraps getStateFunc; the previous keys of account are
+// not found from the end of their grace period, so they no longer pass the
+// threshold of account.
+func previousKeysStateFunc(height base.Height, getStateFunc base.GetStateFunc) base.GetStateFunc {
+	return func(key string) (base.State, bool, error) {
+		st, found, err := getStateFunc(key)
+		if err != nil || !found || !ccstate.IsPreviousKeysStateKey(key) {
+			return st, found, err
+		}
+
+		v, err := ccstate.StatePreviousKeysValue(st)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if !v.Active(height) {
+			return nil, false, nil
+		}
+
+		return st, found, nil
+	}
+}
+
 func mergeOperationReceipt(
 	receipt base.OperationReceipt,
 	feeer string,
@@ -657,6 +930,8 @@ func (opr *OperationProcessor) close() {
 	opr.duplicatedNewAddress = nil
 	opr.processorClosers = &sync.Map{}
 	opr.receipt = nil
+	opr.outflows = currency.BlockOutflows{}
+	opr.blockDuplicated = nil
 
 	operationProcessorPool.Put(opr)
 
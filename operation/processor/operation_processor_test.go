@@ -2,22 +2,44 @@ package processor_test
 
 import (
 	"context"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/imfact-labs/currency-model/common"
 	"github.com/imfact-labs/currency-model/operation/currency"
+	"github.com/imfact-labs/currency-model/operation/extension"
 	"github.com/imfact-labs/currency-model/operation/extras"
 	"github.com/imfact-labs/currency-model/operation/processor"
 	operationtest "github.com/imfact-labs/currency-model/operation/test"
 	ccstate "github.com/imfact-labs/currency-model/state/currency"
+	extstate "github.com/imfact-labs/currency-model/state/extension"
 	"github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
 )
 
 func newWrappedProcessor(t *testing.T, getStateFunc base.GetStateFunc) *processor.OperationProcessor {
 	t.Helper()
 
+	return newWrappedProcessorAt(t, base.GenesisHeight, getStateFunc)
+}
+
+func newWrappedProcessorAt(t *testing.T, height base.Height, getStateFunc base.GetStateFunc) *processor.OperationProcessor {
+	t.Helper()
+
+	opr, err := newRootProcessor(t).New(height, getStateFunc, nil, nil)
+	if err != nil {
+		t.Fatalf("new wrapped processor: %v", err)
+	}
+
+	return opr
+}
+
+func newRootProcessor(t *testing.T) *processor.OperationProcessor {
+	t.Helper()
+
 	root := processor.NewOperationProcessor()
 
 	if err := root.SetCheckDuplicationFunc(processor.CheckDuplication); err != nil {
@@ -36,12 +58,115 @@ func newWrappedProcessor(t *testing.T, getStateFunc base.GetStateFunc) *processo
 		t.Fatalf("set mint processor: %v", err)
 	}
 
-	opr, err := root.New(base.GenesisHeight, getStateFunc, nil, nil)
-	if err != nil {
-		t.Fatalf("new wrapped processor: %v", err)
+	if err := root.SetProcessor(currency.BurnHint, currency.NewBurnProcessor()); err != nil {
+		t.Fatalf("set burn processor: %v", err)
 	}
 
-	return opr
+	if err := root.SetProcessor(currency.FreezeAccountHint, currency.NewFreezeAccountProcessor()); err != nil {
+		t.Fatalf("set freeze account processor: %v", err)
+	}
+
+	if err := root.SetProcessor(currency.ForceTransferHint, currency.NewForceTransferProcessor()); err != nil {
+		t.Fatalf("set force transfer processor: %v", err)
+	}
+
+	if err := root.SetProcessor(currency.CreateVestingHint, currency.NewCreateVestingProcessor()); err != nil {
+		t.Fatalf("set create vesting processor: %v", err)
+	}
+
+	if err := root.SetProcessor(currency.ReleaseVestingHint, currency.NewReleaseVestingProcessor()); err != nil {
+		t.Fatalf("set release vesting processor: %v", err)
+	}
+
+	if err := root.SetProcessor(currency.LockTransferHint, currency.NewLockTransferProcessor()); err != nil {
+		t.Fatalf("set lock transfer processor: %v", err)
+	}
+
+	if err := root.SetProcessor(currency.ClaimTransferHint, currency.NewClaimTransferProcessor()); err != nil {
+		t.Fatalf("set claim transfer processor: %v", err)
+	}
+
+	if err := root.SetProcessor(currency.RefundTransferHint, currency.NewRefundTransferProcessor()); err != nil {
+		t.Fatalf("set refund transfer processor: %v", err)
+	}
+
+	if err := root.SetProcessor(currency.ScheduleTransferHint, currency.NewScheduleTransferProcessor()); err != nil {
+		t.Fatalf("set schedule transfer processor: %v", err)
+	}
+
+	if err := root.SetProcessor(currency.RunScheduleHint, currency.NewRunScheduleProcessor()); err != nil {
+		t.Fatalf("set run schedule processor: %v", err)
+	}
+
+	if err := root.SetProcessor(currency.SkipScheduleHint, currency.NewSkipScheduleProcessor()); err != nil {
+		t.Fatalf("set skip schedule processor: %v", err)
+	}
+
+	if err := root.SetProcessor(currency.PauseCurrencyHint, currency.NewPauseCurrencyProcessor(base.MaxThreshold)); err != nil {
+		t.Fatalf("set pause currency processor: %v", err)
+	}
+
+	if err := root.SetProcessor(currency.ProposeOperationHint, currency.NewProposeOperationProcessor()); err != nil {
+		t.Fatalf("set propose operation processor: %v", err)
+	}
+
+	if err := root.SetProcessor(currency.ApproveOperationHint, currency.NewApproveOperationProcessor()); err != nil {
+		t.Fatalf("set approve operation processor: %v", err)
+	}
+
+	if err := root.SetProcessor(currency.SetGuardiansHint, currency.NewSetGuardiansProcessor()); err != nil {
+		t.Fatalf("set set guardians processor: %v", err)
+	}
+
+	if err := root.SetProcessor(currency.RecoverAccountHint, currency.NewRecoverAccountProcessor()); err != nil {
+		t.Fatalf("set recover account processor: %v", err)
+	}
+
+	if err := root.SetProcessor(currency.CompleteRecoveryHint, currency.NewCompleteRecoveryProcessor()); err != nil {
+		t.Fatalf("set complete recovery processor: %v", err)
+	}
+
+	if err := root.SetProcessor(currency.CancelRecoveryHint, currency.NewCancelRecoveryProcessor()); err != nil {
+		t.Fatalf("set cancel recovery processor: %v", err)
+	}
+
+	if err := root.SetProcessor(currency.ApproveHint, currency.NewApproveProcessor()); err != nil {
+		t.Fatalf("set approve processor: %v", err)
+	}
+
+	if err := root.SetProcessor(currency.TransferFromHint, currency.NewTransferFromProcessor()); err != nil {
+		t.Fatalf("set transfer from processor: %v", err)
+	}
+
+	if err := root.SetProcessor(currency.UpdateKeyHint, currency.NewUpdateKeyProcessor()); err != nil {
+		t.Fatalf("set update key processor: %v", err)
+	}
+
+	if err := root.SetProcessor(extension.CreateContractAccountHint, extension.NewCreateContractAccountProcessor()); err != nil {
+		t.Fatalf("set create contract account processor: %v", err)
+	}
+
+	if err := root.SetProcessor(extension.UpdateOwnerHint, extension.NewUpdateOwnerProcessor()); err != nil {
+		t.Fatalf("set update owner processor: %v", err)
+	}
+
+	if err := root.SetProcessor(extension.AcceptOwnerHint, extension.NewAcceptOwnerProcessor()); err != nil {
+		t.Fatalf("set accept owner processor: %v", err)
+	}
+
+	if err := root.SetProcessor(extension.WithdrawHint, extension.NewWithdrawProcessor()); err != nil {
+		t.Fatalf("set withdraw processor: %v", err)
+	}
+
+	if err := root.SetProcessor(extension.UpdateBalanceStatusHint, extension.NewUpdateBalanceStatusProcessor()); err != nil {
+		t.Fatalf("set update balance status processor: %v", err)
+	}
+
+	if err := root.SetProcessor(extension.UpdateActivationHint, extension.NewUpdateActivationProcessor()); err != nil {
+		t.Fatalf("set update activation processor: %v", err)
+	}
+
+	return root
 }
 
 func setFixedFeeer(tp *operationtest.TestProcessor, cid types.CurrencyID, receiver base.Address, fee int64) {
@@ -287,3 +412,1481 @@ func TestOperationProcessorSetsDetailedFeeReceiptForFixedItemDataSizeExecutionFe
 		t.Fatalf("unexpected execution fee receipt: %+v", fee)
 	}
 }
+
+func TestOperationProcessorChargesRatioFeePerItem(t *testing.T) {
+	getter := operationtest.NewMockStateGetter()
+	var tp operationtest.TestProcessor
+	tp.Setup(getter)
+
+	senderPrivSeed := tp.NewPrivateKey("sender-ratio")
+	sender, _, senderPriv := tp.NewTestAccountState(senderPrivSeed, true)
+	tp.NewTestBalanceState(sender, tp.GenesisCurrency, 100000, true)
+
+	receiverA, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("receiver-ratio-a"), true)
+	receiverB, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("receiver-ratio-b"), true)
+	receiverC, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("receiver-ratio-c"), true)
+
+	// NOTE 1% with min 5 and max 50
+	feeer := types.NewRatioFeeer(tp.GenesisAddr, 100, common.NewBig(5), common.NewBig(50))
+	design := types.NewCurrencyDesign(
+		common.ZeroBig,
+		tp.GenesisCurrency,
+		common.NewBig(9),
+		tp.GenesisAddr,
+		types.NewCurrencyPolicy(common.ZeroBig, feeer),
+	)
+	setCurrencyDesign(&tp, tp.GenesisCurrency, design)
+
+	opr := newWrappedProcessor(t, tp.GetStateFunc)
+
+	items := []currency.TransferItem{
+		currency.NewTransferItemMultiAmounts(receiverA, []types.Amount{
+			types.NewAmount(common.NewBig(100), tp.GenesisCurrency), // NOTE 1 -> min 5
+		}),
+		currency.NewTransferItemMultiAmounts(receiverB, []types.Amount{
+			types.NewAmount(common.NewBig(2000), tp.GenesisCurrency), // NOTE 20
+		}),
+		currency.NewTransferItemMultiAmounts(receiverC, []types.Amount{
+			types.NewAmount(common.NewBig(9000), tp.GenesisCurrency), // NOTE 90 -> max 50
+		}),
+	}
+
+	transferOp, err := currency.NewTransfer(currency.NewTransferFact(
+		[]byte("transfer-ratio"),
+		sender,
+		items,
+		tp.GenesisCurrency,
+	))
+	if err != nil {
+		t.Fatalf("new transfer: %v", err)
+	}
+
+	if err := transferOp.Sign(senderPriv, tp.NetworkID); err != nil {
+		t.Fatalf("sign transfer: %v", err)
+	}
+
+	states, reason, err := opr.Process(context.Background(), transferOp, tp.GetStateFunc)
+	if err != nil {
+		t.Fatalf("process transfer: %v", err)
+	}
+
+	if reason != nil {
+		t.Fatalf("unexpected transfer reason: %v", reason)
+	}
+
+	receipt := receiptAsCurrency(t, opr.OperationReceipt())
+	fee, ok := receipt.Fee.(types.RatioFeeReceipt)
+	if !ok {
+		t.Fatalf("unexpected fee receipt type: %T", receipt.Fee)
+	}
+
+	if err := fee.IsValid(nil); err != nil {
+		t.Fatalf("invalid ratio fee receipt: %v", err)
+	}
+
+	if fee.FeeAmount() != "75" || fee.Ratio() != 100 || fee.ItemCount() != 3 || fee.Amount() != "11100" {
+		t.Fatalf("unexpected ratio fee receipt: %+v", fee)
+	}
+
+	if fee.MinFee() != "5" || fee.MaxFee() != "50" {
+		t.Fatalf("unexpected ratio fee receipt bounds: %+v", fee)
+	}
+
+	if receipt.Feeer() != types.RatioFeeerHint.String() {
+		t.Fatalf("unexpected feeer hint receipt: %+v", fee)
+	}
+
+	deducted := common.ZeroBig
+	senderKey := ccstate.BalanceStateKey(sender, tp.GenesisCurrency)
+	for i := range states {
+		if states[i].Key() != senderKey {
+			continue
+		}
+
+		if v, ok := states[i].Value().(ccstate.DeductBalanceStateValue); ok {
+			deducted = deducted.Add(v.Amount.Big())
+		}
+	}
+
+	if !deducted.Equal(common.NewBig(11175)) {
+		t.Fatalf("unexpected deducted sender balance: %v", deducted)
+	}
+}
+
+func TestOperationProcessorChargesFeeInFeeCurrency(t *testing.T) {
+	getter := operationtest.NewMockStateGetter()
+	var tp operationtest.TestProcessor
+	tp.Setup(getter)
+
+	senderPrivSeed := tp.NewPrivateKey("sender-fee-currency")
+	sender, _, senderPriv := tp.NewTestAccountState(senderPrivSeed, true)
+	receiver, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("receiver-fee-currency"), true)
+
+	feeCID := tp.NewTestCurrencyState("USD", tp.GenesisAddr, true)
+	tp.NewTestBalanceState(sender, tp.GenesisCurrency, 1000, true)
+	tp.NewTestBalanceState(sender, feeCID, 100, true)
+
+	// NOTE fixed fee 10 is charged as 15 USD
+	policy := types.NewCurrencyPolicy(common.ZeroBig, types.NewFixedFeeer(tp.GenesisAddr, common.NewBig(10))).
+		WithFeeCurrency(types.NewFeeCurrency(feeCID, common.NewBig(3), common.NewBig(2)))
+	design := types.NewCurrencyDesign(
+		common.ZeroBig,
+		tp.GenesisCurrency,
+		common.NewBig(9),
+		tp.GenesisAddr,
+		policy,
+	)
+	setCurrencyDesign(&tp, tp.GenesisCurrency, design)
+
+	opr := newWrappedProcessor(t, tp.GetStateFunc)
+
+	transferOp, err := currency.NewTransfer(currency.NewTransferFact(
+		[]byte("transfer-fee-currency"),
+		sender,
+		[]currency.TransferItem{
+			currency.NewTransferItemMultiAmounts(receiver, []types.Amount{
+				types.NewAmount(common.NewBig(100), tp.GenesisCurrency),
+			}),
+		},
+		tp.GenesisCurrency,
+	))
+	if err != nil {
+		t.Fatalf("new transfer: %v", err)
+	}
+
+	if err := transferOp.Sign(senderPriv, tp.NetworkID); err != nil {
+		t.Fatalf("sign transfer: %v", err)
+	}
+
+	states, reason, err := opr.Process(context.Background(), transferOp, tp.GetStateFunc)
+	if err != nil {
+		t.Fatalf("process transfer: %v", err)
+	}
+
+	if reason != nil {
+		t.Fatalf("unexpected transfer reason: %v", reason)
+	}
+
+	receipt := receiptAsCurrency(t, opr.OperationReceipt())
+	fee, ok := receipt.Fee.(types.ConvertedFeeReceipt)
+	if !ok {
+		t.Fatalf("unexpected fee receipt type: %T", receipt.Fee)
+	}
+
+	if err := fee.IsValid(nil); err != nil {
+		t.Fatalf("invalid converted fee receipt: %v", err)
+	}
+
+	if fee.Currency() != feeCID || fee.FeeAmount() != "15" {
+		t.Fatalf("unexpected converted fee receipt: %+v", fee)
+	}
+
+	if fee.Source().Currency() != tp.GenesisCurrency || fee.Source().FeeAmount() != "10" {
+		t.Fatalf("unexpected source fee receipt: %+v", fee.Source())
+	}
+
+	deducted := map[string]common.Big{}
+	added := map[string]common.Big{}
+	for i := range states {
+		switch v := states[i].Value().(type) {
+		case ccstate.DeductBalanceStateValue:
+			deducted[states[i].Key()] = v.Amount.Big()
+		case ccstate.AddBalanceStateValue:
+			added[states[i].Key()] = v.Amount.Big()
+		}
+	}
+
+	if d := deducted[ccstate.BalanceStateKey(sender, tp.GenesisCurrency)]; !d.Equal(common.NewBig(100)) {
+		t.Fatalf("unexpected deducted sender balance: %v", d)
+	}
+
+	if d := deducted[ccstate.BalanceStateKey(sender, feeCID)]; !d.Equal(common.NewBig(15)) {
+		t.Fatalf("unexpected deducted sender fee currency balance: %v", d)
+	}
+
+	if a := added[ccstate.BalanceStateKey(tp.GenesisAddr, feeCID)]; !a.Equal(common.NewBig(15)) {
+		t.Fatalf("unexpected added fee receiver balance: %v", a)
+	}
+}
+
+func TestOperationProcessorSplitsFeeBetweenReceivers(t *testing.T) {
+	getter := operationtest.NewMockStateGetter()
+	var tp operationtest.TestProcessor
+	tp.Setup(getter)
+
+	sender, _, senderPriv := tp.NewTestAccountState(tp.NewPrivateKey("sender-split"), true)
+	tp.NewTestBalanceState(sender, tp.GenesisCurrency, 1000, true)
+	receiver, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("receiver-split"), true)
+
+	operator := tp.GenesisAddr
+	treasury, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("treasury-split"), true)
+	tp.NewTestBalanceState(treasury, tp.GenesisCurrency, 0, true)
+	burn, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("burn-split"), true)
+	tp.NewTestBalanceState(burn, tp.GenesisCurrency, 0, true)
+
+	// NOTE fee 7 with 5:3:2; 2 to treasury, 1 to burn and the rest 4 to operator
+	feeer := types.NewSplitFeeer(
+		types.NewFixedFeeer(operator, common.NewBig(7)),
+		[]types.FeeShare{
+			types.NewFeeShare(operator, 5),
+			types.NewFeeShare(treasury, 3),
+			types.NewFeeShare(burn, 2),
+		},
+		0,
+	)
+	if err := feeer.IsValid(nil); err != nil {
+		t.Fatalf("invalid split feeer: %v", err)
+	}
+
+	design := types.NewCurrencyDesign(
+		common.ZeroBig,
+		tp.GenesisCurrency,
+		common.NewBig(9),
+		tp.GenesisAddr,
+		types.NewCurrencyPolicy(common.ZeroBig, feeer),
+	)
+	setCurrencyDesign(&tp, tp.GenesisCurrency, design)
+
+	opr := newWrappedProcessor(t, tp.GetStateFunc)
+
+	transferOp, err := currency.NewTransfer(currency.NewTransferFact(
+		[]byte("transfer-split"),
+		sender,
+		[]currency.TransferItem{
+			currency.NewTransferItemMultiAmounts(receiver, []types.Amount{
+				types.NewAmount(common.NewBig(100), tp.GenesisCurrency),
+			}),
+		},
+		tp.GenesisCurrency,
+	))
+	if err != nil {
+		t.Fatalf("new transfer: %v", err)
+	}
+
+	if err := transferOp.Sign(senderPriv, tp.NetworkID); err != nil {
+		t.Fatalf("sign transfer: %v", err)
+	}
+
+	states, reason, err := opr.Process(context.Background(), transferOp, tp.GetStateFunc)
+	if err != nil {
+		t.Fatalf("process transfer: %v", err)
+	}
+
+	if reason != nil {
+		t.Fatalf("unexpected transfer reason: %v", reason)
+	}
+
+	deducted := common.ZeroBig
+	added := map[string]common.Big{}
+	for i := range states {
+		switch v := states[i].Value().(type) {
+		case ccstate.DeductBalanceStateValue:
+			deducted = deducted.Add(v.Amount.Big())
+		case ccstate.AddBalanceStateValue:
+			added[states[i].Key()] = v.Amount.Big()
+		}
+	}
+
+	if !deducted.Equal(common.NewBig(107)) {
+		t.Fatalf("unexpected deducted sender balance: %v", deducted)
+	}
+
+	receivers := []base.Address{operator, treasury, burn}
+	for i, am := range []int64{4, 2, 1} {
+		if a := added[ccstate.BalanceStateKey(receivers[i], tp.GenesisCurrency)]; !a.Equal(common.NewBig(am)) {
+			t.Fatalf("unexpected fee share of %v: %v", receivers[i], a)
+		}
+	}
+}
+
+func TestOperationProcessorBurnsFeeFromTotalSupply(t *testing.T) {
+	getter := operationtest.NewMockStateGetter()
+	var tp operationtest.TestProcessor
+	tp.Setup(getter)
+
+	sender, _, senderPriv := tp.NewTestAccountState(tp.NewPrivateKey("sender-burn"), true)
+	tp.NewTestBalanceState(sender, tp.GenesisCurrency, 1000, true)
+	receiver, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("receiver-burn"), true)
+
+	// NOTE fee 10 with operator 3 and burn 2; 4 is burned and the rest 6 to operator
+	feeer := types.NewSplitFeeer(
+		types.NewFixedFeeer(tp.GenesisAddr, common.NewBig(10)),
+		[]types.FeeShare{types.NewFeeShare(tp.GenesisAddr, 3)},
+		2,
+	)
+	design := types.NewCurrencyDesign(
+		common.NewBig(100000),
+		tp.GenesisCurrency,
+		common.NewBig(9),
+		tp.GenesisAddr,
+		types.NewCurrencyPolicy(common.ZeroBig, feeer),
+	)
+	setCurrencyDesign(&tp, tp.GenesisCurrency, design)
+
+	opr := newWrappedProcessor(t, tp.GetStateFunc)
+
+	transferOp, err := currency.NewTransfer(currency.NewTransferFact(
+		[]byte("transfer-burn"),
+		sender,
+		[]currency.TransferItem{
+			currency.NewTransferItemMultiAmounts(receiver, []types.Amount{
+				types.NewAmount(common.NewBig(100), tp.GenesisCurrency),
+			}),
+		},
+		tp.GenesisCurrency,
+	))
+	if err != nil {
+		t.Fatalf("new transfer: %v", err)
+	}
+
+	if err := transferOp.Sign(senderPriv, tp.NetworkID); err != nil {
+		t.Fatalf("sign transfer: %v", err)
+	}
+
+	states, reason, err := opr.Process(context.Background(), transferOp, tp.GetStateFunc)
+	if err != nil {
+		t.Fatalf("process transfer: %v", err)
+	}
+
+	if reason != nil {
+		t.Fatalf("unexpected transfer reason: %v", reason)
+	}
+
+	deducted := common.ZeroBig
+	var burned base.StateMergeValue
+	for i := range states {
+		switch v := states[i].Value().(type) {
+		case ccstate.DeductBalanceStateValue:
+			deducted = deducted.Add(v.Amount.Big())
+		case ccstate.AddBalanceStateValue:
+			if states[i].Key() == ccstate.BalanceStateKey(tp.GenesisAddr, tp.GenesisCurrency) &&
+				!v.Amount.Big().Equal(common.NewBig(6)) {
+				t.Fatalf("unexpected fee share of operator: %v", v.Amount.Big())
+			}
+		case ccstate.BurnTotalSupplyStateValue:
+			burned = states[i]
+		}
+	}
+
+	if !deducted.Equal(common.NewBig(110)) {
+		t.Fatalf("unexpected deducted sender balance: %v", deducted)
+	}
+
+	if burned == nil || burned.Key() != ccstate.DesignStateKey(tp.GenesisCurrency) {
+		t.Fatal("expected burn of total supply")
+	}
+
+	st, _, _ := tp.GetStateFunc(ccstate.DesignStateKey(tp.GenesisCurrency))
+	merger := burned.Merger(base.Height(2), st)
+	if err := merger.Merge(burned.Value(), transferOp.Fact().Hash()); err != nil {
+		t.Fatalf("merge burn: %v", err)
+	}
+
+	nst, err := merger.CloseValue()
+	if err != nil {
+		t.Fatalf("close burn merger: %v", err)
+	}
+
+	de, err := ccstate.GetDesignFromState(nst)
+	if err != nil {
+		t.Fatalf("get design: %v", err)
+	}
+
+	if !de.TotalSupply().Equal(common.NewBig(99996)) || !de.Burned().Equal(common.NewBig(4)) {
+		t.Fatalf("unexpected supply after burn: %v, %v", de.TotalSupply(), de.Burned())
+	}
+}
+
+func TestCalculateFeeForUnsignedOperation(t *testing.T) {
+	getter := operationtest.NewMockStateGetter()
+	var tp operationtest.TestProcessor
+	tp.Setup(getter)
+
+	sender, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("sender-calculate-fee"), true)
+	receiver, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("receiver-calculate-fee"), true)
+	feeCID := tp.NewTestCurrencyState("USD", tp.GenesisAddr, true)
+
+	policy := types.NewCurrencyPolicy(common.ZeroBig, types.NewFixedFeeer(tp.GenesisAddr, common.NewBig(10))).
+		WithFeeCurrency(types.NewFeeCurrency(feeCID, common.NewBig(3), common.NewBig(2)))
+	setCurrencyDesign(&tp, tp.GenesisCurrency, types.NewCurrencyDesign(
+		common.ZeroBig,
+		tp.GenesisCurrency,
+		common.NewBig(9),
+		tp.GenesisAddr,
+		policy,
+	))
+
+	transferOp, err := currency.NewTransfer(currency.NewTransferFact(
+		[]byte("transfer-calculate-fee"),
+		sender,
+		[]currency.TransferItem{
+			currency.NewTransferItemMultiAmounts(receiver, []types.Amount{
+				types.NewAmount(common.NewBig(100), tp.GenesisCurrency),
+			}),
+		},
+		tp.GenesisCurrency,
+	))
+	if err != nil {
+		t.Fatalf("new transfer: %v", err)
+	}
+
+	feeer, receipt, required, cid, reason := processor.CalculateFee(transferOp, tp.GetStateFunc)
+	if reason != nil {
+		t.Fatalf("unexpected reason: %v", reason)
+	}
+
+	if feeer.Type() != types.FeeerFixed {
+		t.Fatalf("unexpected feeer: %v", feeer.Type())
+	}
+
+	if cid != feeCID || !required.Equal(common.NewBig(15)) {
+		t.Fatalf("unexpected fee: %v %v", required, cid)
+	}
+
+	if receipt.Currency() != feeCID || receipt.FeeAmount() != "15" {
+		t.Fatalf("unexpected fee receipt: %+v", receipt)
+	}
+}
+
+func TestCalculateFeeRejections(t *testing.T) {
+	getter := operationtest.NewMockStateGetter()
+	var tp operationtest.TestProcessor
+	tp.Setup(getter)
+
+	sender, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("sender-calculate-fee-rejections"), true)
+	receiver, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("receiver-calculate-fee-rejections"), true)
+
+	mintOp, err := currency.NewMint(currency.NewMintFact(
+		[]byte("mint-calculate-fee"),
+		receiver,
+		types.NewAmount(common.NewBig(100), tp.GenesisCurrency),
+	))
+	if err != nil {
+		t.Fatalf("new mint: %v", err)
+	}
+
+	if _, _, _, _, reason := processor.CalculateFee(mintOp, tp.GetStateFunc); reason == nil ||
+		!strings.Contains(reason.Error(), "expected FeeAble") {
+		t.Fatalf("expected mint without fee rejected, not %v", reason)
+	}
+
+	transferOp, err := currency.NewTransfer(currency.NewTransferFact(
+		[]byte("transfer-calculate-fee-unknown"),
+		sender,
+		[]currency.TransferItem{
+			currency.NewTransferItemMultiAmounts(receiver, []types.Amount{
+				types.NewAmount(common.NewBig(100), types.CurrencyID("USD")),
+			}),
+		},
+		types.CurrencyID("USD"),
+	))
+	if err != nil {
+		t.Fatalf("new transfer: %v", err)
+	}
+
+	if _, _, _, _, reason := processor.CalculateFee(transferOp, tp.GetStateFunc); reason == nil {
+		t.Fatal("expected fee of unknown currency rejected")
+	}
+
+	// NOTE fee currency of policy is not registered
+	setCurrencyDesign(&tp, types.CurrencyID("USD"), types.NewCurrencyDesign(
+		common.ZeroBig,
+		types.CurrencyID("USD"),
+		common.NewBig(9),
+		tp.GenesisAddr,
+		types.NewCurrencyPolicy(common.ZeroBig, types.NewFixedFeeer(tp.GenesisAddr, common.NewBig(10))).
+			WithFeeCurrency(types.NewFeeCurrency(types.CurrencyID("EUR"), common.NewBig(1), common.NewBig(1))),
+	))
+
+	if _, _, _, _, reason := processor.CalculateFee(transferOp, tp.GetStateFunc); reason == nil ||
+		!strings.Contains(reason.Error(), "fee currency") {
+		t.Fatalf("expected unknown fee currency rejected, not %v", reason)
+	}
+}
+
+func TestOperationProcessorActivatesPendingPolicy(t *testing.T) {
+	getter := operationtest.NewMockStateGetter()
+	var tp operationtest.TestProcessor
+	tp.Setup(getter)
+
+	sender, _, senderPriv := tp.NewTestAccountState(tp.NewPrivateKey("sender-pending-policy"), true)
+	tp.NewTestBalanceState(sender, tp.GenesisCurrency, 1000, true)
+	receiver, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("receiver-pending-policy"), true)
+
+	setFixedFeeer(&tp, tp.GenesisCurrency, tp.GenesisAddr, 10)
+
+	// NOTE fixed fee 20 is scheduled at height 3
+	pending := types.NewCurrencyPolicy(common.ZeroBig, types.NewFixedFeeer(tp.GenesisAddr, common.NewBig(20)))
+	tp.SetState(common.NewBaseState(
+		base.Height(2),
+		ccstate.PendingPolicyStateKey(tp.GenesisCurrency),
+		ccstate.NewPendingPolicyStateValue(pending, base.Height(3)),
+		nil,
+		[]util.Hash{},
+	), true)
+
+	transferOp, err := currency.NewTransfer(currency.NewTransferFact(
+		[]byte("transfer-pending-policy"),
+		sender,
+		[]currency.TransferItem{
+			currency.NewTransferItemMultiAmounts(receiver, []types.Amount{
+				types.NewAmount(common.NewBig(100), tp.GenesisCurrency),
+			}),
+		},
+		tp.GenesisCurrency,
+	))
+	if err != nil {
+		t.Fatalf("new transfer: %v", err)
+	}
+
+	if err := transferOp.Sign(senderPriv, tp.NetworkID); err != nil {
+		t.Fatalf("sign transfer: %v", err)
+	}
+
+	for _, c := range []struct {
+		height    base.Height
+		fee       string
+		activated bool
+	}{
+		{height: base.Height(2), fee: "10"},
+		{height: base.Height(3), fee: "20", activated: true},
+	} {
+		opr := newWrappedProcessorAt(t, c.height, tp.GetStateFunc)
+
+		states, reason, err := opr.Process(context.Background(), transferOp, tp.GetStateFunc)
+		if err != nil {
+			t.Fatalf("process transfer at %v: %v", c.height, err)
+		}
+
+		if reason != nil {
+			t.Fatalf("unexpected transfer reason at %v: %v", c.height, reason)
+		}
+
+		receipt := receiptAsCurrency(t, opr.OperationReceipt())
+		if receipt.Fee.FeeAmount() != c.fee {
+			t.Fatalf("unexpected fee at %v: %v", c.height, receipt.Fee.FeeAmount())
+		}
+
+		var activated, applied bool
+		for i := range states {
+			switch v := states[i].Value().(type) {
+			case ccstate.DesignStateValue:
+				t.Fatalf("unexpected whole currency design at %v", c.height)
+			case ccstate.ActivatePolicyStateValue:
+				activated = true
+
+				if v.Policy.Feeer().Fee().String() != "20" {
+					t.Fatalf("unexpected activated policy: %v", v.Policy)
+				}
+			case ccstate.PendingPolicyStateValue:
+				applied = v.Applied
+			}
+		}
+
+		if activated != c.activated || applied != c.activated {
+			t.Fatalf("unexpected activation at %v: %v, applied %v", c.height, activated, applied)
+		}
+	}
+}
+
+func TestOperationProcessorExecutesApprovedProposal(t *testing.T) {
+	getter := operationtest.NewMockStateGetter()
+	var tp operationtest.TestProcessor
+	tp.Setup(getter)
+
+	privA, err := base.ParseMPrivatekey(tp.NewPrivateKey("proposal-key-a"))
+	if err != nil {
+		t.Fatalf("parse private key: %v", err)
+	}
+
+	privB, err := base.ParseMPrivatekey(tp.NewPrivateKey("proposal-key-b"))
+	if err != nil {
+		t.Fatalf("parse private key: %v", err)
+	}
+
+	keyA, _ := types.NewBaseAccountKey(privA.Publickey(), 50)
+	keyB, _ := types.NewBaseAccountKey(privB.Publickey(), 50)
+
+	keys, err := types.NewBaseAccountKeys([]types.AccountKey{keyA, keyB}, 100)
+	if err != nil {
+		t.Fatalf("new account keys: %v", err)
+	}
+
+	account, err := types.NewAccountFromKeys(keys)
+	if err != nil {
+		t.Fatalf("new account: %v", err)
+	}
+
+	sender := account.Address()
+	tp.SetState(common.NewBaseState(
+		base.Height(1), ccstate.AccountStateKey(sender), ccstate.NewAccountStateValue(account), nil, []util.Hash{},
+	), true)
+	tp.NewTestBalanceState(sender, tp.GenesisCurrency, 1000, true)
+	receiver, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("receiver-proposal"), true)
+
+	setCurrencyDesign(&tp, tp.GenesisCurrency, types.NewCurrencyDesign(
+		common.NewBig(100000),
+		tp.GenesisCurrency,
+		common.NewBig(9),
+		tp.GenesisAddr,
+		types.NewCurrencyPolicy(common.ZeroBig, types.NewNilFeeer()),
+	))
+
+	transferFact := currency.NewTransferFact(
+		[]byte("proposed-transfer"),
+		sender,
+		[]currency.TransferItem{currency.NewTransferItemMultiAmounts(receiver, []types.Amount{
+			types.NewAmount(common.NewBig(300), tp.GenesisCurrency),
+		})},
+		tp.GenesisCurrency,
+	)
+
+	fact := currency.NewProposeOperationFact(
+		[]byte("propose"), sender, transferFact, base.Height(20), tp.GenesisCurrency)
+
+	proposeOp, err := currency.NewProposeOperation(fact)
+	if err != nil {
+		t.Fatalf("new propose operation: %v", err)
+	}
+
+	if err := proposeOp.Sign(privA, tp.NetworkID); err != nil {
+		t.Fatalf("sign propose operation: %v", err)
+	}
+
+	if err := proposeOp.IsValid(tp.NetworkID); err != nil {
+		t.Fatalf("invalid propose operation: %v", err)
+	}
+
+	opr := newWrappedProcessorAt(t, base.Height(10), tp.GetStateFunc)
+
+	if _, reason, err := opr.PreProcess(context.Background(), proposeOp, tp.GetStateFunc); err != nil {
+		t.Fatalf("preprocess propose operation: %v", err)
+	} else if reason != nil {
+		t.Fatalf("unexpected propose operation reason: %v", reason)
+	}
+
+	states, reason, err := opr.Process(context.Background(), proposeOp, tp.GetStateFunc)
+	if err != nil {
+		t.Fatalf("process propose operation: %v", err)
+	} else if reason != nil {
+		t.Fatalf("unexpected propose operation reason: %v", reason)
+	}
+
+	key := ccstate.ProposalStateKey(sender, fact.ProposalID())
+
+	var proposal ccstate.ProposalStateValue
+	for i := range states {
+		if v, ok := states[i].Value().(ccstate.ProposalStateValue); ok && states[i].Key() == key {
+			proposal = v
+		}
+	}
+
+	if proposal.Status != ccstate.ProposalPending || !proposal.Approved(privA.Publickey()) {
+		t.Fatalf("expected pending proposal approved by proposer, %v", proposal.Status)
+	}
+
+	tp.SetState(common.NewBaseState(base.Height(10), key, proposal, nil, []util.Hash{}), true)
+
+	newApprove := func(priv base.Privatekey, token string) currency.ApproveOperation {
+		op, err := currency.NewApproveOperation(currency.NewApproveOperationFact(
+			[]byte(token), sender, fact.ProposalID(), tp.GenesisCurrency))
+		if err != nil {
+			t.Fatalf("new approve operation: %v", err)
+		}
+
+		if err := op.Sign(priv, tp.NetworkID); err != nil {
+			t.Fatalf("sign approve operation: %v", err)
+		}
+
+		return op
+	}
+
+	// NOTE the proposer can not approve again.
+	_, reason, err = newWrappedProcessorAt(t, base.Height(11), tp.GetStateFunc).PreProcess(
+		context.Background(), newApprove(privA, "approve-a"), tp.GetStateFunc)
+	if err != nil {
+		t.Fatalf("preprocess approve operation: %v", err)
+	} else if reason == nil || !strings.Contains(reason.Error(), "already approved") {
+		t.Fatalf("expected already approved, not %v", reason)
+	}
+
+	approveOp := newApprove(privB, "approve-b")
+
+	opr = newWrappedProcessorAt(t, base.Height(11), tp.GetStateFunc)
+
+	if _, reason, err := opr.PreProcess(context.Background(), approveOp, tp.GetStateFunc); err != nil {
+		t.Fatalf("preprocess approve operation: %v", err)
+	} else if reason != nil {
+		t.Fatalf("unexpected approve operation reason: %v", reason)
+	}
+
+	states, reason, err = opr.Process(context.Background(), approveOp, tp.GetStateFunc)
+	if err != nil {
+		t.Fatalf("process approve operation: %v", err)
+	} else if reason != nil {
+		t.Fatalf("unexpected approve operation reason: %v", reason)
+	}
+
+	var added bool
+	for i := range states {
+		switch v := states[i].Value().(type) {
+		case ccstate.AddBalanceStateValue:
+			if states[i].Key() == ccstate.BalanceStateKey(receiver, tp.GenesisCurrency) {
+				added = v.Amount.Big().Equal(common.NewBig(300))
+			}
+		case ccstate.ProposalStateValue:
+			proposal = v
+		}
+	}
+
+	if !added || proposal.Status != ccstate.ProposalExecuted {
+		t.Fatalf("expected proposed transfer executed, %v, %v", added, proposal.Status)
+	}
+}
+
+func TestOperationProcessorAcceptsOldKeysInGracePeriod(t *testing.T) {
+	getter := operationtest.NewMockStateGetter()
+	var tp operationtest.TestProcessor
+	tp.Setup(getter)
+
+	account, _, oldPriv := tp.NewTestAccountState(tp.NewPrivateKey("key-rotation"), true)
+	tp.NewTestBalanceState(account, tp.GenesisCurrency, 1000, true)
+
+	setCurrencyDesign(&tp, tp.GenesisCurrency, types.NewCurrencyDesign(
+		common.NewBig(100000),
+		tp.GenesisCurrency,
+		common.NewBig(9),
+		tp.GenesisAddr,
+		types.NewCurrencyPolicy(common.ZeroBig, types.NewNilFeeer()),
+	))
+
+	privs := make([]base.Privatekey, 2)
+	for i, seed := range []string{"rotated-key", "next-rotated-key"} {
+		priv, err := base.ParseMPrivatekey(tp.NewPrivateKey(seed))
+		if err != nil {
+			t.Fatalf("parse private key: %v", err)
+		}
+
+		privs[i] = priv
+	}
+
+	newKeys := make([]types.AccountKeys, len(privs))
+	for i := range privs {
+		k, _ := types.NewBaseAccountKey(privs[i].Publickey(), 100)
+
+		keys, err := types.NewBaseAccountKeys([]types.AccountKey{k}, 100)
+		if err != nil {
+			t.Fatalf("new keys: %v", err)
+		}
+
+		newKeys[i] = keys
+	}
+
+	rotateOp, err := currency.NewUpdateKey(currency.NewUpdateKeyFact(
+		[]byte("rotate-key"), account, newKeys[0], tp.GenesisCurrency, 5))
+	if err != nil {
+		t.Fatalf("new update key: %v", err)
+	}
+
+	if err := rotateOp.Sign(oldPriv, tp.NetworkID); err != nil {
+		t.Fatalf("sign update key: %v", err)
+	}
+
+	opr := newWrappedProcessorAt(t, base.Height(10), tp.GetStateFunc)
+
+	if _, reason, err := opr.PreProcess(context.Background(), rotateOp, tp.GetStateFunc); err != nil {
+		t.Fatalf("preprocess update key: %v", err)
+	} else if reason != nil {
+		t.Fatalf("unexpected update key reason: %v", reason)
+	}
+
+	states, reason, err := opr.Process(context.Background(), rotateOp, tp.GetStateFunc)
+	if err != nil {
+		t.Fatalf("process update key: %v", err)
+	} else if reason != nil {
+		t.Fatalf("unexpected update key reason: %v", reason)
+	}
+
+	var previous ccstate.PreviousKeysStateValue
+	for i := range states {
+		switch v := states[i].Value().(type) {
+		case ccstate.AccountStateValue:
+			tp.SetState(common.NewBaseState(base.Height(10), states[i].Key(), v, nil, []util.Hash{}), true)
+		case ccstate.PreviousKeysStateValue:
+			previous = v
+			tp.SetState(common.NewBaseState(base.Height(10), states[i].Key(), v, nil, []util.Hash{}), true)
+		}
+	}
+
+	if previous.Until != base.Height(15) {
+		t.Fatalf("expected previous keys until 15, not %v", previous.Until)
+	}
+
+	nextOp, err := currency.NewUpdateKey(currency.NewUpdateKeyFact(
+		[]byte("next-rotate-key"), account, newKeys[1], tp.GenesisCurrency, 0))
+	if err != nil {
+		t.Fatalf("new update key: %v", err)
+	}
+
+	// NOTE the replaced keys are still accepted in the grace period.
+	if err := nextOp.Sign(oldPriv, tp.NetworkID); err != nil {
+		t.Fatalf("sign update key: %v", err)
+	}
+
+	if _, reason, err := newWrappedProcessorAt(t, base.Height(14), tp.GetStateFunc).PreProcess(
+		context.Background(), nextOp, tp.GetStateFunc); err != nil {
+		t.Fatalf("preprocess update key: %v", err)
+	} else if reason != nil {
+		t.Fatalf("unexpected update key reason in grace period: %v", reason)
+	}
+
+	_, reason, err = newWrappedProcessorAt(t, base.Height(15), tp.GetStateFunc).PreProcess(
+		context.Background(), nextOp, tp.GetStateFunc)
+	if err != nil {
+		t.Fatalf("preprocess update key: %v", err)
+	} else if reason == nil || !strings.Contains(reason.Error(), "threshold") {
+		t.Fatalf("expected not passed threshold after grace period, not %v", reason)
+	}
+}
+
+func TestOperationProcessorAcceptsMixedKeyTypeSigns(t *testing.T) {
+	getter := operationtest.NewMockStateGetter()
+	var tp operationtest.TestProcessor
+	tp.Setup(getter)
+
+	receiver, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("mixed-receiver"), true)
+
+	setCurrencyDesign(&tp, tp.GenesisCurrency, types.NewCurrencyDesign(
+		common.NewBig(100000),
+		tp.GenesisCurrency,
+		common.NewBig(9),
+		tp.GenesisAddr,
+		types.NewCurrencyPolicy(common.ZeroBig, types.NewNilFeeer()),
+	))
+
+	mePriv, err := base.ParseMPrivatekey(tp.NewPrivateKey("mixed-secp256k1"))
+	if err != nil {
+		t.Fatalf("parse private key: %v", err)
+	}
+
+	edPriv, err := types.NewEdPrivatekeyFromSeed("mixed-ed25519-seed-for-operation-processor")
+	if err != nil {
+		t.Fatalf("new ed25519 private key: %v", err)
+	}
+
+	meKey, _ := types.NewBaseAccountKey(mePriv.Publickey(), 50)
+	edKey, _ := types.NewBaseAccountKey(edPriv.Publickey(), 50)
+
+	keys, err := types.NewBaseAccountKeys([]types.AccountKey{edKey, meKey}, 100)
+	if err != nil {
+		t.Fatalf("new keys: %v", err)
+	}
+
+	reversed, err := types.NewBaseAccountKeys([]types.AccountKey{meKey, edKey}, 100)
+	if err != nil {
+		t.Fatalf("new keys: %v", err)
+	}
+
+	ac, err := types.NewAccountFromKeys(keys)
+	if err != nil {
+		t.Fatalf("new account: %v", err)
+	}
+
+	// NOTE the address does not depend on the order nor the types of keys.
+	if rac, err := types.NewAccountFromKeys(reversed); err != nil {
+		t.Fatalf("new account: %v", err)
+	} else if !rac.Address().Equal(ac.Address()) {
+		t.Fatalf("expected same address, %v != %v", rac.Address(), ac.Address())
+	}
+
+	tp.SetState(common.NewBaseState(
+		base.Height(1), ccstate.AccountStateKey(ac.Address()), ccstate.NewAccountStateValue(ac), nil, []util.Hash{}), true)
+	tp.NewTestBalanceState(ac.Address(), tp.GenesisCurrency, 1000, true)
+
+	item := currency.NewTransferItemMultiAmounts(receiver, []types.Amount{
+		types.NewAmount(common.NewBig(100), tp.GenesisCurrency),
+	})
+
+	transferOp, err := currency.NewTransfer(currency.NewTransferFact(
+		[]byte("mixed-transfer"),
+		ac.Address(),
+		[]currency.TransferItem{item},
+		tp.GenesisCurrency,
+	))
+	if err != nil {
+		t.Fatalf("new transfer: %v", err)
+	}
+
+	if err := transferOp.Sign(edPriv, tp.NetworkID); err != nil {
+		t.Fatalf("sign transfer: %v", err)
+	}
+
+	// NOTE the ed25519 key alone can not pass the threshold.
+	_, reason, err := newWrappedProcessor(t, tp.GetStateFunc).PreProcess(
+		context.Background(), transferOp, tp.GetStateFunc)
+	if err != nil {
+		t.Fatalf("preprocess transfer: %v", err)
+	} else if reason == nil || !strings.Contains(reason.Error(), "threshold") {
+		t.Fatalf("expected not passed threshold, not %v", reason)
+	}
+
+	if err := transferOp.Sign(mePriv, tp.NetworkID); err != nil {
+		t.Fatalf("sign transfer: %v", err)
+	}
+
+	if err := transferOp.IsValid(tp.NetworkID); err != nil {
+		t.Fatalf("invalid transfer: %v", err)
+	}
+
+	if _, reason, err := newWrappedProcessor(t, tp.GetStateFunc).PreProcess(
+		context.Background(), transferOp, tp.GetStateFunc); err != nil {
+		t.Fatalf("preprocess transfer: %v", err)
+	} else if reason != nil {
+		t.Fatalf("unexpected transfer reason: %v", reason)
+	}
+}
+
+func TestOperationProcessorAllowsOnlyOwnerToNominateContractOwner(t *testing.T) {
+	getter := operationtest.NewMockStateGetter()
+	var tp operationtest.TestProcessor
+	tp.Setup(getter)
+
+	setCurrencyDesign(&tp, tp.GenesisCurrency, types.NewCurrencyDesign(
+		common.NewBig(100000),
+		tp.GenesisCurrency,
+		common.NewBig(9),
+		tp.GenesisAddr,
+		types.NewCurrencyPolicy(common.ZeroBig, types.NewNilFeeer()),
+	))
+
+	owner, _, ownerPriv := tp.NewTestAccountState(tp.NewPrivateKey("contract-owner"), true)
+	nominee, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("contract-nominee"), true)
+	stranger, _, strangerPriv := tp.NewTestAccountState(tp.NewPrivateKey("contract-stranger"), true)
+
+	for _, a := range []base.Address{owner, nominee, stranger} {
+		tp.NewTestBalanceState(a, tp.GenesisCurrency, 1000, true)
+	}
+
+	contract, _ := tp.NewTestContractAccountState(owner, tp.NewPrivateKey("owned-contract"), true)
+
+	updateOwner := func(token string, sender base.Address, priv base.Privatekey) base.Operation {
+		op, err := extension.NewUpdateOwner(extension.NewUpdateOwnerFact(
+			[]byte(token), sender, contract, nominee, tp.GenesisCurrency))
+		if err != nil {
+			t.Fatalf("new update owner: %v", err)
+		}
+
+		if err := op.Sign(priv, tp.NetworkID); err != nil {
+			t.Fatalf("sign update owner: %v", err)
+		}
+
+		return op
+	}
+
+	// NOTE only the owner of contract account can nominate the next owner.
+	_, reason, err := newWrappedProcessorAt(t, base.Height(3), tp.GetStateFunc).PreProcess(
+		context.Background(), updateOwner("stranger-nominates", stranger, strangerPriv), tp.GetStateFunc)
+	if err != nil {
+		t.Fatalf("preprocess update owner: %v", err)
+	} else if reason == nil || !strings.Contains(reason.Error(), "not owner") {
+		t.Fatalf("expected nomination by stranger rejected, not %v", reason)
+	}
+
+	if _, reason, err := newWrappedProcessorAt(t, base.Height(3), tp.GetStateFunc).PreProcess(
+		context.Background(), updateOwner("owner-nominates", owner, ownerPriv), tp.GetStateFunc); err != nil {
+		t.Fatalf("preprocess update owner: %v", err)
+	} else if reason != nil {
+		t.Fatalf("unexpected update owner reason: %v", reason)
+	}
+}
+
+func TestOperationProcessorLimitsHandlerWithdrawByScope(t *testing.T) {
+	getter := operationtest.NewMockStateGetter()
+	var tp operationtest.TestProcessor
+	tp.Setup(getter)
+
+	setCurrencyDesign(&tp, tp.GenesisCurrency, types.NewCurrencyDesign(
+		common.NewBig(100000),
+		tp.GenesisCurrency,
+		common.NewBig(9),
+		tp.GenesisAddr,
+		types.NewCurrencyPolicy(common.ZeroBig, types.NewNilFeeer()),
+	))
+
+	owner, _, ownerPriv := tp.NewTestAccountState(tp.NewPrivateKey("scope-owner"), true)
+	scoped, _, scopedPriv := tp.NewTestAccountState(tp.NewPrivateKey("scope-scoped-handler"), true)
+	unscoped, _, unscopedPriv := tp.NewTestAccountState(tp.NewPrivateKey("scope-unscoped-handler"), true)
+	other, _, otherPriv := tp.NewTestAccountState(tp.NewPrivateKey("scope-other-handler"), true)
+
+	for _, a := range []base.Address{owner, scoped, unscoped, other} {
+		tp.NewTestBalanceState(a, tp.GenesisCurrency, 1000, true)
+	}
+
+	contract, _ := tp.NewTestContractAccountState(owner, tp.NewPrivateKey("scoped-contract"), true)
+	tp.NewTestBalanceState(contract, tp.GenesisCurrency, 1000, true)
+
+	status := types.NewContractAccountStatus(owner, []base.Address{scoped, unscoped, other})
+	if err := status.SetHandlerScopes([]types.HandlerScope{
+		types.NewHandlerScope(scoped, []hint.Type{extension.WithdrawHint.Type()}, []types.Amount{
+			types.NewAmount(common.NewBig(100), tp.GenesisCurrency),
+		}),
+		types.NewHandlerScope(other, []hint.Type{extension.UpdateRecipientHint.Type()}, []types.Amount{
+			types.NewAmount(common.NewBig(100), tp.GenesisCurrency),
+		}),
+	}); err != nil {
+		t.Fatalf("set handler scopes: %v", err)
+	}
+
+	tp.SetState(common.NewBaseState(
+		base.Height(1),
+		extstate.StateKeyContractAccount(contract),
+		extstate.NewContractAccountStateValue(status),
+		nil,
+		[]util.Hash{},
+	), true)
+
+	withdraw := func(sender base.Address, priv base.Privatekey, amount int64) string {
+		op, err := extension.NewWithdraw(extension.NewWithdrawFact(
+			[]byte(sender.String()+strconv.FormatInt(amount, 10)),
+			sender,
+			[]extension.WithdrawItem{extension.NewWithdrawItemMultiAmounts(contract, []types.Amount{
+				types.NewAmount(common.NewBig(amount), tp.GenesisCurrency),
+			})},
+			tp.GenesisCurrency,
+		))
+		if err != nil {
+			t.Fatalf("new withdraw: %v", err)
+		}
+
+		if err := op.Sign(priv, tp.NetworkID); err != nil {
+			t.Fatalf("sign withdraw: %v", err)
+		}
+
+		if err := op.IsValid(tp.NetworkID); err != nil {
+			t.Fatalf("invalid withdraw: %v", err)
+		}
+
+		_, reason, err := newWrappedProcessor(t, tp.GetStateFunc).PreProcess(
+			context.Background(), op, tp.GetStateFunc)
+		if err != nil {
+			t.Fatalf("preprocess withdraw: %v", err)
+		} else if reason != nil {
+			return reason.Error()
+		}
+
+		return ""
+	}
+
+	for _, c := range []struct {
+		name   string
+		sender base.Address
+		priv   base.Privatekey
+		amount int64
+		reason string
+	}{
+		{name: "owner over limit of handler", sender: owner, priv: ownerPriv, amount: 500},
+		{name: "handler within limit", sender: scoped, priv: scopedPriv, amount: 100},
+		{name: "handler over limit", sender: scoped, priv: scopedPriv, amount: 101, reason: "over withdraw limit"},
+		{name: "handler without scope", sender: unscoped, priv: unscopedPriv, amount: 500},
+		{name: "operation out of scope", sender: other, priv: otherPriv, amount: 1, reason: "out of scope"},
+	} {
+		reason := withdraw(c.sender, c.priv, c.amount)
+
+		switch {
+		case len(c.reason) < 1 && len(reason) > 0:
+			t.Fatalf("%s: unexpected withdraw reason: %v", c.name, reason)
+		case len(c.reason) > 0 && !strings.Contains(reason, c.reason):
+			t.Fatalf("%s: expected %q, not %q", c.name, c.reason, reason)
+		}
+	}
+}
+
+func TestOperationProcessorAllowsOnlyOwnerToUpdateBalanceStatus(t *testing.T) {
+	getter := operationtest.NewMockStateGetter()
+	var tp operationtest.TestProcessor
+	tp.Setup(getter)
+
+	setCurrencyDesign(&tp, tp.GenesisCurrency, types.NewCurrencyDesign(
+		common.NewBig(100000),
+		tp.GenesisCurrency,
+		common.NewBig(9),
+		tp.GenesisAddr,
+		types.NewCurrencyPolicy(common.ZeroBig, types.NewNilFeeer()),
+	))
+
+	owner, _, ownerPriv := tp.NewTestAccountState(tp.NewPrivateKey("status-owner"), true)
+	stranger, _, strangerPriv := tp.NewTestAccountState(tp.NewPrivateKey("status-stranger"), true)
+
+	for _, a := range []base.Address{owner, stranger} {
+		tp.NewTestBalanceState(a, tp.GenesisCurrency, 1000, true)
+	}
+
+	contract, _ := tp.NewTestContractAccountState(owner, tp.NewPrivateKey("status-contract"), true)
+
+	updateBalanceStatus := func(token string, sender base.Address, priv base.Privatekey) base.Operation {
+		op, err := extension.NewUpdateBalanceStatus(extension.NewUpdateBalanceStatusFact(
+			[]byte(token), sender, contract, types.FullyFrozen, tp.GenesisCurrency))
+		if err != nil {
+			t.Fatalf("new update balance status: %v", err)
+		}
+
+		if err := op.Sign(priv, tp.NetworkID); err != nil {
+			t.Fatalf("sign update balance status: %v", err)
+		}
+
+		return op
+	}
+
+	_, reason, err := newWrappedProcessor(t, tp.GetStateFunc).PreProcess(
+		context.Background(), updateBalanceStatus("stranger-freezes", stranger, strangerPriv), tp.GetStateFunc)
+	if err != nil {
+		t.Fatalf("preprocess update balance status: %v", err)
+	} else if reason == nil || !strings.Contains(reason.Error(), "not owner") {
+		t.Fatalf("expected balance status update by stranger rejected, not %v", reason)
+	}
+
+	if _, reason, err := newWrappedProcessor(t, tp.GetStateFunc).PreProcess(
+		context.Background(), updateBalanceStatus("owner-freezes", owner, ownerPriv), tp.GetStateFunc); err != nil {
+		t.Fatalf("preprocess update balance status: %v", err)
+	} else if reason != nil {
+		t.Fatalf("unexpected update balance status reason: %v", reason)
+	}
+}
+
+func TestOperationProcessorRejectsOldKeysAfterRecovery(t *testing.T) {
+	getter := operationtest.NewMockStateGetter()
+	var tp operationtest.TestProcessor
+	tp.Setup(getter)
+
+	account, _, oldPriv := tp.NewTestAccountState(tp.NewPrivateKey("recovered-account"), true)
+
+	setCurrencyDesign(&tp, tp.GenesisCurrency, types.NewCurrencyDesign(
+		common.NewBig(100000),
+		tp.GenesisCurrency,
+		common.NewBig(9),
+		tp.GenesisAddr,
+		types.NewCurrencyPolicy(common.ZeroBig, types.NewNilFeeer()),
+	))
+
+	np := func(height base.Height, getStateFunc base.GetStateFunc,
+		_, _ base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		return newWrappedProcessorAt(t, height, getStateFunc), nil
+	}
+
+	privs := make([]base.Privatekey, 3)
+	keys := make([]types.AccountKeys, len(privs))
+	for i, seed := range []string{"guardian", "rotated-key", "recovered-key"} {
+		priv, err := base.ParseMPrivatekey(tp.NewPrivateKey(seed))
+		if err != nil {
+			t.Fatalf("parse private key: %v", err)
+		}
+
+		k, _ := types.NewBaseAccountKey(priv.Publickey(), 100)
+
+		if keys[i], err = types.NewBaseAccountKeys([]types.AccountKey{k}, 100); err != nil {
+			t.Fatalf("new keys: %v", err)
+		}
+
+		privs[i] = priv
+	}
+
+	process := func(height base.Height, op interface {
+		base.Operation
+		Sign(base.Privatekey, base.NetworkID) error
+	}, priv base.Privatekey) {
+		t.Helper()
+
+		if err := op.Sign(priv, tp.NetworkID); err != nil {
+			t.Fatalf("sign %T: %v", op, err)
+		}
+
+		if _, reason, err := tp.ProcessAt(np, height, op); err != nil || reason != nil {
+			t.Fatalf("process %T: %v, %v", op, reason, err)
+		}
+	}
+
+	setOp, _ := currency.NewSetGuardians(currency.NewSetGuardiansFact(
+		[]byte("set-guardians"), account, keys[0], 5, tp.GenesisCurrency))
+	process(base.Height(10), &setOp, oldPriv)
+
+	// NOTE the old keys are accepted until 111.
+	rotateOp, _ := currency.NewUpdateKey(currency.NewUpdateKeyFact(
+		[]byte("rotate-key"), account, keys[1], tp.GenesisCurrency, 100))
+	process(base.Height(11), &rotateOp, oldPriv)
+
+	recoverOp, _ := currency.NewRecoverAccount(currency.NewRecoverAccountFact(
+		[]byte("recover-account"), account, keys[2], tp.GenesisCurrency))
+	process(base.Height(12), &recoverOp, privs[0])
+
+	completeOp, _ := currency.NewCompleteRecovery(currency.NewCompleteRecoveryFact(
+		[]byte("complete-recovery"), account, tp.GenesisCurrency))
+	process(base.Height(17), &completeOp, privs[0])
+
+	st, found, _ := tp.GetStateFunc(ccstate.PreviousKeysStateKey(account))
+	if !found {
+		t.Fatal("expected previous keys state")
+	} else if v := st.Value().(ccstate.PreviousKeysStateValue); v.Active(base.Height(17)) {
+		t.Fatalf("expected previous keys expired by recovery, not until %v", v.Until)
+	}
+
+	for i, priv := range []base.Privatekey{oldPriv, privs[1]} {
+		op, _ := currency.NewUpdateKey(currency.NewUpdateKeyFact(
+			[]byte("update-key-after-recovery-"+strconv.Itoa(i)), account, keys[1], tp.GenesisCurrency, 0))
+
+		if err := op.Sign(priv, tp.NetworkID); err != nil {
+			t.Fatalf("sign update key: %v", err)
+		}
+
+		reason, err := tp.PreProcessAt(np, base.Height(18), op)
+		if err != nil {
+			t.Fatalf("preprocess update key: %v", err)
+		} else if reason == nil || !strings.Contains(reason.Error(), "threshold") {
+			t.Fatalf("expected replaced keys rejected after recovery, not %v", reason)
+		}
+	}
+}
+
+func TestOperationProcessorRejectsFeeToDepositBlockedContract(t *testing.T) {
+	getter := operationtest.NewMockStateGetter()
+	var tp operationtest.TestProcessor
+	tp.Setup(getter)
+
+	sender, _, senderPriv := tp.NewTestAccountState(tp.NewPrivateKey("sender-blocked-fee"), true)
+	tp.NewTestBalanceState(sender, tp.GenesisCurrency, 1000, true)
+	receiver, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("receiver-blocked-fee"), true)
+
+	feeReceiver, _ := tp.NewTestContractAccountState(sender, tp.NewPrivateKey("blocked-fee-receiver"), true)
+	tp.NewTestBalanceState(feeReceiver, tp.GenesisCurrency, 0, true)
+
+	setFixedFeeer(&tp, tp.GenesisCurrency, feeReceiver, 10)
+
+	status := types.NewContractAccountStatus(sender, nil)
+	status.SetBalanceStatus(types.DepositBlocked)
+	tp.SetState(common.NewBaseState(
+		base.Height(1),
+		extstate.StateKeyContractAccount(feeReceiver),
+		extstate.NewContractAccountStateValue(status),
+		nil,
+		[]util.Hash{},
+	), true)
+
+	op, err := currency.NewTransfer(currency.NewTransferFact(
+		[]byte("transfer-blocked-fee"),
+		sender,
+		[]currency.TransferItem{currency.NewTransferItemMultiAmounts(receiver, []types.Amount{
+			types.NewAmount(common.NewBig(100), tp.GenesisCurrency),
+		})},
+		tp.GenesisCurrency,
+	))
+	if err != nil {
+		t.Fatalf("new transfer: %v", err)
+	}
+
+	if err := op.Sign(senderPriv, tp.NetworkID); err != nil {
+		t.Fatalf("sign transfer: %v", err)
+	}
+
+	_, reason, err := newWrappedProcessor(t, tp.GetStateFunc).Process(context.Background(), op, tp.GetStateFunc)
+	if err != nil {
+		t.Fatalf("process transfer: %v", err)
+	} else if reason == nil || !strings.Contains(reason.Error(), "not allowed to deposit") {
+		t.Fatalf("expected fee receiver not allowed to deposit, not %v", reason)
+	}
+}
+
+func TestOperationProcessorCountsOutflowsInBlock(t *testing.T) {
+	getter := operationtest.NewMockStateGetter()
+	var tp operationtest.TestProcessor
+	tp.Setup(getter)
+
+	sender, _, senderPriv := tp.NewTestAccountState(tp.NewPrivateKey("sender-block-outflow"), true)
+	tp.NewTestBalanceState(sender, tp.GenesisCurrency, 1000, true)
+	receiver, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("receiver-block-outflow"), true)
+
+	// NOTE max outflow 300 per 10 blocks
+	setCurrencyDesign(&tp, tp.GenesisCurrency, types.NewCurrencyDesign(
+		common.NewBig(100000),
+		tp.GenesisCurrency,
+		common.NewBig(9),
+		tp.GenesisAddr,
+		types.NewCurrencyPolicy(common.ZeroBig, types.NewNilFeeer()).
+			WithLimits(types.NewTransferLimits(common.ZeroBig, common.NewBig(300), 10, common.ZeroBig)),
+	))
+
+	feeCID := types.CurrencyID("FEE")
+	setCurrencyDesign(&tp, feeCID, types.NewCurrencyDesign(
+		common.NewBig(100000),
+		feeCID,
+		common.NewBig(9),
+		tp.GenesisAddr,
+		types.NewCurrencyPolicy(common.ZeroBig, types.NewNilFeeer()),
+	))
+	tp.NewTestBalanceState(sender, feeCID, 1000, true)
+
+	// NOTE the transfers of same currency with different fee currencies are
+	// not duplicated.
+	newTransfer := func(token string, cid types.CurrencyID) currency.Transfer {
+		op, err := currency.NewTransfer(currency.NewTransferFact(
+			[]byte(token),
+			sender,
+			[]currency.TransferItem{
+				currency.NewTransferItemMultiAmounts(receiver, []types.Amount{
+					types.NewAmount(common.NewBig(200), tp.GenesisCurrency),
+				}),
+			},
+			cid,
+		))
+		if err != nil {
+			t.Fatalf("new transfer: %v", err)
+		}
+
+		if err := op.Sign(senderPriv, tp.NetworkID); err != nil {
+			t.Fatalf("sign transfer: %v", err)
+		}
+
+		return op
+	}
+
+	opr := newWrappedProcessorAt(t, base.Height(12), tp.GetStateFunc)
+
+	ops := []currency.Transfer{newTransfer("outflow-a", tp.GenesisCurrency), newTransfer("outflow-b", feeCID)}
+	for i := range ops {
+		if _, reason, err := opr.PreProcess(context.Background(), ops[i], tp.GetStateFunc); err != nil || reason != nil {
+			t.Fatalf("preprocess transfer: %v, %v", err, reason)
+		}
+	}
+
+	if _, reason, err := opr.Process(context.Background(), ops[0], tp.GetStateFunc); err != nil || reason != nil {
+		t.Fatalf("process transfer: %v, %v", err, reason)
+	}
+
+	switch _, reason, err := opr.Process(context.Background(), ops[1], tp.GetStateFunc); {
+	case err != nil:
+		t.Fatalf("process transfer: %v", err)
+	case reason == nil || !strings.Contains(reason.Error(), "over max outflow"):
+		t.Fatalf("expected outflows in block over max outflow, not %v", reason)
+	}
+}
+
+func TestOperationProcessorDeduplicatesBlockDuplicated(t *testing.T) {
+	getter := operationtest.NewMockStateGetter()
+	var tp operationtest.TestProcessor
+	tp.Setup(getter)
+
+	sender, _, senderPriv := tp.NewTestAccountState(tp.NewPrivateKey("sender-block-duplicated"), true)
+	tp.NewTestBalanceState(sender, tp.GenesisCurrency, 1000, true)
+	receiver, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("receiver-block-duplicated"), true)
+
+	newTransfer := func(token string) currency.Transfer {
+		op, err := currency.NewTransfer(currency.NewTransferFact(
+			[]byte(token),
+			sender,
+			[]currency.TransferItem{
+				currency.NewTransferItemMultiAmounts(receiver, []types.Amount{
+					types.NewAmount(common.NewBig(10), tp.GenesisCurrency),
+				}),
+			},
+			tp.GenesisCurrency,
+		))
+		if err != nil {
+			t.Fatalf("new transfer: %v", err)
+		}
+
+		if err := op.Sign(senderPriv, tp.NetworkID); err != nil {
+			t.Fatalf("sign transfer: %v", err)
+		}
+
+		return op
+	}
+
+	root := newRootProcessor(t)
+
+	// NOTE the operation before proposal, like the run of due schedule.
+	before, err := root.New(base.Height(12), tp.GetStateFunc, nil, nil)
+	if err != nil {
+		t.Fatalf("new processor: %v", err)
+	}
+
+	if _, reason, err := before.PreProcess(context.Background(), newTransfer("before-proposal"), tp.GetStateFunc); err != nil || reason != nil {
+		t.Fatalf("preprocess transfer: %v, %v", err, reason)
+	}
+
+	root.SetBlockDuplicated(base.Height(12), before.Duplicated)
+
+	op := newTransfer("in-proposal")
+
+	opr, err := root.New(base.Height(12), tp.GetStateFunc, nil, nil)
+	if err != nil {
+		t.Fatalf("new processor: %v", err)
+	}
+
+	switch _, reason, err := opr.PreProcess(context.Background(), op, tp.GetStateFunc); {
+	case err != nil:
+		t.Fatalf("preprocess transfer: %v", err)
+	case reason == nil || !strings.Contains(reason.Error(), "duplicated"):
+		t.Fatalf("expected duplicated with operation before proposal, not %v", reason)
+	}
+
+	// NOTE the keys are only for the given height.
+	next, err := root.New(base.Height(13), tp.GetStateFunc, nil, nil)
+	if err != nil {
+		t.Fatalf("new processor: %v", err)
+	}
+
+	if _, reason, err := next.PreProcess(context.Background(), op, tp.GetStateFunc); err != nil || reason != nil {
+		t.Fatalf("expected not duplicated at next height: %v, %v", err, reason)
+	}
+}
+
+func TestOperationProcessorRejectsFeeFromFrozenPayer(t *testing.T) {
+	getter := operationtest.NewMockStateGetter()
+	var tp operationtest.TestProcessor
+	tp.Setup(getter)
+
+	sender, _, senderPriv := tp.NewTestAccountState(tp.NewPrivateKey("sender-frozen-fee"), true)
+	receiver, _, _ := tp.NewTestAccountState(tp.NewPrivateKey("receiver-frozen-fee"), true)
+
+	feeCID := tp.NewTestCurrencyState("USD", tp.GenesisAddr, true)
+	tp.NewTestBalanceState(sender, tp.GenesisCurrency, 1000, true)
+	tp.NewTestBalanceState(sender, feeCID, 100, true)
+
+	setCurrencyDesign(&tp, tp.GenesisCurrency, types.NewCurrencyDesign(
+		common.ZeroBig,
+		tp.GenesisCurrency,
+		common.NewBig(9),
+		tp.GenesisAddr,
+		types.NewCurrencyPolicy(common.ZeroBig, types.NewFixedFeeer(tp.GenesisAddr, common.NewBig(10))).
+			WithFeeCurrency(types.NewFeeCurrency(feeCID, common.NewBig(1), common.NewBig(1))),
+	))
+
+	// NOTE only the fee currency of payer is frozen; the moved currency is
+	// not.
+	tp.SetState(common.NewBaseState(base.Height(1), ccstate.FrozenStateKey(sender, feeCID),
+		ccstate.NewFrozenStateValue(true), nil, []util.Hash{}), true)
+
+	op, err := currency.NewTransfer(currency.NewTransferFact(
+		[]byte("transfer-frozen-fee"),
+		sender,
+		[]currency.TransferItem{
+			currency.NewTransferItemMultiAmounts(receiver, []types.Amount{
+				types.NewAmount(common.NewBig(100), tp.GenesisCurrency),
+			}),
+		},
+		tp.GenesisCurrency,
+	))
+	if err != nil {
+		t.Fatalf("new transfer: %v", err)
+	}
+
+	if err := op.Sign(senderPriv, tp.NetworkID); err != nil {
+		t.Fatalf("sign transfer: %v", err)
+	}
+
+	switch _, reason, err := newWrappedProcessor(t, tp.GetStateFunc).Process(context.Background(), op, tp.GetStateFunc); {
+	case err != nil:
+		t.Fatalf("process transfer: %v", err)
+	case reason == nil || !strings.Contains(reason.Error(), string(common.ErrMAccountFrozen)):
+		t.Fatalf("expected fee payer frozen, not %v", reason)
+	}
+}
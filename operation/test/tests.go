@@ -387,6 +387,19 @@ func (t *TestProcessor) NewTestCurrencyState(cid string, addr base.Address, inSt
 	return currencyID
 }
 
+// NewTestCurrencyDesignState sets the currency design state of design.
+func (t *TestProcessor) NewTestCurrencyDesignState(design types.CurrencyDesign, inState bool) {
+	state := common.NewBaseState(
+		base.Height(1),
+		ccstate.DesignStateKey(design.Currency()),
+		ccstate.NewCurrencyDesignStateValue(design),
+		nil,
+		[]util.Hash{},
+	)
+
+	t.SetState(state, inState)
+}
+
 func (t *TestProcessor) NewTestSuffrageState(priv, node string, inState bool) (base.Address, base.Privatekey) {
 	privateKey, _ := base.ParseMPrivatekey(priv)
 	nodeAddr, _ := base.ParseStringAddress(node)
@@ -519,6 +532,107 @@ func (t *TestProcessor) Print(fileName string, i interface{}) {
 	return
 }
 
+// PreProcessAt runs PreProcess of the operation processor, which np creates at
+// height.
+func (t *TestProcessor) PreProcessAt(
+	np types.GetNewProcessor, height base.Height, op base.Operation,
+) (base.OperationProcessReasonError, error) {
+	opr, err := np(height, t.GetStateFunc, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = opr.Close()
+	}()
+
+	_, reason, err := opr.PreProcess(context.Background(), op, t.GetStateFunc)
+
+	return reason, err
+}
+
+// ProcessAt runs PreProcess and Process of the operation processor, which np
+// creates at height, and applies the processed states.
+func (t *TestProcessor) ProcessAt(
+	np types.GetNewProcessor, height base.Height, op base.Operation,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	opr, err := np(height, t.GetStateFunc, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer func() {
+		_ = opr.Close()
+	}()
+
+	switch _, reason, err := opr.PreProcess(context.Background(), op, t.GetStateFunc); {
+	case err != nil, reason != nil:
+		return nil, reason, err
+	}
+
+	sts, reason, err := opr.Process(context.Background(), op, t.GetStateFunc)
+	if err != nil || reason != nil {
+		return nil, reason, err
+	}
+
+	return sts, nil, t.ApplyStates(height, op.Fact().Hash(), sts)
+}
+
+// ApplyStates merges the state values into the states at height like the
+// block does; the values of same key are merged by one merger.
+func (t *TestProcessor) ApplyStates(height base.Height, op util.Hash, sts []base.StateMergeValue) error {
+	var keys []string
+	mergers := map[string]base.StateValueMerger{}
+
+	for i := range sts {
+		key := sts[i].Key()
+
+		merger, found := mergers[key]
+		if !found {
+			st, _, err := t.GetStateFunc(key)
+			if err != nil {
+				return err
+			}
+
+			merger = sts[i].Merger(height, st)
+			mergers[key] = merger
+			keys = append(keys, key)
+		}
+
+		if err := merger.Merge(sts[i].Value(), op); err != nil {
+			return err
+		}
+	}
+
+	for i := range keys {
+		st, err := mergers[keys[i]].CloseValue()
+		if err != nil {
+			return err
+		}
+
+		_ = mergers[keys[i]].Close()
+
+		t.SetState(st, true)
+	}
+
+	return nil
+}
+
+// Balance returns the balance of address in the states.
+func (t *TestProcessor) Balance(addr base.Address, cid types.CurrencyID) common.Big {
+	st, found, err := t.GetStateFunc(ccstate.BalanceStateKey(addr, cid))
+	if err != nil || !found {
+		return common.ZeroBig
+	}
+
+	am, err := ccstate.StateBalanceValue(st)
+	if err != nil {
+		return common.ZeroBig
+	}
+
+	return am.Big()
+}
+
 func (t *TestProcessor) Error() error {
 	return t.err
 }
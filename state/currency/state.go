@@ -2,6 +2,7 @@ package currency
 
 import (
 	"fmt"
+	"github.com/imfact-labs/currency-model/common"
 	"github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util"
@@ -11,15 +12,39 @@ import (
 )
 
 var (
-	AccountStateValueHint = hint.MustNewHint("account-state-value-v0.0.1")
-	BalanceStateValueHint = hint.MustNewHint("balance-state-value-v0.0.1")
-	DesignStateValueHint  = hint.MustNewHint("currency-design-state-value-v0.0.1")
+	AccountStateValueHint       = hint.MustNewHint("account-state-value-v0.0.1")
+	BalanceStateValueHint       = hint.MustNewHint("balance-state-value-v0.0.1")
+	DesignStateValueHint        = hint.MustNewHint("currency-design-state-value-v0.0.1")
+	PendingPolicyStateValueHint = hint.MustNewHint("currency-pending-policy-state-value-v0.0.1")
+	OutflowStateValueHint       = hint.MustNewHint("currency-outflow-state-value-v0.0.1")
+	FrozenStateValueHint        = hint.MustNewHint("currency-frozen-state-value-v0.0.1")
+	VestingStateValueHint       = hint.MustNewHint("currency-vesting-state-value-v0.0.1")
+	TransferLockStateValueHint  = hint.MustNewHint("currency-transfer-lock-state-value-v0.0.1")
+	ScheduleStateValueHint      = hint.MustNewHint("currency-schedule-state-value-v0.0.1")
+	ScheduleQueueStateValueHint = hint.MustNewHint("currency-schedule-queue-state-value-v0.0.1")
+	ProposalStateValueHint      = hint.MustNewHint("currency-proposal-state-value-v0.0.1")
+	GuardianStateValueHint      = hint.MustNewHint("currency-guardian-state-value-v0.0.1")
+	RecoveryStateValueHint      = hint.MustNewHint("currency-recovery-state-value-v0.0.1")
+	PreviousKeysStateValueHint  = hint.MustNewHint("currency-previous-keys-state-value-v0.0.1")
+	AllowanceStateValueHint     = hint.MustNewHint("currency-allowance-state-value-v0.0.1")
 )
 
 var (
-	AccountStateKeySuffix = ":account"
-	BalanceStateKeySuffix = ":balance"
-	DesignStateKeyPrefix  = "currencydesign:"
+	AccountStateKeySuffix       = ":account"
+	BalanceStateKeySuffix       = ":balance"
+	DesignStateKeyPrefix        = "currencydesign:"
+	PendingPolicyStateKeySuffix = ":pendingpolicy"
+	OutflowStateKeySuffix       = ":outflow"
+	FrozenStateKeySuffix        = ":frozen"
+	VestingStateKeySuffix       = ":vesting"
+	TransferLockStateKeySuffix  = ":transferlock"
+	ScheduleStateKeySuffix      = ":schedule"
+	ScheduleQueueStateKeyPrefix = "schedulequeue:"
+	ProposalStateKeySuffix      = ":proposal"
+	GuardianStateKeySuffix      = ":guardian"
+	RecoveryStateKeySuffix      = ":recovery"
+	PreviousKeysStateKeySuffix  = ":previouskeys"
+	AllowanceStateKeySuffix     = ":allowance"
 )
 
 type AccountStateValue struct {
@@ -160,6 +185,891 @@ func (b DeductBalanceStateValue) HashBytes() []byte {
 	return b.Amount.Bytes()
 }
 
+// OutflowStateValue counts the amount, which an account sent out within the
+// outflow window starting at Window.
+type OutflowStateValue struct {
+	hint.BaseHinter
+	Amount types.Amount
+	Window base.Height
+}
+
+func NewOutflowStateValue(amount types.Amount, window base.Height) OutflowStateValue {
+	return OutflowStateValue{
+		BaseHinter: hint.NewBaseHinter(OutflowStateValueHint),
+		Amount:     amount,
+		Window:     window,
+	}
+}
+
+func (o OutflowStateValue) Hint() hint.Hint {
+	return o.BaseHinter.Hint()
+}
+
+func (o OutflowStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("Invalid OutflowStateValue")
+
+	if err := o.BaseHinter.IsValid(OutflowStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, o.Amount, o.Window); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (o OutflowStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(o.Amount.Bytes(), o.Window.Bytes())
+}
+
+// AddOutflowStateValue is merged into OutflowStateValue; the counter is reset
+// when Window is different from the window of the existing counter.
+type AddOutflowStateValue struct {
+	Amount types.Amount
+	Window base.Height
+}
+
+func NewAddOutflowStateValue(amount types.Amount, window base.Height) AddOutflowStateValue {
+	return AddOutflowStateValue{
+		Amount: amount,
+		Window: window,
+	}
+}
+
+func (o AddOutflowStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("Invalid AddOutflowStateValue")
+
+	if err := util.CheckIsValiders(nil, false, o.Amount, o.Window); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (o AddOutflowStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(o.Amount.Bytes(), o.Window.Bytes())
+}
+
+// FrozenStateValue keeps whether an account is frozen in currency. The frozen
+// account can not send the currency.
+type FrozenStateValue struct {
+	hint.BaseHinter
+	Frozen bool
+}
+
+func NewFrozenStateValue(frozen bool) FrozenStateValue {
+	return FrozenStateValue{
+		BaseHinter: hint.NewBaseHinter(FrozenStateValueHint),
+		Frozen:     frozen,
+	}
+}
+
+func (f FrozenStateValue) Hint() hint.Hint {
+	return f.BaseHinter.Hint()
+}
+
+func (f FrozenStateValue) IsValid([]byte) error {
+	if err := f.BaseHinter.IsValid(FrozenStateValueHint.Type().Bytes()); err != nil {
+		return util.ErrInvalid.Errorf("Invalid FrozenStateValue, %v", err)
+	}
+
+	return nil
+}
+
+func (f FrozenStateValue) HashBytes() []byte {
+	return util.BoolToBytes(f.Frozen)
+}
+
+// VestingStateValue locks Amount until it is vested. Nothing is vested before
+// Cliff; after Cliff, Amount is released linearly from Start to End.
+// Released is the amount, which already moved to the balance.
+type VestingStateValue struct {
+	hint.BaseHinter
+	Amount   types.Amount
+	Released common.Big
+	Start    base.Height
+	Cliff    base.Height
+	End      base.Height
+}
+
+func NewVestingStateValue(
+	amount types.Amount, released common.Big, start, cliff, end base.Height,
+) VestingStateValue {
+	return VestingStateValue{
+		BaseHinter: hint.NewBaseHinter(VestingStateValueHint),
+		Amount:     amount,
+		Released:   released,
+		Start:      start,
+		Cliff:      cliff,
+		End:        end,
+	}
+}
+
+func (v VestingStateValue) Hint() hint.Hint {
+	return v.BaseHinter.Hint()
+}
+
+func (v VestingStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("Invalid VestingStateValue")
+
+	if err := v.BaseHinter.IsValid(VestingStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, v.Amount, v.Start, v.Cliff, v.End); err != nil {
+		return e.Wrap(err)
+	}
+
+	switch {
+	case !v.Released.OverNil():
+		return e.Wrap(errors.Errorf("released under zero, %v", v.Released))
+	case v.Released.Compare(v.Amount.Big()) > 0:
+		return e.Wrap(errors.Errorf("released over amount, %v > %v", v.Released, v.Amount.Big()))
+	case v.Start > v.Cliff || v.Cliff > v.End || v.Start >= v.End:
+		return e.Wrap(errors.Errorf("invalid vesting range, start=%v cliff=%v end=%v", v.Start, v.Cliff, v.End))
+	}
+
+	return nil
+}
+
+func (v VestingStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(
+		v.Amount.Bytes(), v.Released.Bytes(), v.Start.Bytes(), v.Cliff.Bytes(), v.End.Bytes())
+}
+
+// Vested returns the amount vested at the height.
+func (v VestingStateValue) Vested(height base.Height) common.Big {
+	switch {
+	case height < v.Cliff:
+		return common.ZeroBig
+	case height >= v.End:
+		return v.Amount.Big()
+	default:
+		return v.Amount.Big().
+			Mul(common.NewBig(int64(height - v.Start))).
+			Div(common.NewBig(int64(v.End - v.Start)))
+	}
+}
+
+// Releasable returns the amount, which is vested at the height, but not yet
+// released.
+func (v VestingStateValue) Releasable(height base.Height) common.Big {
+	return v.Vested(height).Sub(v.Released)
+}
+
+// Locked returns the amount, which is not yet released.
+func (v VestingStateValue) Locked() common.Big {
+	return v.Amount.Big().Sub(v.Released)
+}
+
+type TransferLockStatus string
+
+const (
+	TransferLockOpen     TransferLockStatus = "open"
+	TransferLockClaimed  TransferLockStatus = "claimed"
+	TransferLockRefunded TransferLockStatus = "refunded"
+)
+
+func (s TransferLockStatus) IsValid([]byte) error {
+	switch s {
+	case TransferLockOpen, TransferLockClaimed, TransferLockRefunded:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("unknown transfer lock status, %q", s)
+	}
+}
+
+// TransferLockStateValue holds Amount of Sender in escrow. Receiver can claim
+// it with the preimage of Hashlock before Expiry; after Expiry, Sender can
+// refund it. Preimage is kept after claim so the counterparty of swap can read
+// it.
+type TransferLockStateValue struct {
+	hint.BaseHinter
+	Sender   base.Address
+	Receiver base.Address
+	Amount   types.Amount
+	Hashlock string
+	Expiry   base.Height
+	Status   TransferLockStatus
+	Preimage string
+}
+
+func NewTransferLockStateValue(
+	sender, receiver base.Address,
+	amount types.Amount,
+	hashlock string,
+	expiry base.Height,
+	status TransferLockStatus,
+	preimage string,
+) TransferLockStateValue {
+	return TransferLockStateValue{
+		BaseHinter: hint.NewBaseHinter(TransferLockStateValueHint),
+		Sender:     sender,
+		Receiver:   receiver,
+		Amount:     amount,
+		Hashlock:   hashlock,
+		Expiry:     expiry,
+		Status:     status,
+		Preimage:   preimage,
+	}
+}
+
+func (v TransferLockStateValue) Hint() hint.Hint {
+	return v.BaseHinter.Hint()
+}
+
+func (v TransferLockStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("Invalid TransferLockStateValue")
+
+	if err := v.BaseHinter.IsValid(TransferLockStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, v.Sender, v.Receiver, v.Amount, v.Expiry, v.Status); err != nil {
+		return e.Wrap(err)
+	}
+
+	if len(v.Hashlock) < 1 {
+		return e.Wrap(errors.Errorf("empty hashlock"))
+	}
+
+	if v.Status == TransferLockClaimed && len(v.Preimage) < 1 {
+		return e.Wrap(errors.Errorf("empty preimage of claimed transfer lock"))
+	}
+
+	return nil
+}
+
+func (v TransferLockStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(
+		v.Sender.Bytes(),
+		v.Receiver.Bytes(),
+		v.Amount.Bytes(),
+		[]byte(v.Hashlock),
+		v.Expiry.Bytes(),
+		[]byte(v.Status),
+		[]byte(v.Preimage),
+	)
+}
+
+type ScheduleStatus string
+
+const (
+	ScheduleActive    ScheduleStatus = "active"
+	ScheduleCompleted ScheduleStatus = "completed"
+	ScheduleCancelled ScheduleStatus = "cancelled"
+)
+
+func (s ScheduleStatus) IsValid([]byte) error {
+	switch s {
+	case ScheduleActive, ScheduleCompleted, ScheduleCancelled:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("unknown schedule status, %q", s)
+	}
+}
+
+// ScheduleMissPolicy decides what happens to a schedule when its run can not
+// be made at the due height, for example by insufficient balance.
+type ScheduleMissPolicy string
+
+const (
+	ScheduleMissSkip   ScheduleMissPolicy = "skip"
+	ScheduleMissCancel ScheduleMissPolicy = "cancel"
+)
+
+func (p ScheduleMissPolicy) IsValid([]byte) error {
+	switch p {
+	case ScheduleMissSkip, ScheduleMissCancel:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("unknown schedule miss policy, %q", p)
+	}
+}
+
+// ScheduleStateValue is the standing order of Sender, which transfers Amount
+// to Receiver at Next height. Interval 0 means the order runs only once;
+// otherwise it runs every Interval blocks until Times periods pass, or forever
+// if Times is 0. Runs and Missed count the periods made and skipped.
+type ScheduleStateValue struct {
+	hint.BaseHinter
+	Sender         base.Address
+	Receiver       base.Address
+	Amount         types.Amount
+	Interval       uint64
+	Times          uint64
+	Next           base.Height
+	Runs           uint64
+	Missed         uint64
+	OnInsufficient ScheduleMissPolicy
+	Status         ScheduleStatus
+}
+
+func NewScheduleStateValue(
+	sender, receiver base.Address,
+	amount types.Amount,
+	interval, times uint64,
+	next base.Height,
+	runs, missed uint64,
+	onInsufficient ScheduleMissPolicy,
+	status ScheduleStatus,
+) ScheduleStateValue {
+	return ScheduleStateValue{
+		BaseHinter:     hint.NewBaseHinter(ScheduleStateValueHint),
+		Sender:         sender,
+		Receiver:       receiver,
+		Amount:         amount,
+		Interval:       interval,
+		Times:          times,
+		Next:           next,
+		Runs:           runs,
+		Missed:         missed,
+		OnInsufficient: onInsufficient,
+		Status:         status,
+	}
+}
+
+func (v ScheduleStateValue) Hint() hint.Hint {
+	return v.BaseHinter.Hint()
+}
+
+func (v ScheduleStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("Invalid ScheduleStateValue")
+
+	if err := v.BaseHinter.IsValid(ScheduleStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false, v.Sender, v.Receiver, v.Amount, v.Next, v.OnInsufficient, v.Status,
+	); err != nil {
+		return e.Wrap(err)
+	}
+
+	if v.Interval < 1 && v.Times > 1 {
+		return e.Wrap(errors.Errorf("one-shot schedule can not run %d times", v.Times))
+	}
+
+	return nil
+}
+
+func (v ScheduleStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(
+		v.Sender.Bytes(),
+		v.Receiver.Bytes(),
+		v.Amount.Bytes(),
+		util.Uint64ToBytes(v.Interval),
+		util.Uint64ToBytes(v.Times),
+		v.Next.Bytes(),
+		util.Uint64ToBytes(v.Runs),
+		util.Uint64ToBytes(v.Missed),
+		[]byte(v.OnInsufficient),
+		[]byte(v.Status),
+	)
+}
+
+// Run returns the schedule after the run of the current period is made.
+func (v ScheduleStateValue) Run() ScheduleStateValue {
+	v.Runs++
+
+	return v.next()
+}
+
+// Miss returns the schedule after the run of the current period is missed;
+// by OnInsufficient, the schedule is cancelled or moves to the next period.
+func (v ScheduleStateValue) Miss() ScheduleStateValue {
+	v.Missed++
+
+	if v.OnInsufficient == ScheduleMissCancel {
+		v.Status = ScheduleCancelled
+
+		return v
+	}
+
+	return v.next()
+}
+
+func (v ScheduleStateValue) next() ScheduleStateValue {
+	if v.Interval < 1 || (v.Times > 0 && v.Runs+v.Missed >= v.Times) {
+		v.Status = ScheduleCompleted
+
+		return v
+	}
+
+	v.Next += base.Height(v.Interval)
+
+	return v
+}
+
+// CancelScheduleStateValue is merged into ScheduleStateValue to cancel the
+// schedule; it is applied after the run of the same block.
+type CancelScheduleStateValue struct{}
+
+func NewCancelScheduleStateValue() CancelScheduleStateValue {
+	return CancelScheduleStateValue{}
+}
+
+func (CancelScheduleStateValue) IsValid([]byte) error {
+	return nil
+}
+
+func (CancelScheduleStateValue) HashBytes() []byte {
+	return []byte(ScheduleCancelled)
+}
+
+// ScheduleQueueStateValue keeps the keys of the schedules, which are due at
+// Height. The keys are sorted, so the due runs of the height are made in the
+// same order by every node.
+type ScheduleQueueStateValue struct {
+	hint.BaseHinter
+	Height base.Height
+	Keys   []string
+}
+
+func NewScheduleQueueStateValue(height base.Height, keys []string) ScheduleQueueStateValue {
+	return ScheduleQueueStateValue{
+		BaseHinter: hint.NewBaseHinter(ScheduleQueueStateValueHint),
+		Height:     height,
+		Keys:       keys,
+	}
+}
+
+func (v ScheduleQueueStateValue) Hint() hint.Hint {
+	return v.BaseHinter.Hint()
+}
+
+func (v ScheduleQueueStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("Invalid ScheduleQueueStateValue")
+
+	if err := v.BaseHinter.IsValid(ScheduleQueueStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := v.Height.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	for i := range v.Keys {
+		if !IsScheduleStateKey(v.Keys[i]) {
+			return e.Wrap(errors.Errorf("invalid schedule key, %q", v.Keys[i]))
+		}
+	}
+
+	return nil
+}
+
+func (v ScheduleQueueStateValue) HashBytes() []byte {
+	bs := make([][]byte, len(v.Keys)+1)
+	bs[0] = v.Height.Bytes()
+
+	for i := range v.Keys {
+		bs[i+1] = []byte(v.Keys[i])
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+// AddScheduleQueueStateValue is merged into ScheduleQueueStateValue to add the
+// schedule key to the queue.
+type AddScheduleQueueStateValue struct {
+	Key string
+}
+
+func NewAddScheduleQueueStateValue(key string) AddScheduleQueueStateValue {
+	return AddScheduleQueueStateValue{
+		Key: key,
+	}
+}
+
+func (a AddScheduleQueueStateValue) IsValid([]byte) error {
+	if !IsScheduleStateKey(a.Key) {
+		return util.ErrInvalid.Errorf("Invalid AddScheduleQueueStateValue; invalid schedule key, %q", a.Key)
+	}
+
+	return nil
+}
+
+func (a AddScheduleQueueStateValue) HashBytes() []byte {
+	return []byte(a.Key)
+}
+
+type ProposalStatus string
+
+const (
+	ProposalPending  ProposalStatus = "pending"
+	ProposalExecuted ProposalStatus = "executed"
+)
+
+func (s ProposalStatus) IsValid([]byte) error {
+	switch s {
+	case ProposalPending, ProposalExecuted:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("unknown proposal status, %q", s)
+	}
+}
+
+// ProposalStateValue is the operation fact proposed by one of the key holders
+// of Account; ID is the fact hash of the proposing fact. Approvals are the
+// keys of Account, which approved it; when their weights pass the threshold of
+// the account keys before Expiry, Fact is executed with the authority of
+// Account.
+type ProposalStateValue struct {
+	hint.BaseHinter
+	Account   base.Address
+	ID        string
+	Fact      base.Fact
+	Expiry    base.Height
+	Approvals []base.Publickey
+	Status    ProposalStatus
+}
+
+func NewProposalStateValue(
+	account base.Address,
+	id string,
+	fact base.Fact,
+	expiry base.Height,
+	approvals []base.Publickey,
+	status ProposalStatus,
+) ProposalStateValue {
+	return ProposalStateValue{
+		BaseHinter: hint.NewBaseHinter(ProposalStateValueHint),
+		Account:    account,
+		ID:         id,
+		Fact:       fact,
+		Expiry:     expiry,
+		Approvals:  approvals,
+		Status:     status,
+	}
+}
+
+func (v ProposalStateValue) Hint() hint.Hint {
+	return v.BaseHinter.Hint()
+}
+
+func (v ProposalStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("Invalid ProposalStateValue")
+
+	if err := v.BaseHinter.IsValid(ProposalStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, v.Account, v.Expiry, v.Status); err != nil {
+		return e.Wrap(err)
+	}
+
+	if len(v.ID) < 1 {
+		return e.Wrap(errors.Errorf("empty proposal id"))
+	}
+
+	if v.Fact == nil {
+		return e.Wrap(errors.Errorf("empty proposed fact"))
+	}
+
+	if len(v.Approvals) < 1 {
+		return e.Wrap(errors.Errorf("empty approvals"))
+	}
+
+	if util.IsDuplicatedSlice(v.Approvals, func(i base.Publickey) (bool, string) {
+		if i == nil {
+			return true, ""
+		}
+
+		return true, i.String()
+	}) {
+		return e.Wrap(errors.Errorf("duplicated approval found"))
+	}
+
+	for i := range v.Approvals {
+		if err := v.Approvals[i].IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+func (v ProposalStateValue) HashBytes() []byte {
+	bs := make([][]byte, len(v.Approvals)+5)
+	bs[0] = v.Account.Bytes()
+	bs[1] = []byte(v.ID)
+	bs[2] = v.Fact.Hash().Bytes()
+	bs[3] = v.Expiry.Bytes()
+	bs[4] = []byte(v.Status)
+
+	for i := range v.Approvals {
+		bs[i+5] = v.Approvals[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+// Approved returns true if pub already approved the proposal.
+func (v ProposalStateValue) Approved(pub base.Publickey) bool {
+	for i := range v.Approvals {
+		if v.Approvals[i].Equal(pub) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Approve returns the proposal with the new approvals.
+func (v ProposalStateValue) Approve(pubs ...base.Publickey) ProposalStateValue {
+	approvals := make([]base.Publickey, len(v.Approvals), len(v.Approvals)+len(pubs))
+	copy(approvals, v.Approvals)
+
+	v.Approvals = append(approvals, pubs...)
+
+	return v
+}
+
+// GuardianStateValue is the guardian set of Account. When the signs of
+// Guardians pass their threshold, the keys of Account can be replaced by
+// recovery after Delay blocks.
+type GuardianStateValue struct {
+	hint.BaseHinter
+	Account   base.Address
+	Guardians types.AccountKeys
+	Delay     uint64
+}
+
+func NewGuardianStateValue(account base.Address, guardians types.AccountKeys, delay uint64) GuardianStateValue {
+	return GuardianStateValue{
+		BaseHinter: hint.NewBaseHinter(GuardianStateValueHint),
+		Account:    account,
+		Guardians:  guardians,
+		Delay:      delay,
+	}
+}
+
+func (v GuardianStateValue) Hint() hint.Hint {
+	return v.BaseHinter.Hint()
+}
+
+func (v GuardianStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("Invalid GuardianStateValue")
+
+	if err := v.BaseHinter.IsValid(GuardianStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, v.Account, v.Guardians); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (v GuardianStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(
+		v.Account.Bytes(),
+		v.Guardians.Bytes(),
+		util.Uint64ToBytes(v.Delay),
+	)
+}
+
+type RecoveryStatus string
+
+const (
+	RecoveryPending   RecoveryStatus = "pending"
+	RecoveryCompleted RecoveryStatus = "completed"
+	RecoveryCancelled RecoveryStatus = "cancelled"
+)
+
+func (s RecoveryStatus) IsValid([]byte) error {
+	switch s {
+	case RecoveryPending, RecoveryCompleted, RecoveryCancelled:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("unknown recovery status, %q", s)
+	}
+}
+
+// RecoveryStateValue is the recovery of Account started by the guardians. Keys
+// replace the keys of Account from Ready height; until then the current keys
+// of Account can cancel it.
+type RecoveryStateValue struct {
+	hint.BaseHinter
+	Account base.Address
+	Keys    types.AccountKeys
+	Ready   base.Height
+	Status  RecoveryStatus
+}
+
+func NewRecoveryStateValue(
+	account base.Address, keys types.AccountKeys, ready base.Height, status RecoveryStatus,
+) RecoveryStateValue {
+	return RecoveryStateValue{
+		BaseHinter: hint.NewBaseHinter(RecoveryStateValueHint),
+		Account:    account,
+		Keys:       keys,
+		Ready:      ready,
+		Status:     status,
+	}
+}
+
+func (v RecoveryStateValue) Hint() hint.Hint {
+	return v.BaseHinter.Hint()
+}
+
+func (v RecoveryStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("Invalid RecoveryStateValue")
+
+	if err := v.BaseHinter.IsValid(RecoveryStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, v.Account, v.Keys, v.Ready, v.Status); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (v RecoveryStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(
+		v.Account.Bytes(),
+		v.Keys.Bytes(),
+		v.Ready.Bytes(),
+		[]byte(v.Status),
+	)
+}
+
+// PreviousKeysStateValue keeps the keys of Account replaced by UpdateKey. Keys
+// still pass the threshold of Account below Until height.
+type PreviousKeysStateValue struct {
+	hint.BaseHinter
+	Account base.Address
+	Keys    types.AccountKeys
+	Until   base.Height
+}
+
+func NewPreviousKeysStateValue(
+	account base.Address, keys types.AccountKeys, until base.Height,
+) PreviousKeysStateValue {
+	return PreviousKeysStateValue{
+		BaseHinter: hint.NewBaseHinter(PreviousKeysStateValueHint),
+		Account:    account,
+		Keys:       keys,
+		Until:      until,
+	}
+}
+
+func (v PreviousKeysStateValue) Hint() hint.Hint {
+	return v.BaseHinter.Hint()
+}
+
+func (v PreviousKeysStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("Invalid PreviousKeysStateValue")
+
+	if err := v.BaseHinter.IsValid(PreviousKeysStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, v.Account, v.Keys, v.Until); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (v PreviousKeysStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(
+		v.Account.Bytes(),
+		v.Keys.Bytes(),
+		v.Until.Bytes(),
+	)
+}
+
+// Active reports whether Keys are still accepted at height.
+func (v PreviousKeysStateValue) Active(height base.Height) bool {
+	return height < v.Until
+}
+
+// AllowanceStateValue is the Amount, which Spender can transfer from the
+// balance of Owner by TransferFrom. Expiry is the height, from which the
+// allowance can not be used; zero Expiry means it does not expire.
+type AllowanceStateValue struct {
+	hint.BaseHinter
+	Owner   base.Address
+	Spender base.Address
+	Amount  types.Amount
+	Expiry  base.Height
+}
+
+func NewAllowanceStateValue(
+	owner, spender base.Address, amount types.Amount, expiry base.Height,
+) AllowanceStateValue {
+	return AllowanceStateValue{
+		BaseHinter: hint.NewBaseHinter(AllowanceStateValueHint),
+		Owner:      owner,
+		Spender:    spender,
+		Amount:     amount,
+		Expiry:     expiry,
+	}
+}
+
+func (v AllowanceStateValue) Hint() hint.Hint {
+	return v.BaseHinter.Hint()
+}
+
+func (v AllowanceStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("Invalid AllowanceStateValue")
+
+	if err := v.BaseHinter.IsValid(AllowanceStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, v.Owner, v.Spender, v.Amount, v.Expiry); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (v AllowanceStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(
+		v.Owner.Bytes(),
+		v.Spender.Bytes(),
+		v.Amount.Bytes(),
+		v.Expiry.Bytes(),
+	)
+}
+
+// Expired reports whether the allowance can not be used at height.
+func (v AllowanceStateValue) Expired(height base.Height) bool {
+	return v.Expiry > 0 && height >= v.Expiry
+}
+
+// BurnTotalSupplyStateValue is merged into DesignStateValue to remove the
+// amount from the total supply of currency.
+type BurnTotalSupplyStateValue struct {
+	Amount types.Amount
+}
+
+func NewBurnTotalSupplyStateValue(amount types.Amount) BurnTotalSupplyStateValue {
+	return BurnTotalSupplyStateValue{
+		Amount: amount,
+	}
+}
+
+func (b BurnTotalSupplyStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("Invalid BurnTotalSupplyStateValue")
+
+	if err := util.CheckIsValiders(nil, false, b.Amount); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (b BurnTotalSupplyStateValue) HashBytes() []byte {
+	return b.Amount.Bytes()
+}
+
 type DesignStateValue struct {
 	hint.BaseHinter
 	Design types.CurrencyDesign
@@ -194,6 +1104,106 @@ func (c DesignStateValue) HashBytes() []byte {
 	return c.Design.Bytes()
 }
 
+// PendingPolicyStateValue keeps the currency policy, which replaces the
+// policy of currency design from the block of Height. Applied is set when the
+// policy is stored in the currency design.
+type PendingPolicyStateValue struct {
+	hint.BaseHinter
+	Policy  types.CurrencyPolicy
+	Height  base.Height
+	Applied bool
+}
+
+func NewPendingPolicyStateValue(policy types.CurrencyPolicy, height base.Height) PendingPolicyStateValue {
+	return PendingPolicyStateValue{
+		BaseHinter: hint.NewBaseHinter(PendingPolicyStateValueHint),
+		Policy:     policy,
+		Height:     height,
+	}
+}
+
+func (p PendingPolicyStateValue) Hint() hint.Hint {
+	return p.BaseHinter.Hint()
+}
+
+func (p PendingPolicyStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("Invalid PendingPolicyStateValue")
+
+	if err := p.BaseHinter.IsValid(PendingPolicyStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, p.Policy, p.Height); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (p PendingPolicyStateValue) HashBytes() []byte {
+	if !p.Applied {
+		return util.ConcatBytesSlice(p.Policy.Bytes(), p.Height.Bytes())
+	}
+
+	return util.ConcatBytesSlice(p.Policy.Bytes(), p.Height.Bytes(), util.BoolToBytes(p.Applied))
+}
+
+// ActivatePolicyStateValue is merged into DesignStateValue to replace the
+// policy of the existing currency design by the activated pending policy.
+type ActivatePolicyStateValue struct {
+	Policy types.CurrencyPolicy
+}
+
+func NewActivatePolicyStateValue(policy types.CurrencyPolicy) ActivatePolicyStateValue {
+	return ActivatePolicyStateValue{
+		Policy: policy,
+	}
+}
+
+func (a ActivatePolicyStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("Invalid ActivatePolicyStateValue")
+
+	if err := util.CheckIsValiders(nil, false, a.Policy); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (a ActivatePolicyStateValue) HashBytes() []byte {
+	return a.Policy.Bytes()
+}
+
+// ActivatePendingPolicy returns the currency design with the pending policy
+// when the pending policy is activated at height and the design is not yet
+// updated since the activation. The applied pending policy is already in the
+// design.
+func ActivatePendingPolicy(
+	design, pending base.State, height base.Height,
+) (types.CurrencyDesign, bool, error) {
+	de, err := GetDesignFromState(design)
+	if err != nil {
+		return types.CurrencyDesign{}, false, err
+	}
+
+	if pending == nil {
+		return de, false, nil
+	}
+
+	pv, ok := pending.Value().(PendingPolicyStateValue)
+	if !ok {
+		return types.CurrencyDesign{}, false, errors.Errorf("expected PendingPolicyStateValue, but %T", pending.Value())
+	}
+
+	if pv.Applied || pv.Height > height || design.Height() >= pv.Height {
+		return de, false, nil
+	}
+
+	de.SetPolicy(pv.Policy)
+
+	return de, true, nil
+}
+
 func GetDesignFromState(st base.State) (types.CurrencyDesign, error) {
 	v := st.Value()
 	if v == nil {
@@ -256,9 +1266,231 @@ func ParseBalanceStateKey(key string) (*[3]string, error) {
 }
 
 func IsDesignStateKey(key string) bool {
-	return strings.HasPrefix(key, DesignStateKeyPrefix)
+	return strings.HasPrefix(key, DesignStateKeyPrefix) && !IsPendingPolicyStateKey(key)
 }
 
 func DesignStateKey(cid types.CurrencyID) string {
 	return fmt.Sprintf("%s%s", DesignStateKeyPrefix, cid)
 }
+
+func IsPendingPolicyStateKey(key string) bool {
+	return strings.HasPrefix(key, DesignStateKeyPrefix) && strings.HasSuffix(key, PendingPolicyStateKeySuffix)
+}
+
+func PendingPolicyStateKey(cid types.CurrencyID) string {
+	return fmt.Sprintf("%s%s", DesignStateKey(cid), PendingPolicyStateKeySuffix)
+}
+
+func OutflowStateKey(a base.Address, cid types.CurrencyID) string {
+	return fmt.Sprintf("%s%s", BalanceStateKeyPrefix(a, cid), OutflowStateKeySuffix)
+}
+
+func IsOutflowStateKey(key string) bool {
+	return strings.HasSuffix(key, OutflowStateKeySuffix)
+}
+
+func FrozenStateKey(a base.Address, cid types.CurrencyID) string {
+	return fmt.Sprintf("%s%s", BalanceStateKeyPrefix(a, cid), FrozenStateKeySuffix)
+}
+
+func IsFrozenStateKey(key string) bool {
+	return strings.HasSuffix(key, FrozenStateKeySuffix)
+}
+
+func VestingStateKey(a base.Address, cid types.CurrencyID) string {
+	return fmt.Sprintf("%s%s", BalanceStateKeyPrefix(a, cid), VestingStateKeySuffix)
+}
+
+func IsVestingStateKey(key string) bool {
+	return strings.HasSuffix(key, VestingStateKeySuffix)
+}
+
+func TransferLockStateKey(sender base.Address, hashlock string) string {
+	return fmt.Sprintf("%s:%s%s", sender.String(), hashlock, TransferLockStateKeySuffix)
+}
+
+func IsTransferLockStateKey(key string) bool {
+	return strings.HasSuffix(key, TransferLockStateKeySuffix)
+}
+
+func StateTransferLockValue(st base.State) (TransferLockStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return TransferLockStateValue{}, util.ErrNotFound.Errorf("transfer lock not found in State")
+	}
+
+	a, ok := v.(TransferLockStateValue)
+	if !ok {
+		return TransferLockStateValue{}, errors.Errorf("invalid transfer lock value found, %T", v)
+	}
+
+	return a, nil
+}
+
+func ScheduleStateKey(sender base.Address, id string) string {
+	return fmt.Sprintf("%s:%s%s", sender.String(), id, ScheduleStateKeySuffix)
+}
+
+func IsScheduleStateKey(key string) bool {
+	return strings.HasSuffix(key, ScheduleStateKeySuffix)
+}
+
+func StateScheduleValue(st base.State) (ScheduleStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return ScheduleStateValue{}, util.ErrNotFound.Errorf("schedule not found in State")
+	}
+
+	a, ok := v.(ScheduleStateValue)
+	if !ok {
+		return ScheduleStateValue{}, errors.Errorf("invalid schedule value found, %T", v)
+	}
+
+	return a, nil
+}
+
+func ScheduleQueueStateKey(height base.Height) string {
+	return fmt.Sprintf("%s%d", ScheduleQueueStateKeyPrefix, height)
+}
+
+func IsScheduleQueueStateKey(key string) bool {
+	return strings.HasPrefix(key, ScheduleQueueStateKeyPrefix)
+}
+
+func StateScheduleQueueValue(st base.State) (ScheduleQueueStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return ScheduleQueueStateValue{}, util.ErrNotFound.Errorf("schedule queue not found in State")
+	}
+
+	a, ok := v.(ScheduleQueueStateValue)
+	if !ok {
+		return ScheduleQueueStateValue{}, errors.Errorf("invalid schedule queue value found, %T", v)
+	}
+
+	return a, nil
+}
+
+func ProposalStateKey(account base.Address, id string) string {
+	return fmt.Sprintf("%s:%s%s", account.String(), id, ProposalStateKeySuffix)
+}
+
+func IsProposalStateKey(key string) bool {
+	return strings.HasSuffix(key, ProposalStateKeySuffix)
+}
+
+func StateProposalValue(st base.State) (ProposalStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return ProposalStateValue{}, util.ErrNotFound.Errorf("proposal not found in State")
+	}
+
+	a, ok := v.(ProposalStateValue)
+	if !ok {
+		return ProposalStateValue{}, errors.Errorf("invalid proposal value found, %T", v)
+	}
+
+	return a, nil
+}
+
+func GuardianStateKey(account base.Address) string {
+	return fmt.Sprintf("%s%s", account.String(), GuardianStateKeySuffix)
+}
+
+func IsGuardianStateKey(key string) bool {
+	return strings.HasSuffix(key, GuardianStateKeySuffix)
+}
+
+func StateGuardianValue(st base.State) (GuardianStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return GuardianStateValue{}, util.ErrNotFound.Errorf("guardian not found in State")
+	}
+
+	a, ok := v.(GuardianStateValue)
+	if !ok {
+		return GuardianStateValue{}, errors.Errorf("invalid guardian value found, %T", v)
+	}
+
+	return a, nil
+}
+
+func RecoveryStateKey(account base.Address) string {
+	return fmt.Sprintf("%s%s", account.String(), RecoveryStateKeySuffix)
+}
+
+func IsRecoveryStateKey(key string) bool {
+	return strings.HasSuffix(key, RecoveryStateKeySuffix)
+}
+
+func StateRecoveryValue(st base.State) (RecoveryStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return RecoveryStateValue{}, util.ErrNotFound.Errorf("recovery not found in State")
+	}
+
+	a, ok := v.(RecoveryStateValue)
+	if !ok {
+		return RecoveryStateValue{}, errors.Errorf("invalid recovery value found, %T", v)
+	}
+
+	return a, nil
+}
+
+func PreviousKeysStateKey(account base.Address) string {
+	return fmt.Sprintf("%s%s", account.String(), PreviousKeysStateKeySuffix)
+}
+
+func IsPreviousKeysStateKey(key string) bool {
+	return strings.HasSuffix(key, PreviousKeysStateKeySuffix)
+}
+
+func StatePreviousKeysValue(st base.State) (PreviousKeysStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return PreviousKeysStateValue{}, util.ErrNotFound.Errorf("previous keys not found in State")
+	}
+
+	a, ok := v.(PreviousKeysStateValue)
+	if !ok {
+		return PreviousKeysStateValue{}, errors.Errorf("invalid previous keys value found, %T", v)
+	}
+
+	return a, nil
+}
+
+func AllowanceStateKey(owner, spender base.Address, cid types.CurrencyID) string {
+	return fmt.Sprintf("%s:%s:%s%s", owner.String(), spender.String(), cid.String(), AllowanceStateKeySuffix)
+}
+
+func IsAllowanceStateKey(key string) bool {
+	return strings.HasSuffix(key, AllowanceStateKeySuffix)
+}
+
+func StateAllowanceValue(st base.State) (AllowanceStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return AllowanceStateValue{}, util.ErrNotFound.Errorf("allowance not found in State")
+	}
+
+	a, ok := v.(AllowanceStateValue)
+	if !ok {
+		return AllowanceStateValue{}, errors.Errorf("invalid allowance value found, %T", v)
+	}
+
+	return a, nil
+}
+
+func StateVestingValue(st base.State) (VestingStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return VestingStateValue{}, util.ErrNotFound.Errorf("vesting not found in State")
+	}
+
+	a, ok := v.(VestingStateValue)
+	if !ok {
+		return VestingStateValue{}, errors.Errorf("invalid vesting value found, %T", v)
+	}
+
+	return a, nil
+}
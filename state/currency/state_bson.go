@@ -1,9 +1,12 @@
 package currency
 
 import (
+	"github.com/imfact-labs/currency-model/common"
 	"github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
 	"github.com/imfact-labs/mitum2/util/hint"
 	"go.mongodb.org/mongo-driver/v2/bson"
 )
@@ -122,3 +125,541 @@ func (c *DesignStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 
 	return nil
 }
+
+func (p PendingPolicyStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   p.Hint().String(),
+			"policy":  p.Policy,
+			"height":  p.Height,
+			"applied": p.Applied,
+		},
+	)
+}
+
+type PendingPolicyStateValueBSONUnmarshaler struct {
+	Hint    string   `bson:"_hint"`
+	Policy  bson.Raw `bson:"policy"`
+	Height  int64    `bson:"height"`
+	Applied bool     `bson:"applied"`
+}
+
+func (p *PendingPolicyStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("Decode PendingPolicyStateValue")
+
+	var u PendingPolicyStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	p.BaseHinter = hint.NewBaseHinter(ht)
+
+	var po types.CurrencyPolicy
+	if err := po.DecodeBSON(u.Policy, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	p.Policy = po
+	p.Height = base.Height(u.Height)
+	p.Applied = u.Applied
+
+	return nil
+}
+
+func (o OutflowStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  o.Hint().String(),
+			"amount": o.Amount,
+			"window": o.Window,
+		},
+	)
+}
+
+type OutflowStateValueBSONUnmarshaler struct {
+	Hint   string   `bson:"_hint"`
+	Amount bson.Raw `bson:"amount"`
+	Window int64    `bson:"window"`
+}
+
+func (o *OutflowStateValue) DecodeBSON(v []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("Decode OutflowStateValue")
+
+	var u OutflowStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(v, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	o.BaseHinter = hint.NewBaseHinter(ht)
+
+	var am types.Amount
+	if err := am.DecodeBSON(u.Amount, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	o.Amount = am
+	o.Window = base.Height(u.Window)
+
+	return nil
+}
+
+func (f FrozenStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  f.Hint().String(),
+			"frozen": f.Frozen,
+		},
+	)
+}
+
+type FrozenStateValueBSONUnmarshaler struct {
+	Hint   string `bson:"_hint"`
+	Frozen bool   `bson:"frozen"`
+}
+
+func (f *FrozenStateValue) DecodeBSON(v []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("Decode FrozenStateValue")
+
+	var u FrozenStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(v, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	f.BaseHinter = hint.NewBaseHinter(ht)
+	f.Frozen = u.Frozen
+
+	return nil
+}
+
+func (v VestingStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    v.Hint().String(),
+			"amount":   v.Amount,
+			"released": v.Released.String(),
+			"start":    v.Start,
+			"cliff":    v.Cliff,
+			"end":      v.End,
+		},
+	)
+}
+
+type VestingStateValueBSONUnmarshaler struct {
+	Hint     string   `bson:"_hint"`
+	Amount   bson.Raw `bson:"amount"`
+	Released string   `bson:"released"`
+	Start    int64    `bson:"start"`
+	Cliff    int64    `bson:"cliff"`
+	End      int64    `bson:"end"`
+}
+
+func (v *VestingStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("Decode VestingStateValue")
+
+	var u VestingStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	v.BaseHinter = hint.NewBaseHinter(ht)
+
+	var am types.Amount
+	if err := am.DecodeBSON(u.Amount, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	released, err := common.NewBigFromString(u.Released)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	v.Amount = am
+	v.Released = released
+	v.Start = base.Height(u.Start)
+	v.Cliff = base.Height(u.Cliff)
+	v.End = base.Height(u.End)
+
+	return nil
+}
+
+func (v TransferLockStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    v.Hint().String(),
+			"sender":   v.Sender,
+			"receiver": v.Receiver,
+			"amount":   v.Amount,
+			"hashlock": v.Hashlock,
+			"expiry":   v.Expiry,
+			"status":   v.Status,
+			"preimage": v.Preimage,
+		},
+	)
+}
+
+type TransferLockStateValueBSONUnmarshaler struct {
+	Hint     string   `bson:"_hint"`
+	Sender   string   `bson:"sender"`
+	Receiver string   `bson:"receiver"`
+	Amount   bson.Raw `bson:"amount"`
+	Hashlock string   `bson:"hashlock"`
+	Expiry   int64    `bson:"expiry"`
+	Status   string   `bson:"status"`
+	Preimage string   `bson:"preimage"`
+}
+
+func (v *TransferLockStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("Decode TransferLockStateValue")
+
+	var u TransferLockStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := v.unpack(
+		enc, ht, u.Sender, u.Receiver, u.Hashlock, base.Height(u.Expiry), u.Status, u.Preimage,
+	); err != nil {
+		return e.Wrap(err)
+	}
+
+	var am types.Amount
+	if err := am.DecodeBSON(u.Amount, enc); err != nil {
+		return e.Wrap(err)
+	}
+	v.Amount = am
+
+	return nil
+}
+
+func (v ScheduleStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":           v.Hint().String(),
+			"sender":          v.Sender,
+			"receiver":        v.Receiver,
+			"amount":          v.Amount,
+			"interval":        v.Interval,
+			"times":           v.Times,
+			"next":            v.Next,
+			"runs":            v.Runs,
+			"missed":          v.Missed,
+			"on_insufficient": v.OnInsufficient,
+			"status":          v.Status,
+		},
+	)
+}
+
+type ScheduleStateValueBSONUnmarshaler struct {
+	Hint           string   `bson:"_hint"`
+	Sender         string   `bson:"sender"`
+	Receiver       string   `bson:"receiver"`
+	Amount         bson.Raw `bson:"amount"`
+	Interval       uint64   `bson:"interval"`
+	Times          uint64   `bson:"times"`
+	Next           int64    `bson:"next"`
+	Runs           uint64   `bson:"runs"`
+	Missed         uint64   `bson:"missed"`
+	OnInsufficient string   `bson:"on_insufficient"`
+	Status         string   `bson:"status"`
+}
+
+func (v *ScheduleStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("Decode ScheduleStateValue")
+
+	var u ScheduleStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := v.unpack(
+		enc, ht, u.Sender, u.Receiver, u.Interval, u.Times, base.Height(u.Next), u.Runs, u.Missed,
+		u.OnInsufficient, u.Status,
+	); err != nil {
+		return e.Wrap(err)
+	}
+
+	var am types.Amount
+	if err := am.DecodeBSON(u.Amount, enc); err != nil {
+		return e.Wrap(err)
+	}
+	v.Amount = am
+
+	return nil
+}
+
+func (v ScheduleQueueStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  v.Hint().String(),
+			"height": v.Height,
+			"keys":   v.Keys,
+		},
+	)
+}
+
+type ScheduleQueueStateValueBSONUnmarshaler struct {
+	Hint   string   `bson:"_hint"`
+	Height int64    `bson:"height"`
+	Keys   []string `bson:"keys"`
+}
+
+func (v *ScheduleQueueStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("Decode ScheduleQueueStateValue")
+
+	var u ScheduleQueueStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	v.BaseHinter = hint.NewBaseHinter(ht)
+	v.Height = base.Height(u.Height)
+	v.Keys = u.Keys
+
+	return nil
+}
+
+func (v ProposalStateValue) MarshalBSON() ([]byte, error) {
+	approvals := make([]string, len(v.Approvals))
+	for i := range v.Approvals {
+		approvals[i] = v.Approvals[i].String()
+	}
+
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":     v.Hint().String(),
+			"account":   v.Account,
+			"id":        v.ID,
+			"fact":      v.Fact,
+			"expiry":    v.Expiry,
+			"approvals": approvals,
+			"status":    v.Status,
+		},
+	)
+}
+
+type ProposalStateValueBSONUnmarshaler struct {
+	Hint      string   `bson:"_hint"`
+	Account   string   `bson:"account"`
+	ID        string   `bson:"id"`
+	Fact      bson.Raw `bson:"fact"`
+	Expiry    int64    `bson:"expiry"`
+	Approvals []string `bson:"approvals"`
+	Status    string   `bson:"status"`
+}
+
+func (v *ProposalStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("Decode ProposalStateValue")
+
+	var u ProposalStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := v.unpack(enc, ht, u.Account, u.ID, base.Height(u.Expiry), u.Approvals, u.Status); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := encoder.Decode(enc, u.Fact, &v.Fact); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (v GuardianStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":     v.Hint().String(),
+			"account":   v.Account,
+			"guardians": v.Guardians,
+			"delay":     v.Delay,
+		},
+	)
+}
+
+type GuardianStateValueBSONUnmarshaler struct {
+	Hint      string   `bson:"_hint"`
+	Account   string   `bson:"account"`
+	Guardians bson.Raw `bson:"guardians"`
+	Delay     uint64   `bson:"delay"`
+}
+
+func (v *GuardianStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("Decode GuardianStateValue")
+
+	var u GuardianStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := v.unpack(enc, ht, u.Account, u.Guardians, u.Delay); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (v RecoveryStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   v.Hint().String(),
+			"account": v.Account,
+			"keys":    v.Keys,
+			"ready":   v.Ready,
+			"status":  v.Status,
+		},
+	)
+}
+
+type RecoveryStateValueBSONUnmarshaler struct {
+	Hint    string   `bson:"_hint"`
+	Account string   `bson:"account"`
+	Keys    bson.Raw `bson:"keys"`
+	Ready   int64    `bson:"ready"`
+	Status  string   `bson:"status"`
+}
+
+func (v *RecoveryStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("Decode RecoveryStateValue")
+
+	var u RecoveryStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := v.unpack(enc, ht, u.Account, u.Keys, base.Height(u.Ready), u.Status); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (v PreviousKeysStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   v.Hint().String(),
+			"account": v.Account,
+			"keys":    v.Keys,
+			"until":   v.Until,
+		},
+	)
+}
+
+type PreviousKeysStateValueBSONUnmarshaler struct {
+	Hint    string   `bson:"_hint"`
+	Account string   `bson:"account"`
+	Keys    bson.Raw `bson:"keys"`
+	Until   int64    `bson:"until"`
+}
+
+func (v *PreviousKeysStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("Decode PreviousKeysStateValue")
+
+	var u PreviousKeysStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := v.unpack(enc, ht, u.Account, u.Keys, base.Height(u.Until)); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (v AllowanceStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   v.Hint().String(),
+			"owner":   v.Owner,
+			"spender": v.Spender,
+			"amount":  v.Amount,
+			"expiry":  v.Expiry,
+		},
+	)
+}
+
+type AllowanceStateValueBSONUnmarshaler struct {
+	Hint    string   `bson:"_hint"`
+	Owner   string   `bson:"owner"`
+	Spender string   `bson:"spender"`
+	Amount  bson.Raw `bson:"amount"`
+	Expiry  int64    `bson:"expiry"`
+}
+
+func (v *AllowanceStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("Decode AllowanceStateValue")
+
+	var u AllowanceStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := v.unpack(enc, ht, u.Owner, u.Spender, base.Height(u.Expiry)); err != nil {
+		return e.Wrap(err)
+	}
+
+	var am types.Amount
+	if err := am.DecodeBSON(u.Amount, enc); err != nil {
+		return e.Wrap(err)
+	}
+	v.Amount = am
+
+	return nil
+}
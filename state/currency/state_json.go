@@ -2,7 +2,9 @@ package currency
 
 import (
 	"encoding/json"
+	"github.com/imfact-labs/currency-model/common"
 	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util"
 	"github.com/imfact-labs/mitum2/util/encoder"
 	"github.com/imfact-labs/mitum2/util/hint"
@@ -112,3 +114,728 @@ func (c *DesignStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
 
 	return nil
 }
+
+type PendingPolicyStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Policy  types.CurrencyPolicy `json:"policy"`
+	Height  base.Height          `json:"height"`
+	Applied bool                 `json:"applied,omitempty"`
+}
+
+func (p PendingPolicyStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(PendingPolicyStateValueJSONMarshaler{
+		BaseHinter: p.BaseHinter,
+		Policy:     p.Policy,
+		Height:     p.Height,
+		Applied:    p.Applied,
+	})
+}
+
+type PendingPolicyStateValueJSONUnmarshaler struct {
+	Policy  json.RawMessage `json:"policy"`
+	Height  base.Height     `json:"height"`
+	Applied bool            `json:"applied,omitempty"`
+}
+
+func (p *PendingPolicyStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("Decode PendingPolicyStateValue")
+
+	var u PendingPolicyStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	var po types.CurrencyPolicy
+	if err := po.DecodeJSON(u.Policy, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	p.Policy = po
+	p.Height = u.Height
+	p.Applied = u.Applied
+
+	return nil
+}
+
+type OutflowStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Amount types.Amount `json:"amount"`
+	Window base.Height  `json:"window"`
+}
+
+func (o OutflowStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OutflowStateValueJSONMarshaler{
+		BaseHinter: o.BaseHinter,
+		Amount:     o.Amount,
+		Window:     o.Window,
+	})
+}
+
+type OutflowStateValueJSONUnmarshaler struct {
+	AM     json.RawMessage `json:"amount"`
+	Window base.Height     `json:"window"`
+}
+
+func (o *OutflowStateValue) DecodeJSON(v []byte, enc encoder.Encoder) error {
+	e := util.StringError("Decode OutflowStateValue")
+
+	var u OutflowStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(v, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	var am types.Amount
+	if err := am.DecodeJSON(u.AM, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	o.Amount = am
+	o.Window = u.Window
+
+	return nil
+}
+
+type FrozenStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Frozen bool `json:"frozen"`
+}
+
+func (f FrozenStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(FrozenStateValueJSONMarshaler{
+		BaseHinter: f.BaseHinter,
+		Frozen:     f.Frozen,
+	})
+}
+
+type FrozenStateValueJSONUnmarshaler struct {
+	Hint   hint.Hint `json:"_hint"`
+	Frozen bool      `json:"frozen"`
+}
+
+func (f *FrozenStateValue) DecodeJSON(v []byte, enc encoder.Encoder) error {
+	e := util.StringError("Decode FrozenStateValue")
+
+	var u FrozenStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(v, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	f.BaseHinter = hint.NewBaseHinter(u.Hint)
+	f.Frozen = u.Frozen
+
+	return nil
+}
+
+type VestingStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Amount   types.Amount `json:"amount"`
+	Released string       `json:"released"`
+	Start    base.Height  `json:"start"`
+	Cliff    base.Height  `json:"cliff"`
+	End      base.Height  `json:"end"`
+}
+
+func (v VestingStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(VestingStateValueJSONMarshaler{
+		BaseHinter: v.BaseHinter,
+		Amount:     v.Amount,
+		Released:   v.Released.String(),
+		Start:      v.Start,
+		Cliff:      v.Cliff,
+		End:        v.End,
+	})
+}
+
+type VestingStateValueJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	AM       json.RawMessage `json:"amount"`
+	Released string          `json:"released"`
+	Start    base.Height     `json:"start"`
+	Cliff    base.Height     `json:"cliff"`
+	End      base.Height     `json:"end"`
+}
+
+func (v *VestingStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("Decode VestingStateValue")
+
+	var u VestingStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	var am types.Amount
+	if err := am.DecodeJSON(u.AM, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	released, err := common.NewBigFromString(u.Released)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	v.BaseHinter = hint.NewBaseHinter(u.Hint)
+	v.Amount = am
+	v.Released = released
+	v.Start = u.Start
+	v.Cliff = u.Cliff
+	v.End = u.End
+
+	return nil
+}
+
+type TransferLockStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Sender   base.Address       `json:"sender"`
+	Receiver base.Address       `json:"receiver"`
+	Amount   types.Amount       `json:"amount"`
+	Hashlock string             `json:"hashlock"`
+	Expiry   base.Height        `json:"expiry"`
+	Status   TransferLockStatus `json:"status"`
+	Preimage string             `json:"preimage,omitempty"`
+}
+
+func (v TransferLockStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(TransferLockStateValueJSONMarshaler{
+		BaseHinter: v.BaseHinter,
+		Sender:     v.Sender,
+		Receiver:   v.Receiver,
+		Amount:     v.Amount,
+		Hashlock:   v.Hashlock,
+		Expiry:     v.Expiry,
+		Status:     v.Status,
+		Preimage:   v.Preimage,
+	})
+}
+
+type TransferLockStateValueJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	Sender   string          `json:"sender"`
+	Receiver string          `json:"receiver"`
+	AM       json.RawMessage `json:"amount"`
+	Hashlock string          `json:"hashlock"`
+	Expiry   base.Height     `json:"expiry"`
+	Status   string          `json:"status"`
+	Preimage string          `json:"preimage"`
+}
+
+func (v *TransferLockStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("Decode TransferLockStateValue")
+
+	var u TransferLockStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := v.unpack(enc, u.Hint, u.Sender, u.Receiver, u.Hashlock, u.Expiry, u.Status, u.Preimage); err != nil {
+		return e.Wrap(err)
+	}
+
+	var am types.Amount
+	if err := am.DecodeJSON(u.AM, enc); err != nil {
+		return e.Wrap(err)
+	}
+	v.Amount = am
+
+	return nil
+}
+
+func (v *TransferLockStateValue) unpack(
+	enc encoder.Encoder, ht hint.Hint, sd, rc, hashlock string, expiry base.Height, status, preimage string,
+) error {
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+
+	receiver, err := base.DecodeAddress(rc, enc)
+	if err != nil {
+		return err
+	}
+
+	v.BaseHinter = hint.NewBaseHinter(ht)
+	v.Sender = sender
+	v.Receiver = receiver
+	v.Hashlock = hashlock
+	v.Expiry = expiry
+	v.Status = TransferLockStatus(status)
+	v.Preimage = preimage
+
+	return nil
+}
+
+type ScheduleStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Sender         base.Address       `json:"sender"`
+	Receiver       base.Address       `json:"receiver"`
+	Amount         types.Amount       `json:"amount"`
+	Interval       uint64             `json:"interval"`
+	Times          uint64             `json:"times"`
+	Next           base.Height        `json:"next"`
+	Runs           uint64             `json:"runs"`
+	Missed         uint64             `json:"missed"`
+	OnInsufficient ScheduleMissPolicy `json:"on_insufficient"`
+	Status         ScheduleStatus     `json:"status"`
+}
+
+func (v ScheduleStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ScheduleStateValueJSONMarshaler{
+		BaseHinter:     v.BaseHinter,
+		Sender:         v.Sender,
+		Receiver:       v.Receiver,
+		Amount:         v.Amount,
+		Interval:       v.Interval,
+		Times:          v.Times,
+		Next:           v.Next,
+		Runs:           v.Runs,
+		Missed:         v.Missed,
+		OnInsufficient: v.OnInsufficient,
+		Status:         v.Status,
+	})
+}
+
+type ScheduleStateValueJSONUnmarshaler struct {
+	Hint           hint.Hint       `json:"_hint"`
+	Sender         string          `json:"sender"`
+	Receiver       string          `json:"receiver"`
+	AM             json.RawMessage `json:"amount"`
+	Interval       uint64          `json:"interval"`
+	Times          uint64          `json:"times"`
+	Next           base.Height     `json:"next"`
+	Runs           uint64          `json:"runs"`
+	Missed         uint64          `json:"missed"`
+	OnInsufficient string          `json:"on_insufficient"`
+	Status         string          `json:"status"`
+}
+
+func (v *ScheduleStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("Decode ScheduleStateValue")
+
+	var u ScheduleStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := v.unpack(
+		enc, u.Hint, u.Sender, u.Receiver, u.Interval, u.Times, u.Next, u.Runs, u.Missed, u.OnInsufficient, u.Status,
+	); err != nil {
+		return e.Wrap(err)
+	}
+
+	var am types.Amount
+	if err := am.DecodeJSON(u.AM, enc); err != nil {
+		return e.Wrap(err)
+	}
+	v.Amount = am
+
+	return nil
+}
+
+func (v *ScheduleStateValue) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	sd, rc string,
+	interval, times uint64,
+	next base.Height,
+	runs, missed uint64,
+	onInsufficient, status string,
+) error {
+	sender, err := base.DecodeAddress(sd, enc)
+	if err != nil {
+		return err
+	}
+
+	receiver, err := base.DecodeAddress(rc, enc)
+	if err != nil {
+		return err
+	}
+
+	v.BaseHinter = hint.NewBaseHinter(ht)
+	v.Sender = sender
+	v.Receiver = receiver
+	v.Interval = interval
+	v.Times = times
+	v.Next = next
+	v.Runs = runs
+	v.Missed = missed
+	v.OnInsufficient = ScheduleMissPolicy(onInsufficient)
+	v.Status = ScheduleStatus(status)
+
+	return nil
+}
+
+type ScheduleQueueStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Height base.Height `json:"height"`
+	Keys   []string    `json:"keys"`
+}
+
+func (v ScheduleQueueStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ScheduleQueueStateValueJSONMarshaler{
+		BaseHinter: v.BaseHinter,
+		Height:     v.Height,
+		Keys:       v.Keys,
+	})
+}
+
+type ScheduleQueueStateValueJSONUnmarshaler struct {
+	Hint   hint.Hint   `json:"_hint"`
+	Height base.Height `json:"height"`
+	Keys   []string    `json:"keys"`
+}
+
+func (v *ScheduleQueueStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("Decode ScheduleQueueStateValue")
+
+	var u ScheduleQueueStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	v.BaseHinter = hint.NewBaseHinter(u.Hint)
+	v.Height = u.Height
+	v.Keys = u.Keys
+
+	return nil
+}
+
+type ProposalStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Account   base.Address     `json:"account"`
+	ID        string           `json:"id"`
+	Fact      base.Fact        `json:"fact"`
+	Expiry    base.Height      `json:"expiry"`
+	Approvals []base.Publickey `json:"approvals"`
+	Status    ProposalStatus   `json:"status"`
+}
+
+func (v ProposalStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ProposalStateValueJSONMarshaler{
+		BaseHinter: v.BaseHinter,
+		Account:    v.Account,
+		ID:         v.ID,
+		Fact:       v.Fact,
+		Expiry:     v.Expiry,
+		Approvals:  v.Approvals,
+		Status:     v.Status,
+	})
+}
+
+type ProposalStateValueJSONUnmarshaler struct {
+	Hint      hint.Hint       `json:"_hint"`
+	Account   string          `json:"account"`
+	ID        string          `json:"id"`
+	Fact      json.RawMessage `json:"fact"`
+	Expiry    base.Height     `json:"expiry"`
+	Approvals []string        `json:"approvals"`
+	Status    string          `json:"status"`
+}
+
+func (v *ProposalStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("Decode ProposalStateValue")
+
+	var u ProposalStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := v.unpack(enc, u.Hint, u.Account, u.ID, u.Expiry, u.Approvals, u.Status); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := encoder.Decode(enc, u.Fact, &v.Fact); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (v *ProposalStateValue) unpack(
+	enc encoder.Encoder, ht hint.Hint, ac, id string, expiry base.Height, approvals []string, status string,
+) error {
+	account, err := base.DecodeAddress(ac, enc)
+	if err != nil {
+		return err
+	}
+
+	pubs := make([]base.Publickey, len(approvals))
+	for i := range approvals {
+		pub, err := base.DecodePublickeyFromString(approvals[i], enc)
+		if err != nil {
+			return err
+		}
+
+		pubs[i] = pub
+	}
+
+	v.BaseHinter = hint.NewBaseHinter(ht)
+	v.Account = account
+	v.ID = id
+	v.Expiry = expiry
+	v.Approvals = pubs
+	v.Status = ProposalStatus(status)
+
+	return nil
+}
+
+type GuardianStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Account   base.Address      `json:"account"`
+	Guardians types.AccountKeys `json:"guardians"`
+	Delay     uint64            `json:"delay"`
+}
+
+func (v GuardianStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(GuardianStateValueJSONMarshaler{
+		BaseHinter: v.BaseHinter,
+		Account:    v.Account,
+		Guardians:  v.Guardians,
+		Delay:      v.Delay,
+	})
+}
+
+type GuardianStateValueJSONUnmarshaler struct {
+	Hint      hint.Hint       `json:"_hint"`
+	Account   string          `json:"account"`
+	Guardians json.RawMessage `json:"guardians"`
+	Delay     uint64          `json:"delay"`
+}
+
+func (v *GuardianStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("Decode GuardianStateValue")
+
+	var u GuardianStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := v.unpack(enc, u.Hint, u.Account, u.Guardians, u.Delay); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (v *GuardianStateValue) unpack(
+	enc encoder.Encoder, ht hint.Hint, ac string, bks []byte, delay uint64,
+) error {
+	account, err := base.DecodeAddress(ac, enc)
+	if err != nil {
+		return err
+	}
+
+	keys, err := decodeAccountKeys(enc, bks)
+	if err != nil {
+		return err
+	}
+
+	v.BaseHinter = hint.NewBaseHinter(ht)
+	v.Account = account
+	v.Guardians = keys
+	v.Delay = delay
+
+	return nil
+}
+
+type RecoveryStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Account base.Address      `json:"account"`
+	Keys    types.AccountKeys `json:"keys"`
+	Ready   base.Height       `json:"ready"`
+	Status  RecoveryStatus    `json:"status"`
+}
+
+func (v RecoveryStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RecoveryStateValueJSONMarshaler{
+		BaseHinter: v.BaseHinter,
+		Account:    v.Account,
+		Keys:       v.Keys,
+		Ready:      v.Ready,
+		Status:     v.Status,
+	})
+}
+
+type RecoveryStateValueJSONUnmarshaler struct {
+	Hint    hint.Hint       `json:"_hint"`
+	Account string          `json:"account"`
+	Keys    json.RawMessage `json:"keys"`
+	Ready   base.Height     `json:"ready"`
+	Status  string          `json:"status"`
+}
+
+func (v *RecoveryStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("Decode RecoveryStateValue")
+
+	var u RecoveryStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := v.unpack(enc, u.Hint, u.Account, u.Keys, u.Ready, u.Status); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (v *RecoveryStateValue) unpack(
+	enc encoder.Encoder, ht hint.Hint, ac string, bks []byte, ready base.Height, status string,
+) error {
+	account, err := base.DecodeAddress(ac, enc)
+	if err != nil {
+		return err
+	}
+
+	keys, err := decodeAccountKeys(enc, bks)
+	if err != nil {
+		return err
+	}
+
+	v.BaseHinter = hint.NewBaseHinter(ht)
+	v.Account = account
+	v.Keys = keys
+	v.Ready = ready
+	v.Status = RecoveryStatus(status)
+
+	return nil
+}
+
+type PreviousKeysStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Account base.Address      `json:"account"`
+	Keys    types.AccountKeys `json:"keys"`
+	Until   base.Height       `json:"until"`
+}
+
+func (v PreviousKeysStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(PreviousKeysStateValueJSONMarshaler{
+		BaseHinter: v.BaseHinter,
+		Account:    v.Account,
+		Keys:       v.Keys,
+		Until:      v.Until,
+	})
+}
+
+type PreviousKeysStateValueJSONUnmarshaler struct {
+	Hint    hint.Hint       `json:"_hint"`
+	Account string          `json:"account"`
+	Keys    json.RawMessage `json:"keys"`
+	Until   base.Height     `json:"until"`
+}
+
+func (v *PreviousKeysStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("Decode PreviousKeysStateValue")
+
+	var u PreviousKeysStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := v.unpack(enc, u.Hint, u.Account, u.Keys, u.Until); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (v *PreviousKeysStateValue) unpack(
+	enc encoder.Encoder, ht hint.Hint, ac string, bks []byte, until base.Height,
+) error {
+	account, err := base.DecodeAddress(ac, enc)
+	if err != nil {
+		return err
+	}
+
+	keys, err := decodeAccountKeys(enc, bks)
+	if err != nil {
+		return err
+	}
+
+	v.BaseHinter = hint.NewBaseHinter(ht)
+	v.Account = account
+	v.Keys = keys
+	v.Until = until
+
+	return nil
+}
+
+type AllowanceStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Owner   base.Address `json:"owner"`
+	Spender base.Address `json:"spender"`
+	Amount  types.Amount `json:"amount"`
+	Expiry  base.Height  `json:"expiry"`
+}
+
+func (v AllowanceStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AllowanceStateValueJSONMarshaler{
+		BaseHinter: v.BaseHinter,
+		Owner:      v.Owner,
+		Spender:    v.Spender,
+		Amount:     v.Amount,
+		Expiry:     v.Expiry,
+	})
+}
+
+type AllowanceStateValueJSONUnmarshaler struct {
+	Hint    hint.Hint       `json:"_hint"`
+	Owner   string          `json:"owner"`
+	Spender string          `json:"spender"`
+	AM      json.RawMessage `json:"amount"`
+	Expiry  base.Height     `json:"expiry"`
+}
+
+func (v *AllowanceStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("Decode AllowanceStateValue")
+
+	var u AllowanceStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := v.unpack(enc, u.Hint, u.Owner, u.Spender, u.Expiry); err != nil {
+		return e.Wrap(err)
+	}
+
+	var am types.Amount
+	if err := am.DecodeJSON(u.AM, enc); err != nil {
+		return e.Wrap(err)
+	}
+	v.Amount = am
+
+	return nil
+}
+
+func (v *AllowanceStateValue) unpack(
+	enc encoder.Encoder, ht hint.Hint, ow, sp string, expiry base.Height,
+) error {
+	owner, err := base.DecodeAddress(ow, enc)
+	if err != nil {
+		return err
+	}
+
+	spender, err := base.DecodeAddress(sp, enc)
+	if err != nil {
+		return err
+	}
+
+	v.BaseHinter = hint.NewBaseHinter(ht)
+	v.Owner = owner
+	v.Spender = spender
+	v.Expiry = expiry
+
+	return nil
+}
+
+func decodeAccountKeys(enc encoder.Encoder, b []byte) (types.AccountKeys, error) {
+	hinter, err := enc.Decode(b)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, ok := hinter.(types.AccountKeys)
+	if !ok {
+		return nil, common.ErrTypeMismatch.Errorf("expected AccountKeys, not %T", hinter)
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,226 @@
+package currency_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/imfact-labs/currency-model/app/runtime/steps"
+	"github.com/imfact-labs/currency-model/common"
+	ccstate "github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	jsonenc "github.com/imfact-labs/mitum2/util/encoder/json"
+)
+
+func newTestEncoders(t *testing.T) (*encoder.Encoders, *bsonenc.Encoder) {
+	t.Helper()
+
+	jenc := jsonenc.NewEncoder()
+	encs := encoder.NewEncoders(jenc, jenc)
+	benc := bsonenc.NewEncoder()
+
+	if err := encs.AddEncoder(benc); err != nil {
+		t.Fatalf("add bson encoder: %v", err)
+	}
+
+	if err := steps.LoadHinters(encs); err != nil {
+		t.Fatalf("load hinters: %v", err)
+	}
+
+	return encs, benc
+}
+
+// requireStateValueRoundTrip encodes v by json and bson, and checks the
+// decoded ones are same with v.
+func requireStateValueRoundTrip(t *testing.T, v base.StateValue) {
+	t.Helper()
+
+	encs, benc := newTestEncoders(t)
+
+	for name, enc := range map[string]encoder.Encoder{"json": encs.JSON(), "bson": benc} {
+		b, err := enc.Marshal(v)
+		if err != nil {
+			t.Fatalf("marshal %s: %v", name, err)
+		}
+
+		i, err := enc.Decode(b)
+		if err != nil {
+			t.Fatalf("decode %s: %v", name, err)
+		}
+
+		got, ok := i.(base.StateValue)
+		if !ok {
+			t.Fatalf("decoded %s type = %T", name, i)
+		}
+
+		if err := got.IsValid(nil); err != nil {
+			t.Fatalf("invalid decoded %s %T: %v", name, got, err)
+		}
+
+		if !bytes.Equal(v.HashBytes(), got.HashBytes()) {
+			t.Fatalf("decoded %s %T not matched, %+v != %+v", name, got, v, got)
+		}
+	}
+}
+
+func TestOutflowStateValueRoundTrip(t *testing.T) {
+	requireStateValueRoundTrip(t, ccstate.NewOutflowStateValue(
+		types.NewAmount(common.NewBig(200), types.CurrencyID("MCC")), base.Height(10)))
+}
+
+func TestTransferLockStateValueRoundTrip(t *testing.T) {
+	requireStateValueRoundTrip(t, ccstate.NewTransferLockStateValue(
+		types.NewAddress("0x52908400098527886E0F7030069857D2E4169EE7"),
+		types.NewAddress("0x8617E340B3D01FA5F11F306F4090FD50E238070D"),
+		types.NewAmount(common.NewBig(300), types.CurrencyID("MCC")),
+		"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		base.Height(20),
+		ccstate.TransferLockClaimed,
+		"74657374",
+	))
+}
+
+func TestScheduleStateValuesRoundTrip(t *testing.T) {
+	requireStateValueRoundTrip(t, ccstate.NewScheduleStateValue(
+		types.NewAddress("0x52908400098527886E0F7030069857D2E4169EE7"),
+		types.NewAddress("0x8617E340B3D01FA5F11F306F4090FD50E238070D"),
+		types.NewAmount(common.NewBig(300), types.CurrencyID("MCC")),
+		10,
+		2,
+		base.Height(30),
+		1,
+		0,
+		ccstate.ScheduleMissSkip,
+		ccstate.ScheduleActive,
+	))
+
+	requireStateValueRoundTrip(t, ccstate.NewScheduleQueueStateValue(
+		base.Height(30), []string{"0x52908400098527886E0F7030069857D2E4169EE7fca:abc:schedule"}))
+}
+
+func TestPreviousKeysStateValueRoundTrip(t *testing.T) {
+	k, err := types.NewBaseAccountKey(base.NewMPrivatekey().Publickey(), 100)
+	if err != nil {
+		t.Fatalf("new key: %v", err)
+	}
+
+	keys, err := types.NewBaseAccountKeys([]types.AccountKey{k}, 100)
+	if err != nil {
+		t.Fatalf("new keys: %v", err)
+	}
+
+	requireStateValueRoundTrip(t, ccstate.NewPreviousKeysStateValue(
+		types.NewAddress("0x52908400098527886E0F7030069857D2E4169EE7"), keys, base.Height(15)))
+}
+
+func TestPendingPolicyStateValueRoundTrip(t *testing.T) {
+	v := ccstate.NewPendingPolicyStateValue(
+		types.NewCurrencyPolicy(common.NewBig(1), types.NewFixedFeeer(
+			types.NewAddress("0x52908400098527886E0F7030069857D2E4169EE7"), common.NewBig(20))),
+		base.Height(20),
+	)
+	requireStateValueRoundTrip(t, v)
+
+	applied := v
+	applied.Applied = true
+	requireStateValueRoundTrip(t, applied)
+
+	if bytes.Equal(v.HashBytes(), applied.HashBytes()) {
+		t.Fatal("expected applied pending policy hashed differently")
+	}
+}
+
+func TestActivatePendingPolicy(t *testing.T) {
+	cid := types.CurrencyID("MCC")
+	design := types.NewCurrencyDesign(
+		common.NewBig(1000), cid, common.NewBig(9), types.NewAddress("0x52908400098527886E0F7030069857D2E4169EE7"),
+		types.NewCurrencyPolicy(common.ZeroBig, types.NewNilFeeer()),
+	)
+
+	designAt := func(height base.Height) base.State {
+		return common.NewBaseState(height, ccstate.DesignStateKey(cid),
+			ccstate.NewCurrencyDesignStateValue(design), nil, []util.Hash{})
+	}
+
+	pv := ccstate.NewPendingPolicyStateValue(types.NewCurrencyPolicy(common.NewBig(7), types.NewNilFeeer()), base.Height(20))
+	pending := common.NewBaseState(base.Height(10), ccstate.PendingPolicyStateKey(cid), pv, nil, []util.Hash{})
+
+	pv.Applied = true
+	applied := common.NewBaseState(base.Height(20), ccstate.PendingPolicyStateKey(cid), pv, nil, []util.Hash{})
+
+	cases := []struct {
+		name      string
+		design    base.State
+		pending   base.State
+		height    base.Height
+		activated bool
+	}{
+		{"no-pending", designAt(5), nil, base.Height(20), false},
+		{"before-height", designAt(5), pending, base.Height(19), false},
+		{"at-height", designAt(5), pending, base.Height(20), true},
+		{"after-height", designAt(5), pending, base.Height(30), true},
+		{"updated-after-activation", designAt(20), pending, base.Height(30), false},
+		{"applied", designAt(5), applied, base.Height(30), false},
+	}
+
+	for _, c := range cases {
+		de, activated, err := ccstate.ActivatePendingPolicy(c.design, c.pending, c.height)
+		if err != nil {
+			t.Fatalf("%s: activate pending policy: %v", c.name, err)
+		}
+
+		if activated != c.activated {
+			t.Fatalf("%s: expected activated %v, not %v", c.name, c.activated, activated)
+		}
+
+		if min := de.Policy().MinBalance(); activated != min.Equal(common.NewBig(7)) {
+			t.Fatalf("%s: unexpected policy min balance, %v", c.name, min)
+		}
+	}
+}
+
+func TestFrozenStateValueRoundTrip(t *testing.T) {
+	requireStateValueRoundTrip(t, ccstate.NewFrozenStateValue(true))
+}
+
+func TestVestingStateValueVested(t *testing.T) {
+	v := ccstate.NewVestingStateValue(
+		types.NewAmount(common.NewBig(1000), types.CurrencyID("MCC")), common.NewBig(300), 10, 20, 110)
+
+	for height, vested := range map[base.Height]int64{
+		0:   0,
+		19:  0,
+		20:  100,
+		60:  500,
+		110: 1000,
+		200: 1000,
+	} {
+		if got := v.Vested(height); !got.Equal(common.NewBig(vested)) {
+			t.Fatalf("expected vested %d at %v, not %v", vested, height, got)
+		}
+	}
+
+	if got := v.Releasable(60); !got.Equal(common.NewBig(200)) {
+		t.Fatalf("expected releasable 200 at 60, not %v", got)
+	}
+
+	if got := v.Locked(); !got.Equal(common.NewBig(700)) {
+		t.Fatalf("expected locked 700, not %v", got)
+	}
+
+	for name, invalid := range map[string]ccstate.VestingStateValue{
+		"negative-released": ccstate.NewVestingStateValue(v.Amount, common.NewBig(-1), 10, 20, 110),
+		"over-released":     ccstate.NewVestingStateValue(v.Amount, common.NewBig(1001), 10, 20, 110),
+		"cliff-after-end":   ccstate.NewVestingStateValue(v.Amount, common.ZeroBig, 10, 111, 110),
+		"empty-range":       ccstate.NewVestingStateValue(v.Amount, common.ZeroBig, 10, 10, 10),
+	} {
+		if err := invalid.IsValid(nil); err == nil {
+			t.Fatalf("%s: expected invalid vesting state value", name)
+		}
+	}
+
+	requireStateValueRoundTrip(t, v)
+}
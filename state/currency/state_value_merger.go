@@ -6,6 +6,7 @@ import (
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util"
 	"github.com/pkg/errors"
+	"sort"
 	"sync"
 )
 
@@ -86,3 +87,365 @@ func (s *BalanceStateValueMerger) closeValue() (base.StateValue, error) {
 		existingAmount,
 	), nil
 }
+
+// DesignStateValueMerger merges DesignStateValue, ActivatePolicyStateValue and
+// BurnTotalSupplyStateValue; DesignStateValue replaces the existing design and
+// the burned amounts are removed from its total supply. The activated policy
+// replaces the policy of the existing design only when the design is not
+// replaced, because the replacing design is made with the activated policy.
+type DesignStateValueMerger struct {
+	*common.BaseStateValueMerger
+	existing *DesignStateValue
+	policy   *types.CurrencyPolicy
+	replaced bool
+	burn     common.Big
+	sync.Mutex
+}
+
+func NewDesignStateValueMerger(height base.Height, key string, st base.State) *DesignStateValueMerger {
+	nst := st
+	if st == nil {
+		nst = common.NewBaseState(base.NilHeight, key, nil, nil, nil)
+	}
+
+	s := &DesignStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, nst.Key(), nst),
+	}
+
+	if nst.Value() != nil {
+		v := nst.Value().(DesignStateValue) //nolint:forcetypeassert //...
+		s.existing = &v
+	}
+	s.burn = common.ZeroBig
+
+	return s
+}
+
+func (s *DesignStateValueMerger) Merge(value base.StateValue, ops util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	switch t := value.(type) {
+	case DesignStateValue:
+		s.existing = &t
+		s.replaced = true
+	case ActivatePolicyStateValue:
+		s.policy = &t.Policy
+	case BurnTotalSupplyStateValue:
+		s.burn = s.burn.Add(t.Amount.Big())
+	default:
+		return errors.Errorf("Unsupported design state value, %T", value)
+	}
+
+	s.AddOperation(ops)
+
+	return nil
+}
+
+func (s *DesignStateValueMerger) CloseValue() (base.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	newValue, err := s.closeValue()
+	if err != nil {
+		return nil, errors.WithMessage(err, "close DesignStateValueMerger")
+	}
+
+	s.BaseStateValueMerger.SetValue(newValue)
+
+	return s.BaseStateValueMerger.CloseValue()
+}
+
+func (s *DesignStateValueMerger) closeValue() (base.StateValue, error) {
+	if s.existing == nil {
+		return nil, errors.Errorf("empty currency design")
+	}
+
+	if (s.replaced || s.policy == nil) && !s.burn.OverZero() {
+		return *s.existing, nil
+	}
+
+	de := s.existing.Design
+	if !s.replaced && s.policy != nil {
+		de.SetPolicy(*s.policy)
+	}
+
+	if s.burn.OverZero() {
+		i, err := de.BurnTotalSupply(s.burn)
+		if err != nil {
+			return nil, err
+		}
+
+		de = i
+	}
+
+	return NewCurrencyDesignStateValue(de), nil
+}
+
+// PendingPolicyStateValueMerger merges PendingPolicyStateValue; the new
+// pending policy replaces the existing one, otherwise the applied mark is set
+// to the existing one.
+type PendingPolicyStateValueMerger struct {
+	*common.BaseStateValueMerger
+	existing *PendingPolicyStateValue
+	pending  *PendingPolicyStateValue
+	applied  bool
+	sync.Mutex
+}
+
+func NewPendingPolicyStateValueMerger(height base.Height, key string, st base.State) *PendingPolicyStateValueMerger {
+	nst := st
+	if st == nil {
+		nst = common.NewBaseState(base.NilHeight, key, nil, nil, nil)
+	}
+
+	s := &PendingPolicyStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, nst.Key(), nst),
+	}
+
+	if nst.Value() != nil {
+		v := nst.Value().(PendingPolicyStateValue) //nolint:forcetypeassert //...
+		s.existing = &v
+	}
+
+	return s
+}
+
+func (s *PendingPolicyStateValueMerger) Merge(value base.StateValue, ops util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	t, ok := value.(PendingPolicyStateValue)
+	if !ok {
+		return errors.Errorf("Unsupported pending policy state value, %T", value)
+	}
+
+	switch {
+	case t.Applied:
+		s.applied = true
+	default:
+		s.pending = &t
+	}
+
+	s.AddOperation(ops)
+
+	return nil
+}
+
+func (s *PendingPolicyStateValueMerger) CloseValue() (base.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	switch {
+	case s.pending != nil:
+		s.BaseStateValueMerger.SetValue(*s.pending)
+	case s.existing == nil:
+		return nil, errors.Errorf("close PendingPolicyStateValueMerger: empty pending policy")
+	default:
+		v := *s.existing
+		v.Applied = v.Applied || s.applied
+
+		s.BaseStateValueMerger.SetValue(v)
+	}
+
+	return s.BaseStateValueMerger.CloseValue()
+}
+
+// OutflowStateValueMerger merges AddOutflowStateValue into the outflow counter
+// of the window.
+type OutflowStateValueMerger struct {
+	*common.BaseStateValueMerger
+	existing OutflowStateValue
+	add      common.Big
+	window   base.Height
+	sync.Mutex
+}
+
+func NewOutflowStateValueMerger(
+	height base.Height, key string, currency types.CurrencyID, st base.State,
+) *OutflowStateValueMerger {
+	nst := st
+	if st == nil {
+		nst = common.NewBaseState(base.NilHeight, key, nil, nil, nil)
+	}
+
+	s := &OutflowStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, nst.Key(), nst),
+	}
+
+	s.existing = NewOutflowStateValue(types.NewZeroAmount(currency), base.NilHeight)
+	if nst.Value() != nil {
+		s.existing = nst.Value().(OutflowStateValue) //nolint:forcetypeassert //...
+	}
+	s.add = common.ZeroBig
+	s.window = s.existing.Window
+
+	return s
+}
+
+func (s *OutflowStateValueMerger) Merge(value base.StateValue, ops util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	switch t := value.(type) {
+	case AddOutflowStateValue:
+		if t.Window != s.window {
+			s.window = t.Window
+			s.add = common.ZeroBig
+		}
+
+		s.add = s.add.Add(t.Amount.Big())
+	default:
+		return errors.Errorf("Unsupported outflow state value, %T", value)
+	}
+
+	s.AddOperation(ops)
+
+	return nil
+}
+
+func (s *OutflowStateValueMerger) CloseValue() (base.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	amount := s.existing.Amount.WithBig(s.add)
+	if s.window == s.existing.Window {
+		amount = s.existing.Amount.WithBig(s.existing.Amount.Big().Add(s.add))
+	}
+
+	s.BaseStateValueMerger.SetValue(NewOutflowStateValue(amount, s.window))
+
+	return s.BaseStateValueMerger.CloseValue()
+}
+
+// ScheduleQueueStateValueMerger merges AddScheduleQueueStateValue into the
+// queue of the height; the keys are kept unique and sorted.
+type ScheduleQueueStateValueMerger struct {
+	*common.BaseStateValueMerger
+	existing ScheduleQueueStateValue
+	add      []string
+	sync.Mutex
+}
+
+func NewScheduleQueueStateValueMerger(
+	height base.Height, key string, due base.Height, st base.State,
+) *ScheduleQueueStateValueMerger {
+	nst := st
+	if st == nil {
+		nst = common.NewBaseState(base.NilHeight, key, nil, nil, nil)
+	}
+
+	s := &ScheduleQueueStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, nst.Key(), nst),
+	}
+
+	s.existing = NewScheduleQueueStateValue(due, nil)
+	if nst.Value() != nil {
+		s.existing = nst.Value().(ScheduleQueueStateValue) //nolint:forcetypeassert //...
+	}
+
+	return s
+}
+
+func (s *ScheduleQueueStateValueMerger) Merge(value base.StateValue, ops util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	switch t := value.(type) {
+	case AddScheduleQueueStateValue:
+		s.add = append(s.add, t.Key)
+	default:
+		return errors.Errorf("Unsupported schedule queue state value, %T", value)
+	}
+
+	s.AddOperation(ops)
+
+	return nil
+}
+
+func (s *ScheduleQueueStateValueMerger) CloseValue() (base.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	m := map[string]struct{}{}
+	keys := make([]string, 0, len(s.existing.Keys)+len(s.add))
+
+	for _, k := range append(append([]string{}, s.existing.Keys...), s.add...) {
+		if _, found := m[k]; found {
+			continue
+		}
+
+		m[k] = struct{}{}
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	s.BaseStateValueMerger.SetValue(NewScheduleQueueStateValue(s.existing.Height, keys))
+
+	return s.BaseStateValueMerger.CloseValue()
+}
+
+// ScheduleStateValueMerger merges ScheduleStateValue and
+// CancelScheduleStateValue; the cancel is applied on the latest schedule, so
+// the run and the cancel of the same block do not overwrite each other.
+type ScheduleStateValueMerger struct {
+	*common.BaseStateValueMerger
+	existing *ScheduleStateValue
+	cancel   bool
+	sync.Mutex
+}
+
+func NewScheduleStateValueMerger(height base.Height, key string, st base.State) *ScheduleStateValueMerger {
+	nst := st
+	if st == nil {
+		nst = common.NewBaseState(base.NilHeight, key, nil, nil, nil)
+	}
+
+	s := &ScheduleStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, nst.Key(), nst),
+	}
+
+	if nst.Value() != nil {
+		v := nst.Value().(ScheduleStateValue) //nolint:forcetypeassert //...
+		s.existing = &v
+	}
+
+	return s
+}
+
+func (s *ScheduleStateValueMerger) Merge(value base.StateValue, ops util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	switch t := value.(type) {
+	case ScheduleStateValue:
+		s.existing = &t
+	case CancelScheduleStateValue:
+		s.cancel = true
+	default:
+		return errors.Errorf("Unsupported schedule state value, %T", value)
+	}
+
+	s.AddOperation(ops)
+
+	return nil
+}
+
+func (s *ScheduleStateValueMerger) CloseValue() (base.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.existing == nil {
+		return nil, errors.Errorf("close ScheduleStateValueMerger; empty schedule")
+	}
+
+	v := *s.existing
+	if s.cancel && v.Status == ScheduleActive {
+		v.Status = ScheduleCancelled
+	}
+
+	s.BaseStateValueMerger.SetValue(v)
+
+	return s.BaseStateValueMerger.CloseValue()
+}
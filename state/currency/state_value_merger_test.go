@@ -0,0 +1,168 @@
+package currency_test
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	ccstate "github.com/imfact-labs/currency-model/state/currency"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+)
+
+func TestDesignStateValueMergerBurnsTotalSupply(t *testing.T) {
+	cid := types.CurrencyID("MCC")
+	key := ccstate.DesignStateKey(cid)
+
+	st := common.NewBaseState(base.Height(9), key, ccstate.NewCurrencyDesignStateValue(types.NewCurrencyDesign(
+		common.NewBig(1000), cid, common.NewBig(9), types.NewAddress("0x52908400098527886E0F7030069857D2E4169EE7"),
+		types.NewCurrencyPolicy(common.ZeroBig, types.NewNilFeeer()),
+	)), nil, []util.Hash{})
+
+	merge := func(amounts ...int64) (base.State, error) {
+		merger := ccstate.NewDesignStateValueMerger(base.Height(10), key, st)
+
+		for _, n := range amounts {
+			if err := merger.Merge(
+				ccstate.NewBurnTotalSupplyStateValue(types.NewAmount(common.NewBig(n), cid)), valuehash.RandomSHA256(),
+			); err != nil {
+				t.Fatalf("merge: %v", err)
+			}
+		}
+
+		return merger.CloseValue()
+	}
+
+	nst, err := merge(10, 20)
+	if err != nil {
+		t.Fatalf("close value: %v", err)
+	}
+
+	de := nst.Value().(ccstate.DesignStateValue).Design
+	if !de.TotalSupply().Equal(common.NewBig(970)) || !de.Burned().Equal(common.NewBig(30)) {
+		t.Fatalf("expected total supply 970 and burned 30, not %v and %v", de.TotalSupply(), de.Burned())
+	}
+
+	if _, err := merge(600, 400); err == nil {
+		t.Fatal("expected burn of whole total supply failed")
+	}
+}
+
+func TestDesignStateValueMergerActivatesPolicy(t *testing.T) {
+	cid := types.CurrencyID("MCC")
+	key := ccstate.DesignStateKey(cid)
+
+	design := types.NewCurrencyDesign(
+		common.NewBig(1000), cid, common.NewBig(9), types.NewAddress("0x52908400098527886E0F7030069857D2E4169EE7"),
+		types.NewCurrencyPolicy(common.ZeroBig, types.NewNilFeeer()),
+	)
+	st := common.NewBaseState(base.Height(9), key, ccstate.NewCurrencyDesignStateValue(design), nil, []util.Hash{})
+
+	policy := types.NewCurrencyPolicy(common.NewBig(7), types.NewNilFeeer())
+
+	// NOTE minted design is made with the activated policy.
+	minted := design
+	minted.SetPolicy(policy)
+	minted, err := minted.AddTotalSupply(common.NewBig(100))
+	if err != nil {
+		t.Fatalf("add total supply: %v", err)
+	}
+
+	merge := func(values ...base.StateValue) types.CurrencyDesign {
+		merger := ccstate.NewDesignStateValueMerger(base.Height(10), key, st)
+
+		for i := range values {
+			if err := merger.Merge(values[i], valuehash.RandomSHA256()); err != nil {
+				t.Fatalf("merge: %v", err)
+			}
+		}
+
+		nst, err := merger.CloseValue()
+		if err != nil {
+			t.Fatalf("close value: %v", err)
+		}
+
+		return nst.Value().(ccstate.DesignStateValue).Design
+	}
+
+	activate := ccstate.NewActivatePolicyStateValue(policy)
+	burn := ccstate.NewBurnTotalSupplyStateValue(types.NewAmount(common.NewBig(10), cid))
+
+	for _, c := range []struct {
+		name   string
+		values []base.StateValue
+		supply int64
+	}{
+		{"activate", []base.StateValue{activate}, 1000},
+		{"activate-burn", []base.StateValue{activate, burn}, 990},
+		{"mint-activate", []base.StateValue{ccstate.NewCurrencyDesignStateValue(minted), activate}, 1100},
+		{"activate-mint", []base.StateValue{activate, ccstate.NewCurrencyDesignStateValue(minted)}, 1100},
+		{"activate-mint-burn", []base.StateValue{activate, ccstate.NewCurrencyDesignStateValue(minted), burn}, 1090},
+	} {
+		de := merge(c.values...)
+
+		if !de.TotalSupply().Equal(common.NewBig(c.supply)) {
+			t.Fatalf("%s: expected total supply %d, not %v", c.name, c.supply, de.TotalSupply())
+		}
+
+		if !de.Policy().MinBalance().Equal(common.NewBig(7)) {
+			t.Fatalf("%s: expected activated policy, not %v", c.name, de.Policy())
+		}
+	}
+
+	// NOTE the replacing design keeps its own policy.
+	updated := design
+	updated.SetPolicy(types.NewCurrencyPolicy(common.NewBig(3), types.NewNilFeeer()))
+
+	if de := merge(activate, ccstate.NewCurrencyDesignStateValue(updated)); !de.Policy().MinBalance().Equal(common.NewBig(3)) {
+		t.Fatalf("expected policy of replacing design, not %v", de.Policy())
+	}
+}
+
+func TestPendingPolicyStateValueMerger(t *testing.T) {
+	cid := types.CurrencyID("MCC")
+	key := ccstate.PendingPolicyStateKey(cid)
+
+	pv := ccstate.NewPendingPolicyStateValue(types.NewCurrencyPolicy(common.NewBig(7), types.NewNilFeeer()), base.Height(10))
+	st := common.NewBaseState(base.Height(5), key, pv, nil, []util.Hash{})
+
+	applied := pv
+	applied.Applied = true
+
+	next := ccstate.NewPendingPolicyStateValue(types.NewCurrencyPolicy(common.NewBig(9), types.NewNilFeeer()), base.Height(20))
+
+	merge := func(values ...base.StateValue) ccstate.PendingPolicyStateValue {
+		merger := ccstate.NewPendingPolicyStateValueMerger(base.Height(10), key, st)
+
+		for i := range values {
+			if err := merger.Merge(values[i], valuehash.RandomSHA256()); err != nil {
+				t.Fatalf("merge: %v", err)
+			}
+		}
+
+		nst, err := merger.CloseValue()
+		if err != nil {
+			t.Fatalf("close value: %v", err)
+		}
+
+		return nst.Value().(ccstate.PendingPolicyStateValue)
+	}
+
+	if v := merge(applied, applied); !v.Applied || v.Height != pv.Height {
+		t.Fatalf("expected existing pending policy applied, %+v", v)
+	}
+
+	// NOTE the new pending policy is not overwritten by the applied mark of
+	// the existing one.
+	for _, values := range [][]base.StateValue{{applied, next}, {next, applied}} {
+		if v := merge(values...); v.Applied || v.Height != next.Height {
+			t.Fatalf("expected new pending policy, %+v", v)
+		}
+	}
+
+	if err := ccstate.NewPendingPolicyStateValueMerger(base.Height(10), key, st).Merge(
+		ccstate.NewActivatePolicyStateValue(pv.Policy), valuehash.RandomSHA256()); err == nil {
+		t.Fatal("expected activated policy not merged into pending policy")
+	}
+}
@@ -11,9 +11,15 @@ import (
 	"strings"
 )
 
-var ContractAccountStateValueHint = hint.MustNewHint("contract-account-state-value-v0.0.1")
+var (
+	ContractAccountStateValueHint  = hint.MustNewHint("contract-account-state-value-v0.0.1")
+	HandlerWithdrawnStateValueHint = hint.MustNewHint("contract-account-handler-withdrawn-state-value-v0.0.1")
+)
 
-var StateKeyContractAccountSuffix = ":contractaccount"
+var (
+	StateKeyContractAccountSuffix  = ":contractaccount"
+	StateKeyHandlerWithdrawnSuffix = ":handlerwithdrawn"
+)
 
 type ContractAccountStateValue struct {
 	hint.BaseHinter
@@ -53,6 +59,68 @@ func (c ContractAccountStateValue) Status() types.ContractAccountStatus {
 	return c.status
 }
 
+// HandlerWithdrawnStateValue counts the amount, which the handler has withdrawn
+// from the contract account in total; it is checked with the withdraw limit of
+// the handler scope.
+type HandlerWithdrawnStateValue struct {
+	hint.BaseHinter
+	Amount types.Amount
+}
+
+func NewHandlerWithdrawnStateValue(amount types.Amount) HandlerWithdrawnStateValue {
+	return HandlerWithdrawnStateValue{
+		BaseHinter: hint.NewBaseHinter(HandlerWithdrawnStateValueHint),
+		Amount:     amount,
+	}
+}
+
+func (h HandlerWithdrawnStateValue) Hint() hint.Hint {
+	return h.BaseHinter.Hint()
+}
+
+func (h HandlerWithdrawnStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("Invalid HandlerWithdrawnStateValue")
+
+	if err := h.BaseHinter.IsValid(HandlerWithdrawnStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, h.Amount); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (h HandlerWithdrawnStateValue) HashBytes() []byte {
+	return h.Amount.Bytes()
+}
+
+// AddHandlerWithdrawnStateValue is merged into HandlerWithdrawnStateValue.
+type AddHandlerWithdrawnStateValue struct {
+	Amount types.Amount
+}
+
+func NewAddHandlerWithdrawnStateValue(amount types.Amount) AddHandlerWithdrawnStateValue {
+	return AddHandlerWithdrawnStateValue{
+		Amount: amount,
+	}
+}
+
+func (h AddHandlerWithdrawnStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("Invalid AddHandlerWithdrawnStateValue")
+
+	if err := util.CheckIsValiders(nil, false, h.Amount); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (h AddHandlerWithdrawnStateValue) HashBytes() []byte {
+	return h.Amount.Bytes()
+}
+
 func StateKeyContractAccount(a base.Address) string {
 	return fmt.Sprintf("%s%s", a.String(), StateKeyContractAccountSuffix)
 }
@@ -61,6 +129,29 @@ func IsStateContractAccountKey(key string) bool {
 	return strings.HasSuffix(key, StateKeyContractAccountSuffix)
 }
 
+func StateKeyHandlerWithdrawn(contract, handler base.Address, cid types.CurrencyID) string {
+	return fmt.Sprintf("%s:%s:%s%s", contract.String(), handler.String(), cid, StateKeyHandlerWithdrawnSuffix)
+}
+
+func IsStateHandlerWithdrawnKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyHandlerWithdrawnSuffix)
+}
+
+// StateHandlerWithdrawnValue returns the amount, which the handler has
+// withdrawn; zero amount if st is nil.
+func StateHandlerWithdrawnValue(st base.State, cid types.CurrencyID) (types.Amount, error) {
+	if st == nil || st.Value() == nil {
+		return types.NewZeroAmount(cid), nil
+	}
+
+	v, ok := st.Value().(HandlerWithdrawnStateValue)
+	if !ok {
+		return types.Amount{}, errors.Errorf("Invalid handler withdrawn value found, %T", st.Value())
+	}
+
+	return v.Amount, nil
+}
+
 func StateContractAccountValue(st base.State) (types.ContractAccountStatus, error) {
 	v := st.Value()
 	if v == nil {
@@ -103,3 +194,47 @@ func CheckCAAuthFromState(st base.State, addr base.Address) (*types.ContractAcco
 	}
 	return ca, nil
 }
+
+// CheckCAOperationAuthFromState checks whether the address is the owner or the
+// handler, whose scope allows the operation.
+func CheckCAOperationAuthFromState(st base.State, addr base.Address, ht hint.Hint) (*types.ContractAccountStatus, error) {
+	ca, err := LoadCAStateValue(st)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case ca.Owner().Equal(addr):
+	case !ca.IsHandler(addr):
+		return nil, common.ErrAccountNAth.Wrap(errors.Errorf("neither the owner nor the handler of the contract account, %v",
+			addr))
+	case !ca.IsHandlerAllowed(addr, ht):
+		return nil, common.ErrAccountNAth.Wrap(errors.Errorf("operation, %v is out of scope of the handler, %v",
+			ht.Type(), addr))
+	}
+
+	return ca, nil
+}
+
+// CheckDepositAllowed returns error if the address is contract account, whose
+// balance status blocks deposit.
+func CheckDepositAllowed(addr base.Address, getStateFunc base.GetStateFunc) error {
+	switch st, found, err := getStateFunc(StateKeyContractAccount(addr)); {
+	case err != nil:
+		return err
+	case !found:
+		return nil
+	default:
+		status, err := StateContractAccountValue(st)
+		if err != nil {
+			return err
+		}
+
+		if !status.BalanceStatus().AllowDeposit() {
+			return common.ErrCAccountRS.Wrap(
+				errors.Errorf("balance of contract account, %v is not allowed to deposit", addr))
+		}
+	}
+
+	return nil
+}
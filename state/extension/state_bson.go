@@ -46,3 +46,41 @@ func (c *ContractAccountStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) e
 
 	return nil
 }
+
+func (h HandlerWithdrawnStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  h.Hint().String(),
+			"amount": h.Amount,
+		},
+	)
+}
+
+type HandlerWithdrawnStateValueBSONUnmarshaler struct {
+	Hint   string   `bson:"_hint"`
+	Amount bson.Raw `bson:"amount"`
+}
+
+func (h *HandlerWithdrawnStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("Decode bson of HandlerWithdrawnStateValue")
+
+	var u HandlerWithdrawnStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	h.BaseHinter = hint.NewBaseHinter(ht)
+
+	var am types.Amount
+	if err := am.DecodeBSON(u.Amount, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	h.Amount = am
+
+	return nil
+}
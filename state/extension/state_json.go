@@ -43,3 +43,39 @@ func (c *ContractAccountStateValue) DecodeJSON(b []byte, enc encoder.Encoder) er
 
 	return nil
 }
+
+type HandlerWithdrawnStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Amount types.Amount `json:"amount"`
+}
+
+func (h HandlerWithdrawnStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(HandlerWithdrawnStateValueJSONMarshaler{
+		BaseHinter: h.BaseHinter,
+		Amount:     h.Amount,
+	})
+}
+
+type HandlerWithdrawnStateValueJSONUnmarshaler struct {
+	Hint   hint.Hint       `json:"_hint"`
+	Amount json.RawMessage `json:"amount"`
+}
+
+func (h *HandlerWithdrawnStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("Decode json of HandlerWithdrawnStateValue")
+
+	var u HandlerWithdrawnStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	h.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var am types.Amount
+	if err := am.DecodeJSON(u.Amount, enc); err != nil {
+		return e.Wrap(err)
+	}
+	h.Amount = am
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package extension_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/imfact-labs/currency-model/app/runtime/steps"
+	"github.com/imfact-labs/currency-model/common"
+	cestate "github.com/imfact-labs/currency-model/state/extension"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	jsonenc "github.com/imfact-labs/mitum2/util/encoder/json"
+	"github.com/imfact-labs/mitum2/util/valuehash"
+)
+
+func newTestEncoders(t *testing.T) (*encoder.Encoders, *bsonenc.Encoder) {
+	t.Helper()
+
+	jenc := jsonenc.NewEncoder()
+	encs := encoder.NewEncoders(jenc, jenc)
+	benc := bsonenc.NewEncoder()
+
+	if err := encs.AddEncoder(benc); err != nil {
+		t.Fatalf("add bson encoder: %v", err)
+	}
+
+	if err := steps.LoadHinters(encs); err != nil {
+		t.Fatalf("load hinters: %v", err)
+	}
+
+	return encs, benc
+}
+
+func TestHandlerWithdrawnStateValueRoundTrip(t *testing.T) {
+	v := cestate.NewHandlerWithdrawnStateValue(types.NewAmount(common.NewBig(60), types.CurrencyID("MCC")))
+
+	encs, benc := newTestEncoders(t)
+
+	for name, enc := range map[string]encoder.Encoder{"json": encs.JSON(), "bson": benc} {
+		b, err := enc.Marshal(v)
+		if err != nil {
+			t.Fatalf("marshal %s: %v", name, err)
+		}
+
+		i, err := enc.Decode(b)
+		if err != nil {
+			t.Fatalf("decode %s: %v", name, err)
+		}
+
+		got, ok := i.(cestate.HandlerWithdrawnStateValue)
+		if !ok {
+			t.Fatalf("decoded %s type = %T", name, i)
+		}
+
+		if err := got.IsValid(nil); err != nil {
+			t.Fatalf("invalid decoded %s: %v", name, err)
+		}
+
+		if !bytes.Equal(v.HashBytes(), got.HashBytes()) {
+			t.Fatalf("decoded %s not matched, %+v != %+v", name, v, got)
+		}
+	}
+}
+
+func TestHandlerWithdrawnStateValueMerger(t *testing.T) {
+	cid := types.CurrencyID("MCC")
+	key := cestate.StateKeyHandlerWithdrawn(
+		types.NewAddress("0x52908400098527886E0F7030069857D2E4169EE7"),
+		types.NewAddress("0x8617E340B3D01FA5F11F306F4090FD50E238070D"),
+		cid,
+	)
+
+	st := common.NewBaseState(base.Height(9), key,
+		cestate.NewHandlerWithdrawnStateValue(types.NewAmount(common.NewBig(60), cid)), nil, []util.Hash{})
+
+	merger := cestate.NewHandlerWithdrawnStateValueMerger(base.Height(10), key, cid, st)
+
+	for _, n := range []int64{10, 20} {
+		if err := merger.Merge(
+			cestate.NewAddHandlerWithdrawnStateValue(types.NewAmount(common.NewBig(n), cid)), valuehash.RandomSHA256(),
+		); err != nil {
+			t.Fatalf("merge: %v", err)
+		}
+	}
+
+	nst, err := merger.CloseValue()
+	if err != nil {
+		t.Fatalf("close value: %v", err)
+	}
+
+	if v := nst.Value().(cestate.HandlerWithdrawnStateValue); !v.Amount.Big().Equal(common.NewBig(90)) {
+		t.Fatalf("expected withdrawn 90, not %v", v.Amount.Big())
+	}
+}
@@ -0,0 +1,66 @@
+package extension
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+	"sync"
+)
+
+// HandlerWithdrawnStateValueMerger merges AddHandlerWithdrawnStateValue into
+// the amount withdrawn by the handler.
+type HandlerWithdrawnStateValueMerger struct {
+	*common.BaseStateValueMerger
+	existing HandlerWithdrawnStateValue
+	add      common.Big
+	sync.Mutex
+}
+
+func NewHandlerWithdrawnStateValueMerger(
+	height base.Height, key string, currency types.CurrencyID, st base.State,
+) *HandlerWithdrawnStateValueMerger {
+	nst := st
+	if st == nil {
+		nst = common.NewBaseState(base.NilHeight, key, nil, nil, nil)
+	}
+
+	s := &HandlerWithdrawnStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, nst.Key(), nst),
+	}
+
+	s.existing = NewHandlerWithdrawnStateValue(types.NewZeroAmount(currency))
+	if nst.Value() != nil {
+		s.existing = nst.Value().(HandlerWithdrawnStateValue) //nolint:forcetypeassert //...
+	}
+	s.add = common.ZeroBig
+
+	return s
+}
+
+func (s *HandlerWithdrawnStateValueMerger) Merge(value base.StateValue, ops util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	switch t := value.(type) {
+	case AddHandlerWithdrawnStateValue:
+		s.add = s.add.Add(t.Amount.Big())
+	default:
+		return errors.Errorf("Unsupported handler withdrawn state value, %T", value)
+	}
+
+	s.AddOperation(ops)
+
+	return nil
+}
+
+func (s *HandlerWithdrawnStateValueMerger) CloseValue() (base.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.BaseStateValueMerger.SetValue(NewHandlerWithdrawnStateValue(
+		s.existing.Amount.WithBig(s.existing.Amount.Big().Add(s.add))))
+
+	return s.BaseStateValueMerger.CloseValue()
+}
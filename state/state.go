@@ -115,6 +115,52 @@ func ExistsCurrencyPolicy(cid types.CurrencyID, getStateFunc base.GetStateFunc)
 	return &policy, nil
 }
 
+// CheckCurrencyNotPaused returns error if the transfers of currency are
+// paused.
+func CheckCurrencyNotPaused(cid types.CurrencyID, getStateFunc base.GetStateFunc) error {
+	switch st, found, err := getStateFunc(currency.DesignStateKey(cid)); {
+	case err != nil:
+		return err
+	case !found:
+		return common.ErrCurrencyNF.Wrap(errors.Errorf("currency id, %v", cid))
+	default:
+		cd, ok := st.Value().(currency.DesignStateValue)
+		if !ok {
+			return common.ErrTypeMismatch.Wrap(errors.Errorf("expected CurrencyDesignStateValue, not %T", st.Value()))
+		}
+
+		if cd.Design.Paused() {
+			return common.ErrCurrencyPaused.Wrap(errors.Errorf("currency id, %v", cid))
+		}
+	}
+
+	return nil
+}
+
+// CheckAccountNotFrozen returns error if the account is frozen in any of the
+// currencies.
+func CheckAccountNotFrozen(addr base.Address, cids []types.CurrencyID, getStateFunc base.GetStateFunc) error {
+	for i := range cids {
+		switch st, found, err := getStateFunc(currency.FrozenStateKey(addr, cids[i])); {
+		case err != nil:
+			return err
+		case !found:
+			continue
+		default:
+			fv, ok := st.Value().(currency.FrozenStateValue)
+			if !ok {
+				return common.ErrTypeMismatch.Wrap(errors.Errorf("expected FrozenStateValue, not %T", st.Value()))
+			}
+
+			if fv.Frozen {
+				return common.ErrAccountFrozen.Wrap(errors.Errorf("account, %v in currency, %v", addr, cids[i]))
+			}
+		}
+	}
+
+	return nil
+}
+
 func ExistsAccount(addr base.Address, name string, isExist bool, getStateFunc base.GetStateFunc) (base.State, error) {
 	var st base.State
 	var found bool
@@ -204,7 +250,21 @@ func CheckFactSignsByState(
 		return common.ErrStateValInvalid.Wrap(errors.Errorf("empty keys found"))
 	}
 
-	if err := types.CheckThreshold(fs, keys); err != nil {
+	var graces []types.AccountKeys
+
+	switch pst, found, err := getState(currency.PreviousKeysStateKey(address)); {
+	case err != nil:
+		return common.ErrStateNF.Wrap(errors.Errorf("previous keys; %v", err))
+	case found:
+		pv, err := currency.StatePreviousKeysValue(pst)
+		if err != nil {
+			return common.ErrStateValInvalid.Wrap(errors.Errorf("previous keys; %v", err))
+		}
+
+		graces = append(graces, pv.Keys)
+	}
+
+	if err := types.CheckThreshold(fs, keys, graces...); err != nil {
 		return common.ErrSignInvalid.Wrap(errors.Errorf("threshold; %v", err))
 	}
 
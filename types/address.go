@@ -17,7 +17,8 @@ var (
 )
 
 const (
-	AddressLength = 20
+	AddressLength                = 20
+	MaxContractAddressSaltLength = 64
 )
 
 type Address struct {
@@ -56,6 +57,37 @@ func NewAddressFromKeys(keys AccountKeys) (Address, error) {
 	return NewAddress(s), nil
 }
 
+// NewContractAddress derives the contract account address from owner and salt
+// in the manner of CREATE2; the address is the last 20 bytes of
+// keccak256(0xff ++ owner ++ keccak256(salt)), where owner is the bytes of the
+// owner address string. The same owner and salt always give the same address,
+// so it can be known before the contract account is created.
+func NewContractAddress(owner base.Address, salt string) (Address, error) {
+	switch {
+	case owner == nil:
+		return Address{}, errors.Errorf("nil owner address")
+	case len(salt) < 1:
+		return Address{}, errors.Errorf("empty salt")
+	case len(salt) > MaxContractAddressSaltLength:
+		return Address{}, errors.Errorf("salt length over max, %d > %d", len(salt), MaxContractAddressSaltLength)
+	}
+
+	sh := sha3.NewLegacyKeccak256()
+	sh.Write([]byte(salt))
+
+	h := sha3.NewLegacyKeccak256()
+	h.Write([]byte{0xff})
+	h.Write(owner.Bytes())
+	h.Write(sh.Sum(nil))
+
+	var buf [42]byte
+	copy(buf[:2], "0x")
+	hex.Encode(buf[2:], h.Sum(nil)[12:])
+	s := string(ChecksumHex(buf))
+
+	return NewAddress(s), nil
+}
+
 func (ad Address) IsValid([]byte) error {
 	if err := ad.BaseStringAddress.IsValid(nil); err != nil {
 		return util.ErrInvalid.Errorf("invalid mitum currency address: %v", err)
@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/imfact-labs/currency-model/types"
+)
+
+func TestNewContractAddress(t *testing.T) {
+	a, err := types.NewContractAddress(testContractOwner, "salt-a")
+	if err != nil {
+		t.Fatalf("new contract address: %v", err)
+	}
+
+	if err := a.IsValid(nil); err != nil {
+		t.Fatalf("invalid contract address: %v", err)
+	}
+
+	if b, err := types.NewContractAddress(testContractOwner, "salt-a"); err != nil || !b.Equal(a) {
+		t.Fatalf("expected same address from same owner and salt, %v, %v", b, err)
+	}
+
+	if b, _ := types.NewContractAddress(testContractOwner, "salt-b"); b.Equal(a) {
+		t.Fatalf("expected different address from different salt, %v", b)
+	}
+
+	if b, _ := types.NewContractAddress(testContractNominee, "salt-a"); b.Equal(a) {
+		t.Fatalf("expected different address from different owner, %v", b)
+	}
+
+	if _, err := types.NewContractAddress(testContractOwner, strings.Repeat("s", types.MaxContractAddressSaltLength)); err != nil {
+		t.Fatalf("expected salt of max length allowed: %v", err)
+	}
+}
+
+func TestNewContractAddressRejections(t *testing.T) {
+	for name, f := range map[string]func() (types.Address, error){
+		"nil owner": func() (types.Address, error) {
+			return types.NewContractAddress(nil, "salt-a")
+		},
+		"empty salt": func() (types.Address, error) {
+			return types.NewContractAddress(testContractOwner, "")
+		},
+		"long salt": func() (types.Address, error) {
+			return types.NewContractAddress(testContractOwner, strings.Repeat("s", types.MaxContractAddressSaltLength+1))
+		},
+	} {
+		if _, err := f(); err == nil {
+			t.Fatalf("expected %s rejected", name)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/imfact-labs/currency-model/common"
@@ -48,6 +49,7 @@ func (k VerificationMethodType) String() string {
 const (
 	AuthTypeECDSASECP = VerificationMethodType("EcdsaSecp256k1VerificationKey2019")
 	AuthTypeImFact    = VerificationMethodType("EcdsaSecp256k1VerificationKeyImFact2025")
+	AuthTypeEd25519   = VerificationMethodType("Ed25519VerificationKey2020")
 	AuthTypeLinked    = VerificationMethodType("LinkedVerificationMethod")
 )
 
@@ -242,22 +244,54 @@ func (v *VerificationMethod) SetPublicKeyMultibaseFromPublicKey(publicKey base.P
 		return fmt.Errorf("publicKey is nil")
 	}
 
-	MEPbKey, err := ParseMEPublickey(publicKey.String())
+	pbKey, err := parseVerificationPublickey(publicKey.String())
 	if err != nil {
 		return err
 	}
-	var Secp256k1PubPrefix = []byte{0xe7, 0x01}
-	compressedBytes := crypto.CompressPubkey(MEPbKey.k)
-	data := append(Secp256k1PubPrefix, compressedBytes...)
-	encoded, err := multibase.Encode(multibase.Base58BTC, data)
+
+	encoded, err := publicKeyMultibase(pbKey)
 	if err != nil {
-		return fmt.Errorf("multibase encoding failed: %w", err)
+		return err
 	}
 	v.publicKeyMultibase = encoded
 
 	return nil
 }
 
+// parseVerificationPublickey parses the public key string of verification
+// method by the type suffix of it.
+func parseVerificationPublickey(s string) (base.Publickey, error) {
+	if strings.HasSuffix(s, EdPublickeyHint.Type().String()) {
+		return ParseEdPublickey(s)
+	}
+
+	return ParseMEPublickey(s)
+}
+
+// publicKeyMultibase encodes the public key with the multicodec prefix of
+// its key type.
+func publicKeyMultibase(publicKey base.Publickey) (string, error) {
+	var data []byte
+
+	switch k := publicKey.(type) {
+	case MEPublickey:
+		var Secp256k1PubPrefix = []byte{0xe7, 0x01}
+		data = append(Secp256k1PubPrefix, crypto.CompressPubkey(k.k)...)
+	case EdPublickey:
+		var Ed25519PubPrefix = []byte{0xed, 0x01}
+		data = append(Ed25519PubPrefix, k.k...)
+	default:
+		return "", fmt.Errorf("verification method publicKey is not a MEPublickey or EdPublickey")
+	}
+
+	encoded, err := multibase.Encode(multibase.Base58BTC, data)
+	if err != nil {
+		return "", fmt.Errorf("multibase encoding failed: %w", err)
+	}
+
+	return encoded, nil
+}
+
 func (v VerificationMethod) PublicKeyMultibase() string {
 	return v.publicKeyMultibase
 }
@@ -293,22 +327,23 @@ func (v VerificationMethod) Allowed() []AllowedOperation {
 }
 
 func (v VerificationMethod) IsValid([]byte) error {
-	if v.Type() == AuthTypeECDSASECP {
+	switch v.Type() {
+	case AuthTypeECDSASECP:
 		if v.publicKeyMultibase == "" {
 			return fmt.Errorf("EcdsaSecp256k1VerificationKey2019 type must have publicKeyMultibase")
 		}
+	case AuthTypeEd25519:
+		if v.publicKeyMultibase == "" {
+			return fmt.Errorf("Ed25519VerificationKey2020 type must have publicKeyMultibase")
+		}
+		if _, ok := v.publicKey.(EdPublickey); v.publicKey != nil && !ok {
+			return fmt.Errorf("Ed25519VerificationKey2020 type must have EdPublickey")
+		}
 	}
 	if v.publicKey != nil && v.publicKeyMultibase != "" {
-		pbKey, ok := v.publicKey.(MEPublickey)
-		if !ok {
-			return fmt.Errorf("verification method publicKey is not a MEPublickey")
-		}
-		var Secp256k1PubPrefix = []byte{0xe7, 0x01}
-		compressedBytes := crypto.CompressPubkey(pbKey.k)
-		data := append(Secp256k1PubPrefix, compressedBytes...)
-		encoded, err := multibase.Encode(multibase.Base58BTC, data)
+		encoded, err := publicKeyMultibase(v.publicKey)
 		if err != nil {
-			return fmt.Errorf("multibase encoding failed: %w", err)
+			return err
 		}
 		if v.publicKeyMultibase != encoded {
 			return fmt.Errorf("verification method publicKey is not matched with publicKeyMultibase")
@@ -28,7 +28,7 @@ func (v *VerificationMethod) unpack(
 	authtype string, pubKeyJwk *JWK, pubKeyMultibase, pubKey string, tid string, allowed []AllowedOperation,
 ) error {
 	if pubKey != "" {
-		pbKey, err := ParseMEPublickey(pubKey)
+		pbKey, err := parseVerificationPublickey(pubKey)
 		if err != nil {
 			return err
 		}
@@ -47,6 +47,17 @@ func (v *VerificationMethod) unpack(
 				}
 			}
 		}
+	case "Ed25519VerificationKey2020":
+		if pubKeyMultibase == "" {
+			if pubKey == "" {
+				return errors.New("invalid Ed25519VerificationKey2020 type")
+			} else {
+				err := v.SetPublicKeyMultibaseFromPublicKey(v.publicKey)
+				if err != nil {
+					return err
+				}
+			}
+		}
 	case "EcdsaSecp256k1VerificationKeyImFact2025":
 		if pubKey == "" {
 			return errors.New("invalid EcdsaSecp256k1VerificationKeyImFact2025 type")
@@ -28,12 +28,14 @@ type BalanceStatus uint8
 const (
 	Allowed = iota
 	WithdrawalBlocked
+	DepositBlocked
+	FullyFrozen
 	MaxBalanceStatus // last value is used for read length of BalanceStatus
 )
 
 func (bs BalanceStatus) IsValid([]byte) error {
 	if uint(bs) > MaxBalanceStatus-1 {
-		return common.ErrValueInvalid.Errorf("unexpected BalanceStatus, %v", bs)
+		return common.ErrValueInvalid.Errorf("unexpected BalanceStatus, %d", bs)
 	}
 
 	return nil
@@ -43,14 +45,53 @@ func (bs BalanceStatus) Bytes() []byte {
 	return util.Uint8ToBytes(uint8(bs))
 }
 
+func (bs BalanceStatus) String() string {
+	switch bs {
+	case Allowed:
+		return "allowed"
+	case WithdrawalBlocked:
+		return "withdrawal-blocked"
+	case DepositBlocked:
+		return "deposit-blocked"
+	case FullyFrozen:
+		return "fully-frozen"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseBalanceStatus parses the name of BalanceStatus, like "deposit-blocked".
+func ParseBalanceStatus(s string) (BalanceStatus, error) {
+	for i := BalanceStatus(0); i < MaxBalanceStatus; i++ {
+		if i.String() == s {
+			return i, nil
+		}
+	}
+
+	return 0, common.ErrValueInvalid.Errorf("unknown BalanceStatus, %q", s)
+}
+
+// AllowWithdraw returns false if the amounts of contract account can not be
+// withdrawn.
+func (bs BalanceStatus) AllowWithdraw() bool {
+	return bs != WithdrawalBlocked && bs != FullyFrozen
+}
+
+// AllowDeposit returns false if contract account can not receive amounts.
+func (bs BalanceStatus) AllowDeposit() bool {
+	return bs != DepositBlocked && bs != FullyFrozen
+}
+
 type ContractAccountStatus struct {
 	hint.BaseHinter
 	owner             base.Address
+	pendingOwner      base.Address
 	isActive          bool
 	balanceStatus     BalanceStatus
 	registerOperation *hint.Hint
 	handlers          []base.Address
 	recipients        []base.Address
+	scopes            []HandlerScope
 }
 
 func NewContractAccountStatus(owner base.Address, handlers []base.Address) ContractAccountStatus {
@@ -88,6 +129,16 @@ func (cs ContractAccountStatus) Bytes() []byte {
 		h = cs.registerOperation.Bytes()
 	}
 
+	var po []byte
+	if cs.pendingOwner != nil {
+		po = cs.pendingOwner.Bytes()
+	}
+
+	scopes := make([][]byte, len(cs.scopes))
+	for i := range cs.scopes {
+		scopes[i] = cs.scopes[i].Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		cs.owner.Bytes(),
 		[]byte{byte(isActive)},
@@ -95,6 +146,8 @@ func (cs ContractAccountStatus) Bytes() []byte {
 		h,
 		util.ConcatBytesSlice(handlers...),
 		util.ConcatBytesSlice(recipients...),
+		po,
+		util.ConcatBytesSlice(scopes...),
 	)
 }
 
@@ -115,6 +168,12 @@ func (cs ContractAccountStatus) IsValid([]byte) error { // nolint:revive
 		return err
 	}
 
+	if cs.pendingOwner != nil {
+		if err := cs.pendingOwner.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
 	if len(cs.handlers) > MaxHandlers {
 		return common.ErrArrayLen.Wrap(
 			errors.Errorf(
@@ -126,6 +185,17 @@ func (cs ContractAccountStatus) IsValid([]byte) error { // nolint:revive
 				"number of recipients, %d, exceeds maximum limit, %d", len(cs.recipients), MaxRecipients))
 	}
 
+	for i := range cs.scopes {
+		if err := cs.scopes[i].IsValid(nil); err != nil {
+			return err
+		}
+
+		if !cs.IsHandler(cs.scopes[i].Handler()) {
+			return common.ErrValueInvalid.Wrap(
+				errors.Errorf("scope of %v, which is not handler", cs.scopes[i].Handler()))
+		}
+	}
+
 	return nil
 }
 
@@ -144,6 +214,25 @@ func (cs *ContractAccountStatus) SetOwner(a base.Address) error { // nolint:revi
 	return nil
 }
 
+// PendingOwner returns the owner nominated by the current owner, which becomes
+// the owner when it accepts the ownership. nil if no owner is nominated.
+func (cs ContractAccountStatus) PendingOwner() base.Address { // nolint:revive
+	return cs.pendingOwner
+}
+
+// SetPendingOwner nominates the next owner; nil clears the nomination.
+func (cs *ContractAccountStatus) SetPendingOwner(a base.Address) error { // nolint:revive
+	if a != nil {
+		if err := a.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	cs.pendingOwner = a
+
+	return nil
+}
+
 func (cs ContractAccountStatus) RegisterOperation() *hint.Hint {
 	return cs.registerOperation
 }
@@ -156,7 +245,11 @@ func (cs ContractAccountStatus) Handlers() []base.Address { // nolint:revive
 	return cs.handlers
 }
 
-func (cs *ContractAccountStatus) SetHandlers(handlers []base.Address) error {
+func (cs *ContractAccountStatus) SetHandlers(hs []base.Address) error {
+	// NOTE sort the copy; hs may be of the operation fact, which is hashed.
+	handlers := make([]base.Address, len(hs))
+	copy(handlers, hs)
+
 	sort.Slice(handlers, func(i, j int) bool {
 		return bytes.Compare(handlers[i].Bytes(), handlers[j].Bytes()) < 0
 	})
@@ -170,9 +263,76 @@ func (cs *ContractAccountStatus) SetHandlers(handlers []base.Address) error {
 
 	cs.handlers = handlers
 
+	// NOTE the scopes of the removed handlers are removed.
+	var scopes []HandlerScope
+	for i := range cs.scopes {
+		if cs.IsHandler(cs.scopes[i].Handler()) {
+			scopes = append(scopes, cs.scopes[i])
+		}
+	}
+	cs.scopes = scopes
+
 	return nil
 }
 
+func (cs ContractAccountStatus) HandlerScopes() []HandlerScope { // nolint:revive
+	return cs.scopes
+}
+
+// HandlerScope returns the scope of handler; false if the handler has no
+// scope, which means it is not restricted.
+func (cs ContractAccountStatus) HandlerScope(ad base.Address) (HandlerScope, bool) { // nolint:revive
+	for i := range cs.scopes {
+		if ad.Equal(cs.scopes[i].Handler()) {
+			return cs.scopes[i], true
+		}
+	}
+
+	return HandlerScope{}, false
+}
+
+// SetHandlerScopes replaces the scopes of handlers; the handlers of scopes
+// should be set by SetHandlers before.
+func (cs *ContractAccountStatus) SetHandlerScopes(hss []HandlerScope) error {
+	scopes := make([]HandlerScope, len(hss))
+	copy(scopes, hss)
+
+	sort.Slice(scopes, func(i, j int) bool {
+		return bytes.Compare(scopes[i].Handler().Bytes(), scopes[j].Handler().Bytes()) < 0
+	})
+
+	for i := range scopes {
+		if err := scopes[i].IsValid(nil); err != nil {
+			return err
+		}
+
+		if !cs.IsHandler(scopes[i].Handler()) {
+			return common.ErrValueInvalid.Wrap(
+				errors.Errorf("scope of %v, which is not handler", scopes[i].Handler()))
+		}
+
+		if i > 0 && scopes[i].Handler().Equal(scopes[i-1].Handler()) {
+			return common.ErrDupVal.Wrap(errors.Errorf("scope of handler, %v", scopes[i].Handler()))
+		}
+	}
+
+	cs.scopes = scopes
+
+	return nil
+}
+
+// IsHandlerAllowed checks whether the handler can send the operation within
+// its scope.
+func (cs ContractAccountStatus) IsHandlerAllowed(ad base.Address, ht hint.Hint) bool { // nolint:revive
+	if !cs.IsHandler(ad) {
+		return false
+	}
+
+	scope, found := cs.HandlerScope(ad)
+
+	return !found || scope.AllowOperation(ht)
+}
+
 func (cs ContractAccountStatus) IsHandler(ad base.Address) bool { // nolint:revive
 	for i := range cs.Handlers() {
 		if ad.Equal(cs.Handlers()[i]) {
@@ -237,6 +397,14 @@ func (cs ContractAccountStatus) Equal(b ContractAccountStatus) bool {
 		return false
 	}
 
+	switch {
+	case cs.pendingOwner == nil && b.pendingOwner == nil:
+	case cs.pendingOwner == nil || b.pendingOwner == nil:
+		return false
+	case !cs.pendingOwner.Equal(b.pendingOwner):
+		return false
+	}
+
 	for i := range cs.handlers {
 		if !cs.handlers[i].Equal(b.handlers[i]) {
 			return false
@@ -249,6 +417,16 @@ func (cs ContractAccountStatus) Equal(b ContractAccountStatus) bool {
 		}
 	}
 
+	if len(cs.scopes) != len(b.scopes) {
+		return false
+	}
+
+	for i := range cs.scopes {
+		if !cs.scopes[i].Equal(b.scopes[i]) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -12,15 +12,21 @@ func (cs ContractAccountStatus) MarshalBSON() ([]byte, error) {
 	if cs.registerOperation != nil {
 		rs = cs.registerOperation.String()
 	}
+	var po string
+	if cs.pendingOwner != nil {
+		po = cs.pendingOwner.String()
+	}
 	return bsonenc.Marshal(
 		bson.M{
 			"_hint":              cs.Hint().String(),
 			"owner":              cs.owner,
+			"pending_owner":      po,
 			"is_active":          cs.isActive,
 			"balance_status":     cs.balanceStatus,
 			"register_operation": rs,
 			"handlers":           cs.handlers,
 			"recipients":         cs.recipients,
+			"handler_scopes":     cs.scopes,
 		},
 	)
 }
@@ -28,11 +34,13 @@ func (cs ContractAccountStatus) MarshalBSON() ([]byte, error) {
 type ContractAccountBSONUnmarshaler struct {
 	Hint              string   `bson:"_hint"`
 	Owner             string   `bson:"owner"`
+	PendingOwner      string   `bson:"pending_owner"`
 	IsActive          bool     `bson:"is_active"`
 	BalanceStatus     uint8    `bson:"balance_status"`
 	RegisterOperation string   `bson:"register_operation"`
 	Handlers          []string `bson:"handlers"`
 	Recipients        []string `bson:"recipients"`
+	HandlerScopes     bson.Raw `bson:"handler_scopes"`
 }
 
 func (cs *ContractAccountStatus) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -57,5 +65,5 @@ func (cs *ContractAccountStatus) DecodeBSON(b []byte, enc *bsonenc.Encoder) erro
 		rht = &h
 	}
 
-	return cs.unpack(enc, ht, ucs.Owner, ucs.IsActive, ucs.BalanceStatus, rht, ucs.Handlers, ucs.Recipients)
+	return cs.unpack(enc, ht, ucs.Owner, ucs.PendingOwner, ucs.IsActive, ucs.BalanceStatus, rht, ucs.Handlers, ucs.Recipients, ucs.HandlerScopes)
 }
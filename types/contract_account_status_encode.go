@@ -10,11 +10,12 @@ import (
 func (cs *ContractAccountStatus) unpack(
 	enc encoder.Encoder,
 	ht hint.Hint,
-	ow string,
+	ow, po string,
 	ia bool,
 	bs uint8,
 	rht *hint.Hint,
 	hds, rcps []string,
+	bsc []byte,
 ) error {
 	cs.BaseHinter = hint.NewBaseHinter(ht)
 	cs.registerOperation = rht
@@ -26,6 +27,16 @@ func (cs *ContractAccountStatus) unpack(
 		cs.owner = a
 	}
 
+	cs.pendingOwner = nil
+	if po != "" {
+		switch a, err := base.DecodeAddress(po, enc); {
+		case err != nil:
+			return errors.Errorf("Decode pending owner address, %v", err)
+		default:
+			cs.pendingOwner = a
+		}
+	}
+
 	cs.isActive = ia
 	balanceStatus := BalanceStatus(bs)
 	if err := balanceStatus.IsValid(nil); err != nil {
@@ -55,5 +66,21 @@ func (cs *ContractAccountStatus) unpack(
 	}
 	cs.recipients = recipients
 
+	hsc, err := enc.DecodeSlice(bsc)
+	if err != nil {
+		return err
+	}
+
+	var scopes []HandlerScope
+	for i := range hsc {
+		j, ok := hsc[i].(HandlerScope)
+		if !ok {
+			return errors.Errorf("expected HandlerScope, not %T", hsc[i])
+		}
+
+		scopes = append(scopes, j)
+	}
+	cs.scopes = scopes
+
 	return nil
 }
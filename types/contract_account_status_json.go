@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/json"
+
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util"
 	"github.com/imfact-labs/mitum2/util/encoder"
@@ -10,33 +12,39 @@ import (
 type ContractAccountStatusJSONMarshaler struct {
 	hint.BaseHinter
 	Owner             base.Address   `json:"owner"`
+	PendingOwner      base.Address   `json:"pending_owner,omitempty"`
 	IsActive          bool           `json:"is_active"`
 	BalanceStatus     BalanceStatus  `json:"balance_status"`
 	RegisterOperation *hint.Hint     `json:"register_operation,omitempty"`
 	Handlers          []base.Address `json:"handlers"`
 	Recipients        []base.Address `json:"recipients"`
+	HandlerScopes     []HandlerScope `json:"handler_scopes,omitempty"`
 }
 
 func (cs ContractAccountStatus) MarshalJSON() ([]byte, error) {
 	return util.MarshalJSON(ContractAccountStatusJSONMarshaler{
 		BaseHinter:        cs.BaseHinter,
 		Owner:             cs.owner,
+		PendingOwner:      cs.pendingOwner,
 		IsActive:          cs.isActive,
 		BalanceStatus:     cs.balanceStatus,
 		RegisterOperation: cs.registerOperation,
 		Handlers:          cs.handlers,
 		Recipients:        cs.recipients,
+		HandlerScopes:     cs.scopes,
 	})
 }
 
 type ContractAccountStatusJSONUnmarshaler struct {
-	Hint              hint.Hint  `json:"_hint"`
-	Owner             string     `json:"owner"`
-	IsActive          bool       `json:"is_active"`
-	BalanceStatus     uint8      `json:"balance_status"`
-	RegisterOperation *hint.Hint `json:"register_operation"`
-	Handlers          []string   `json:"handlers"`
-	Recipients        []string   `json:"recipients"`
+	Hint              hint.Hint       `json:"_hint"`
+	Owner             string          `json:"owner"`
+	PendingOwner      string          `json:"pending_owner"`
+	IsActive          bool            `json:"is_active"`
+	BalanceStatus     uint8           `json:"balance_status"`
+	RegisterOperation *hint.Hint      `json:"register_operation"`
+	Handlers          []string        `json:"handlers"`
+	Recipients        []string        `json:"recipients"`
+	HandlerScopes     json.RawMessage `json:"handler_scopes"`
 }
 
 func (cs *ContractAccountStatus) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -47,5 +55,5 @@ func (cs *ContractAccountStatus) DecodeJSON(b []byte, enc encoder.Encoder) error
 		return e.Wrap(err)
 	}
 
-	return cs.unpack(enc, ucs.Hint, ucs.Owner, ucs.IsActive, ucs.BalanceStatus, ucs.RegisterOperation, ucs.Handlers, ucs.Recipients)
+	return cs.unpack(enc, ucs.Hint, ucs.Owner, ucs.PendingOwner, ucs.IsActive, ucs.BalanceStatus, ucs.RegisterOperation, ucs.Handlers, ucs.Recipients, ucs.HandlerScopes)
 }
@@ -0,0 +1,148 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+var (
+	testContractOwner   = types.NewAddress("0x52908400098527886E0F7030069857D2E4169EE7")
+	testContractNominee = types.NewAddress("0x8617E340B3D01FA5F11F306F4090FD50E238070D")
+)
+
+func requireSameContractAccountStatus(t *testing.T, a, b types.ContractAccountStatus) {
+	t.Helper()
+
+	if err := b.IsValid(nil); err != nil {
+		t.Fatalf("invalid decoded contract account status: %v", err)
+	}
+
+	if !a.Equal(b) || !a.Hash().Equal(b.Hash()) {
+		t.Fatalf("decoded contract account status not matched, %v != %v", a, b)
+	}
+}
+
+func TestContractAccountStatusPendingOwnerRoundTrip(t *testing.T) {
+	status := types.NewContractAccountStatus(testContractOwner, nil)
+
+	j, b := roundTrip(t, status)
+	for _, got := range []types.ContractAccountStatus{j, b} {
+		requireSameContractAccountStatus(t, status, got)
+
+		if got.PendingOwner() != nil {
+			t.Fatalf("expected no pending owner, not %v", got.PendingOwner())
+		}
+	}
+
+	if err := status.SetPendingOwner(testContractNominee); err != nil {
+		t.Fatalf("set pending owner: %v", err)
+	}
+
+	j, b = roundTrip(t, status)
+	for _, got := range []types.ContractAccountStatus{j, b} {
+		requireSameContractAccountStatus(t, status, got)
+
+		if got.PendingOwner() == nil || !got.PendingOwner().Equal(testContractNominee) {
+			t.Fatalf("expected pending owner %v, not %v", testContractNominee, got.PendingOwner())
+		}
+	}
+
+	if err := status.SetPendingOwner(types.NewAddress("0xwrong")); err == nil {
+		t.Fatal("expected invalid pending owner rejected")
+	}
+}
+
+func TestContractAccountStatusHandlerScopes(t *testing.T) {
+	status := types.NewContractAccountStatus(testContractOwner, nil)
+
+	if err := status.SetHandlerScopes([]types.HandlerScope{testHandlerScope()}); err == nil {
+		t.Fatal("expected scope of not handler rejected")
+	}
+
+	if err := status.SetHandlers([]base.Address{testHandler, testContractNominee}); err != nil {
+		t.Fatalf("set handlers: %v", err)
+	}
+
+	if err := status.SetHandlerScopes([]types.HandlerScope{testHandlerScope(), testHandlerScope()}); err == nil {
+		t.Fatal("expected duplicated scope rejected")
+	}
+
+	if err := status.SetHandlerScopes([]types.HandlerScope{testHandlerScope()}); err != nil {
+		t.Fatalf("set handler scopes: %v", err)
+	}
+
+	transfer := hint.MustNewHint("mitum-currency-transfer-operation-v0.0.1")
+	updateHandler := hint.MustNewHint("mitum-extension-update-handler-operation-v0.0.1")
+
+	switch {
+	case !status.IsHandlerAllowed(testHandler, transfer):
+		t.Fatal("expected operation in scope allowed")
+	case status.IsHandlerAllowed(testHandler, updateHandler):
+		t.Fatal("expected operation out of scope not allowed")
+	case !status.IsHandlerAllowed(testContractNominee, updateHandler):
+		t.Fatal("expected handler without scope not restricted")
+	case status.IsHandlerAllowed(testContractOwner, transfer):
+		t.Fatal("expected not handler not allowed")
+	}
+
+	j, b := roundTrip(t, status)
+	for _, got := range []types.ContractAccountStatus{j, b} {
+		requireSameContractAccountStatus(t, status, got)
+	}
+
+	// NOTE the scope of removed handler is removed.
+	if err := status.SetHandlers([]base.Address{testContractNominee}); err != nil {
+		t.Fatalf("set handlers: %v", err)
+	}
+
+	if _, found := status.HandlerScope(testHandler); found {
+		t.Fatal("expected scope of removed handler removed")
+	}
+}
+
+func TestBalanceStatus(t *testing.T) {
+	for _, c := range []struct {
+		status   types.BalanceStatus
+		deposit  bool
+		withdraw bool
+	}{
+		{types.Allowed, true, true},
+		{types.WithdrawalBlocked, true, false},
+		{types.DepositBlocked, false, true},
+		{types.FullyFrozen, false, false},
+	} {
+		if bs, err := types.ParseBalanceStatus(c.status.String()); err != nil || bs != c.status {
+			t.Fatalf("expected %v parsed, %v", c.status, err)
+		}
+
+		if c.status.AllowDeposit() != c.deposit || c.status.AllowWithdraw() != c.withdraw {
+			t.Fatalf("%v: expected deposit %v and withdraw %v", c.status, c.deposit, c.withdraw)
+		}
+	}
+
+	if _, err := types.ParseBalanceStatus("frozen"); err == nil {
+		t.Fatal("expected unknown balance status rejected")
+	}
+
+	if err := types.BalanceStatus(types.MaxBalanceStatus).IsValid(nil); err == nil {
+		t.Fatal("expected out of range balance status invalid")
+	}
+}
+
+func TestContractAccountStatusBalanceStatusRoundTrip(t *testing.T) {
+	status := types.NewContractAccountStatus(testContractOwner, nil)
+	status.SetBalanceStatus(types.FullyFrozen)
+	status.SetActive(true)
+
+	j, b := roundTrip(t, status)
+	for _, got := range []types.ContractAccountStatus{j, b} {
+		requireSameContractAccountStatus(t, status, got)
+
+		if got.BalanceStatus() != types.FullyFrozen || !got.IsActive() {
+			t.Fatalf("expected active and fully frozen, not %v, %v", got.IsActive(), got.BalanceStatus())
+		}
+	}
+}
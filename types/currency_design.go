@@ -20,6 +20,9 @@ type CurrencyDesign struct {
 	genesisAccount base.Address
 	policy         CurrencyPolicy
 	totalSupply    common.Big
+	burned         common.Big
+	maxSupply      common.Big
+	paused         bool
 }
 
 func NewCurrencyDesign(
@@ -33,6 +36,8 @@ func NewCurrencyDesign(
 		genesisAccount: genesisAccount,
 		policy:         po,
 		totalSupply:    initialSupply,
+		burned:         common.ZeroBig,
+		maxSupply:      common.ZeroBig,
 	}
 }
 
@@ -50,6 +55,14 @@ func (de CurrencyDesign) IsValid([]byte) error {
 		return util.ErrInvalid.Errorf("Currency balance should be over zero")
 	case !de.totalSupply.OverZero():
 		return util.ErrInvalid.Errorf("TotalSupply should be over zero")
+	case !de.Burned().OverNil():
+		return util.ErrInvalid.Errorf("Burned should not be under zero")
+	case de.maxSupply.Int != nil && !de.maxSupply.OverNil():
+		return util.ErrInvalid.Errorf("MaxSupply should not be under zero")
+	}
+
+	if ms, ok := de.MaxSupply(); ok && de.totalSupply.Compare(ms) > 0 {
+		return util.ErrInvalid.Errorf("TotalSupply, %v over MaxSupply, %v", de.totalSupply, ms)
 	}
 
 	if de.genesisAccount != nil {
@@ -62,6 +75,10 @@ func (de CurrencyDesign) IsValid([]byte) error {
 		return util.ErrInvalid.Errorf("Invalid CurrencyPolicy: %v", err)
 	}
 
+	if fc, ok := de.policy.FeeCurrency(); ok && fc.Currency() == de.currency {
+		return util.ErrInvalid.Errorf("Fee currency is same with currency, %v", de.currency)
+	}
+
 	return nil
 }
 
@@ -71,6 +88,21 @@ func (de CurrencyDesign) Bytes() []byte {
 		gb = de.genesisAccount.Bytes()
 	}
 
+	var bb []byte
+	if de.burned.OverZero() {
+		bb = de.burned.Bytes()
+	}
+
+	var mb []byte
+	if ms, ok := de.MaxSupply(); ok {
+		mb = ms.Bytes()
+	}
+
+	var pb []byte
+	if de.paused {
+		pb = util.BoolToBytes(de.paused)
+	}
+
 	return util.ConcatBytesSlice(
 		de.initialSupply.Bytes(),
 		de.currency.Bytes(),
@@ -78,6 +110,9 @@ func (de CurrencyDesign) Bytes() []byte {
 		gb,
 		de.policy.Bytes(),
 		de.totalSupply.Bytes(),
+		bb,
+		mb,
+		pb,
 	)
 }
 
@@ -113,12 +148,65 @@ func (de CurrencyDesign) TotalSupply() common.Big {
 	return de.totalSupply
 }
 
+// MaxSupply returns the upper bound of the total supply; false if the total
+// supply is not capped.
+func (de CurrencyDesign) MaxSupply() (common.Big, bool) {
+	if de.maxSupply.Int == nil || !de.maxSupply.OverZero() {
+		return common.ZeroBig, false
+	}
+
+	return de.maxSupply, true
+}
+
+// SetMaxSupply sets the upper bound of the total supply; zero removes the cap.
+func (de *CurrencyDesign) SetMaxSupply(b common.Big) {
+	de.maxSupply = b
+}
+
+// Paused returns true if the transfers of currency are halted.
+func (de CurrencyDesign) Paused() bool {
+	return de.paused
+}
+
+func (de *CurrencyDesign) SetPaused(paused bool) {
+	de.paused = paused
+}
+
 func (de CurrencyDesign) AddTotalSupply(b common.Big) (CurrencyDesign, error) {
 	if !b.OverZero() {
 		return de, errors.Errorf("amount to add to total supply must be greater than zero")
 	}
 
+	if ms, ok := de.MaxSupply(); ok && de.totalSupply.Add(b).Compare(ms) > 0 {
+		return de, errors.Errorf("total supply, %v with amount, %v over max supply, %v", de.totalSupply, b, ms)
+	}
+
 	de.totalSupply = de.totalSupply.Add(b)
 
 	return de, nil
 }
+
+// Burned returns the cumulative amount removed from the total supply by fee
+// burning and Burn operations.
+func (de CurrencyDesign) Burned() common.Big {
+	if de.burned.Int == nil {
+		return common.ZeroBig
+	}
+
+	return de.burned
+}
+
+func (de CurrencyDesign) BurnTotalSupply(b common.Big) (CurrencyDesign, error) {
+	if !b.OverZero() {
+		return de, errors.Errorf("amount to burn from total supply must be greater than zero")
+	}
+
+	if de.totalSupply.Compare(b) <= 0 {
+		return de, errors.Errorf("amount to burn, %v over total supply, %v", b, de.totalSupply)
+	}
+
+	de.totalSupply = de.totalSupply.Sub(b)
+	de.burned = de.Burned().Add(b)
+
+	return de, nil
+}
@@ -18,6 +18,9 @@ func (de CurrencyDesign) MarshalBSON() ([]byte, error) {
 			"genesis_account": de.genesisAccount,
 			"policy":          de.policy,
 			"total_supply":    de.totalSupply.String(),
+			"burned":          de.Burned().String(),
+			"max_supply":      maxSupplyString(de),
+			"paused":          de.paused,
 		},
 	)
 }
@@ -30,6 +33,9 @@ type CurrencyDesignBSONUnmarshaler struct {
 	Genesis       string   `bson:"genesis_account"`
 	Policy        bson.Raw `bson:"policy"`
 	TotalSupply   string   `bson:"total_supply"`
+	Burned        string   `bson:"burned,omitempty"`
+	MaxSupply     string   `bson:"max_supply,omitempty"`
+	Paused        bool     `bson:"paused,omitempty"`
 }
 
 func (de *CurrencyDesign) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -45,7 +51,7 @@ func (de *CurrencyDesign) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	err = de.unpack(enc, ht, ude.InitialSupply, ude.Currency, ude.Decimal, ude.Genesis, ude.Policy, ude.TotalSupply)
+	err = de.unpack(enc, ht, ude.InitialSupply, ude.Currency, ude.Decimal, ude.Genesis, ude.Policy, ude.TotalSupply, ude.Burned, ude.MaxSupply, ude.Paused)
 	if err != nil {
 		return e.Wrap(err)
 	}
@@ -8,7 +8,9 @@ import (
 	"github.com/pkg/errors"
 )
 
-func (de *CurrencyDesign) unpack(enc encoder.Encoder, ht hint.Hint, isp, cr, dc, ga string, bpo []byte, ts string) error {
+func (de *CurrencyDesign) unpack(
+	enc encoder.Encoder, ht hint.Hint, isp, cr, dc, ga string, bpo []byte, ts, bd, ms string, paused bool,
+) error {
 	de.BaseHinter = hint.NewBaseHinter(ht)
 
 	if initialSupply, err := common.NewBigFromString(isp); err != nil {
@@ -50,5 +52,33 @@ func (de *CurrencyDesign) unpack(enc encoder.Encoder, ht hint.Hint, isp, cr, dc,
 		de.totalSupply = big
 	}
 
+	de.burned = common.ZeroBig
+	if len(bd) > 0 {
+		if big, err := common.NewBigFromString(bd); err != nil {
+			return err
+		} else {
+			de.burned = big
+		}
+	}
+
+	de.maxSupply = common.ZeroBig
+	if len(ms) > 0 {
+		if big, err := common.NewBigFromString(ms); err != nil {
+			return err
+		} else {
+			de.maxSupply = big
+		}
+	}
+
+	de.paused = paused
+
 	return nil
 }
+
+func maxSupplyString(de CurrencyDesign) string {
+	if ms, ok := de.MaxSupply(); ok {
+		return ms.String()
+	}
+
+	return ""
+}
@@ -17,6 +17,9 @@ type CurrencyDesignJSONMarshaler struct {
 	Genesis       base.Address   `json:"genesis_account"`
 	Policy        CurrencyPolicy `json:"policy"`
 	TotalSupply   string         `json:"total_supply"`
+	Burned        string         `json:"burned"`
+	MaxSupply     string         `json:"max_supply,omitempty"`
+	Paused        bool           `json:"paused,omitempty"`
 }
 
 func (de CurrencyDesign) MarshalJSON() ([]byte, error) {
@@ -28,6 +31,9 @@ func (de CurrencyDesign) MarshalJSON() ([]byte, error) {
 		Genesis:       de.genesisAccount,
 		Policy:        de.policy,
 		TotalSupply:   de.totalSupply.String(),
+		Burned:        de.Burned().String(),
+		MaxSupply:     maxSupplyString(de),
+		Paused:        de.paused,
 	})
 }
 
@@ -39,6 +45,9 @@ type CurrencyDesignJSONUnmarshaler struct {
 	Genesis       string          `json:"genesis_account"`
 	Policy        json.RawMessage `json:"policy"`
 	TotalSupply   string          `json:"total_supply"`
+	Burned        string          `json:"burned,omitempty"`
+	MaxSupply     string          `json:"max_supply,omitempty"`
+	Paused        bool            `json:"paused,omitempty"`
 }
 
 func (de *CurrencyDesign) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -49,5 +58,5 @@ func (de *CurrencyDesign) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return de.unpack(enc, ude.Hint, ude.InitialSupply, ude.Currency, ude.Decimal, ude.Genesis, ude.Policy, ude.TotalSupply)
+	return de.unpack(enc, ude.Hint, ude.InitialSupply, ude.Currency, ude.Decimal, ude.Genesis, ude.Policy, ude.TotalSupply, ude.Burned, ude.MaxSupply, ude.Paused)
 }
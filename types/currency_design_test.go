@@ -0,0 +1,117 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/types"
+)
+
+func TestCurrencyDesignBurnTotalSupply(t *testing.T) {
+	design := types.NewCurrencyDesign(
+		common.NewBig(1000), types.CurrencyID("MCC"), common.NewBig(9), testFeeReceiver,
+		types.NewCurrencyPolicy(common.ZeroBig, types.NewNilFeeer()),
+	)
+
+	for _, n := range []int64{0, -1, 1000} {
+		if _, err := design.BurnTotalSupply(common.NewBig(n)); err == nil {
+			t.Fatalf("expected burn of %d rejected", n)
+		}
+	}
+
+	burned, err := design.BurnTotalSupply(common.NewBig(300))
+	if err != nil {
+		t.Fatalf("burn total supply: %v", err)
+	}
+
+	burned, err = burned.BurnTotalSupply(common.NewBig(200))
+	if err != nil {
+		t.Fatalf("burn total supply: %v", err)
+	}
+
+	if !burned.TotalSupply().Equal(common.NewBig(500)) || !burned.Burned().Equal(common.NewBig(500)) {
+		t.Fatalf("expected total supply 500 and burned 500, not %v and %v", burned.TotalSupply(), burned.Burned())
+	}
+
+	if !design.Burned().IsZero() {
+		t.Fatalf("expected original design not burned, not %v", design.Burned())
+	}
+
+	j, b := roundTrip(t, burned)
+	for _, got := range []types.CurrencyDesign{j, b} {
+		if err := got.IsValid(nil); err != nil {
+			t.Fatalf("invalid decoded design: %v", err)
+		}
+
+		if !bytes.Equal(burned.Bytes(), got.Bytes()) || !got.Burned().Equal(common.NewBig(500)) {
+			t.Fatalf("decoded design not matched, %v != %v", burned, got)
+		}
+	}
+}
+
+func TestCurrencyDesignMaxSupply(t *testing.T) {
+	newDesign := func(total, max int64) types.CurrencyDesign {
+		design := types.NewCurrencyDesign(
+			common.NewBig(total), types.CurrencyID("MCC"), common.NewBig(9), testFeeReceiver,
+			types.NewCurrencyPolicy(common.ZeroBig, types.NewNilFeeer()),
+		)
+		design.SetMaxSupply(common.NewBig(max))
+
+		return design
+	}
+
+	for _, c := range []struct {
+		name  string
+		total int64
+		max   int64
+		valid bool
+	}{
+		{"uncapped", 1000, 0, true},
+		{"capped", 1000, 1200, true},
+		{"at-max", 1200, 1200, true},
+		{"over-max", 1201, 1200, false},
+		{"negative-max", 1000, -1, false},
+	} {
+		if err := newDesign(c.total, c.max).IsValid(nil); (err == nil) != c.valid {
+			t.Fatalf("%s: expected valid %v, not %v", c.name, c.valid, err)
+		}
+	}
+
+	design := newDesign(1000, 1200)
+
+	if _, err := design.AddTotalSupply(common.NewBig(201)); err == nil {
+		t.Fatal("expected total supply over max supply rejected")
+	}
+
+	added, err := design.AddTotalSupply(common.NewBig(200))
+	if err != nil {
+		t.Fatalf("add total supply: %v", err)
+	}
+
+	if !added.TotalSupply().Equal(common.NewBig(1200)) {
+		t.Fatalf("expected total supply 1200, not %v", added.TotalSupply())
+	}
+
+	j, b := roundTrip(t, design)
+	for _, got := range []types.CurrencyDesign{j, b} {
+		if ms, ok := got.MaxSupply(); !ok || !ms.Equal(common.NewBig(1200)) || !bytes.Equal(design.Bytes(), got.Bytes()) {
+			t.Fatalf("decoded design not matched, %v != %v", design, got)
+		}
+	}
+}
+
+func TestCurrencyDesignPausedRoundTrip(t *testing.T) {
+	design := types.NewCurrencyDesign(
+		common.NewBig(1000), types.CurrencyID("MCC"), common.NewBig(9), testFeeReceiver,
+		types.NewCurrencyPolicy(common.ZeroBig, types.NewNilFeeer()),
+	)
+	design.SetPaused(true)
+
+	j, b := roundTrip(t, design)
+	for _, got := range []types.CurrencyDesign{j, b} {
+		if !got.Paused() || !bytes.Equal(design.Bytes(), got.Bytes()) {
+			t.Fatalf("decoded design not matched, %v != %v", design, got)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util"
 	"github.com/imfact-labs/mitum2/util/hint"
 	"github.com/pkg/errors"
@@ -13,8 +14,11 @@ var (
 
 type CurrencyPolicy struct {
 	hint.BaseHinter
-	minBalance common.Big
-	feeer      Feeer
+	minBalance  common.Big
+	feeer       Feeer
+	feeCurrency *FeeCurrency
+	limits      *TransferLimits
+	authority   *CurrencyAuthority
 }
 
 func NewCurrencyPolicy(newAccountMinBalance common.Big, feeer Feeer) CurrencyPolicy {
@@ -24,8 +28,47 @@ func NewCurrencyPolicy(newAccountMinBalance common.Big, feeer Feeer) CurrencyPol
 	}
 }
 
+// WithFeeCurrency returns the copy of policy, which charges the fee in the
+// other currency by the given conversion.
+func (po CurrencyPolicy) WithFeeCurrency(fc FeeCurrency) CurrencyPolicy {
+	po.feeCurrency = &fc
+
+	return po
+}
+
+// WithLimits returns the copy of policy, which restricts the transfers of
+// currency by the given limits.
+func (po CurrencyPolicy) WithLimits(l TransferLimits) CurrencyPolicy {
+	po.limits = &l
+
+	return po
+}
+
+// WithAuthority returns the copy of policy, which allows the given authority
+// to freeze the accounts of currency.
+func (po CurrencyPolicy) WithAuthority(a CurrencyAuthority) CurrencyPolicy {
+	po.authority = &a
+
+	return po
+}
+
 func (po CurrencyPolicy) Bytes() []byte {
-	return util.ConcatBytesSlice(po.minBalance.Bytes(), po.feeer.Bytes())
+	var fb []byte
+	if po.feeCurrency != nil {
+		fb = po.feeCurrency.Bytes()
+	}
+
+	var lb []byte
+	if po.limits != nil {
+		lb = po.limits.Bytes()
+	}
+
+	var ab []byte
+	if po.authority != nil {
+		ab = po.authority.Bytes()
+	}
+
+	return util.ConcatBytesSlice(po.minBalance.Bytes(), po.feeer.Bytes(), fb, lb, ab)
 }
 
 func (po CurrencyPolicy) IsValid([]byte) error {
@@ -37,6 +80,24 @@ func (po CurrencyPolicy) IsValid([]byte) error {
 		return common.ErrValueInvalid.Wrap(errors.Errorf("invalid currency policy, %v", err))
 	}
 
+	if po.feeCurrency != nil {
+		if err := po.feeCurrency.IsValid(nil); err != nil {
+			return common.ErrValueInvalid.Wrap(errors.Errorf("invalid fee currency, %v", err))
+		}
+	}
+
+	if po.limits != nil {
+		if err := po.limits.IsValid(nil); err != nil {
+			return common.ErrValueInvalid.Wrap(errors.Errorf("invalid transfer limits, %v", err))
+		}
+	}
+
+	if po.authority != nil {
+		if err := po.authority.IsValid(nil); err != nil {
+			return common.ErrValueInvalid.Wrap(errors.Errorf("invalid currency authority, %v", err))
+		}
+	}
+
 	return nil
 }
 
@@ -47,3 +108,194 @@ func (po CurrencyPolicy) MinBalance() common.Big {
 func (po CurrencyPolicy) Feeer() Feeer {
 	return po.feeer
 }
+
+// FeeCurrency returns the fee currency conversion; if not set, the fee is
+// charged in the currency of the policy.
+func (po CurrencyPolicy) FeeCurrency() (FeeCurrency, bool) {
+	if po.feeCurrency == nil {
+		return FeeCurrency{}, false
+	}
+
+	return *po.feeCurrency, true
+}
+
+// Limits returns the transfer limits; if not set, the transfers of currency
+// are not restricted.
+func (po CurrencyPolicy) Limits() (TransferLimits, bool) {
+	if po.limits == nil {
+		return TransferLimits{}, false
+	}
+
+	return *po.limits, true
+}
+
+// Authority returns the authority of currency; if not set, the accounts of
+// currency can not be frozen.
+func (po CurrencyPolicy) Authority() (CurrencyAuthority, bool) {
+	if po.authority == nil {
+		return CurrencyAuthority{}, false
+	}
+
+	return *po.authority, true
+}
+
+// FeeCurrency converts the fee calculated by Feeer into the other currency.
+// The converted fee is fee * numerator / denominator, rounded up.
+type FeeCurrency struct {
+	currency    CurrencyID
+	numerator   common.Big
+	denominator common.Big
+}
+
+func NewFeeCurrency(currency CurrencyID, numerator, denominator common.Big) FeeCurrency {
+	return FeeCurrency{currency: currency, numerator: numerator, denominator: denominator}
+}
+
+func (fc FeeCurrency) Currency() CurrencyID {
+	return fc.currency
+}
+
+func (fc FeeCurrency) Numerator() common.Big {
+	return fc.numerator
+}
+
+func (fc FeeCurrency) Denominator() common.Big {
+	return fc.denominator
+}
+
+func (fc FeeCurrency) Bytes() []byte {
+	return util.ConcatBytesSlice(fc.currency.Bytes(), fc.numerator.Bytes(), fc.denominator.Bytes())
+}
+
+func (fc FeeCurrency) IsValid([]byte) error {
+	if err := fc.currency.IsValid(nil); err != nil {
+		return err
+	}
+
+	if !fc.numerator.OverZero() {
+		return util.ErrInvalid.Errorf("fee currency numerator should be over zero, %v", fc.numerator)
+	}
+
+	if !fc.denominator.OverZero() {
+		return util.ErrInvalid.Errorf("fee currency denominator should be over zero, %v", fc.denominator)
+	}
+
+	return nil
+}
+
+func (fc FeeCurrency) Convert(fee common.Big) common.Big {
+	if !fee.OverZero() {
+		return common.ZeroBig
+	}
+
+	return fee.Mul(fc.numerator).Add(fc.denominator).Sub(common.NewBig(1)).Div(fc.denominator)
+}
+
+// TransferLimits restricts the transfers of currency. Each limit is disabled
+// when it is zero.
+//   - maxTransfer: maximum amount of one transfer
+//   - maxOutflow: maximum amount, which an account sends out within the window
+//     of outflowWindow blocks
+//   - maxBalance: maximum balance of an account
+type TransferLimits struct {
+	maxTransfer   common.Big
+	maxOutflow    common.Big
+	outflowWindow uint64
+	maxBalance    common.Big
+}
+
+func NewTransferLimits(maxTransfer, maxOutflow common.Big, outflowWindow uint64, maxBalance common.Big) TransferLimits {
+	return TransferLimits{
+		maxTransfer:   maxTransfer,
+		maxOutflow:    maxOutflow,
+		outflowWindow: outflowWindow,
+		maxBalance:    maxBalance,
+	}
+}
+
+func (l TransferLimits) MaxTransfer() common.Big {
+	return l.maxTransfer
+}
+
+func (l TransferLimits) MaxOutflow() common.Big {
+	return l.maxOutflow
+}
+
+func (l TransferLimits) OutflowWindow() uint64 {
+	return l.outflowWindow
+}
+
+func (l TransferLimits) MaxBalance() common.Big {
+	return l.maxBalance
+}
+
+func (l TransferLimits) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		l.maxTransfer.Bytes(),
+		l.maxOutflow.Bytes(),
+		util.Uint64ToBytes(l.outflowWindow),
+		l.maxBalance.Bytes(),
+	)
+}
+
+func (l TransferLimits) IsValid([]byte) error {
+	switch {
+	case !l.maxTransfer.OverNil():
+		return util.ErrInvalid.Errorf("max transfer under zero, %v", l.maxTransfer)
+	case !l.maxOutflow.OverNil():
+		return util.ErrInvalid.Errorf("max outflow under zero, %v", l.maxOutflow)
+	case !l.maxBalance.OverNil():
+		return util.ErrInvalid.Errorf("max balance under zero, %v", l.maxBalance)
+	case l.maxOutflow.OverZero() && l.outflowWindow < 1:
+		return util.ErrInvalid.Errorf("empty outflow window for max outflow")
+	}
+
+	return nil
+}
+
+// OutflowWindowStart returns the first height of the outflow window, which
+// the height belongs to.
+func (l TransferLimits) OutflowWindowStart(height base.Height) base.Height {
+	if l.outflowWindow < 1 || height < base.GenesisHeight {
+		return height
+	}
+
+	return height - base.Height(uint64(height)%l.outflowWindow)
+}
+
+// CurrencyAuthority is the account, which can freeze and unfreeze the
+// accounts of currency. If forceTransfer is true, the authority also can move
+// the balance out of the frozen account.
+type CurrencyAuthority struct {
+	account       base.Address
+	forceTransfer bool
+}
+
+func NewCurrencyAuthority(account base.Address, forceTransfer bool) CurrencyAuthority {
+	return CurrencyAuthority{account: account, forceTransfer: forceTransfer}
+}
+
+func (a CurrencyAuthority) Account() base.Address {
+	return a.account
+}
+
+func (a CurrencyAuthority) ForceTransfer() bool {
+	return a.forceTransfer
+}
+
+func (a CurrencyAuthority) Bytes() []byte {
+	var ab []byte
+	if a.account != nil {
+		ab = a.account.Bytes()
+	}
+
+	return util.ConcatBytesSlice(ab, util.BoolToBytes(a.forceTransfer))
+}
+
+func (a CurrencyAuthority) IsValid([]byte) error {
+	if a.account == nil {
+		return util.ErrInvalid.Errorf("empty authority account")
+	}
+
+	return a.account.IsValid(nil)
+}
@@ -9,19 +9,34 @@ import (
 )
 
 func (po CurrencyPolicy) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bson.M{
-			"_hint":       po.Hint().String(),
-			"min_balance": po.minBalance.String(),
-			"feeer":       po.feeer,
-		},
-	)
+	m := bson.M{
+		"_hint":       po.Hint().String(),
+		"min_balance": po.minBalance.String(),
+		"feeer":       po.feeer,
+	}
+
+	if po.feeCurrency != nil {
+		m["fee_currency"] = po.feeCurrency
+	}
+
+	if po.limits != nil {
+		m["limits"] = po.limits
+	}
+
+	if po.authority != nil {
+		m["authority"] = po.authority
+	}
+
+	return bsonenc.Marshal(m)
 }
 
 type CurrencyPolicyBSONUnmarshaler struct {
-	Hint       string   `bson:"_hint"`
-	MinBalance string   `bson:"min_balance"`
-	Feeer      bson.Raw `bson:"feeer"`
+	Hint        string                          `bson:"_hint"`
+	MinBalance  string                          `bson:"min_balance"`
+	Feeer       bson.Raw                        `bson:"feeer"`
+	FeeCurrency *FeeCurrency                    `bson:"fee_currency,omitempty"`
+	Limits      *TransferLimits                 `bson:"limits,omitempty"`
+	Authority   *CurrencyAuthorityBSONMarshaler `bson:"authority,omitempty"`
 }
 
 func (po *CurrencyPolicy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -37,5 +52,88 @@ func (po *CurrencyPolicy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return po.unpack(enc, ht, upo.MinBalance, upo.Feeer)
+	var au string
+	var forceTransfer bool
+	if upo.Authority != nil {
+		au, forceTransfer = upo.Authority.Account, upo.Authority.ForceTransfer
+	}
+
+	return po.unpack(enc, ht, upo.MinBalance, upo.Feeer, upo.FeeCurrency, upo.Limits, au, forceTransfer)
+}
+
+type FeeCurrencyBSONMarshaler struct {
+	Currency    string `bson:"currency"`
+	Numerator   string `bson:"numerator"`
+	Denominator string `bson:"denominator"`
+}
+
+func (fc FeeCurrency) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(FeeCurrencyBSONMarshaler{
+		Currency:    fc.currency.String(),
+		Numerator:   fc.numerator.String(),
+		Denominator: fc.denominator.String(),
+	})
+}
+
+func (fc *FeeCurrency) UnmarshalBSON(b []byte) error {
+	e := util.StringError("unmarshal bson of FeeCurrency")
+
+	var ufc FeeCurrencyBSONMarshaler
+	if err := bsonenc.Unmarshal(b, &ufc); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := fc.unpack(CurrencyID(ufc.Currency), ufc.Numerator, ufc.Denominator); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+type TransferLimitsBSONMarshaler struct {
+	MaxTransfer   string `bson:"max_transfer"`
+	MaxOutflow    string `bson:"max_outflow"`
+	OutflowWindow uint64 `bson:"outflow_window"`
+	MaxBalance    string `bson:"max_balance"`
+}
+
+func (l TransferLimits) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(TransferLimitsBSONMarshaler{
+		MaxTransfer:   l.maxTransfer.String(),
+		MaxOutflow:    l.maxOutflow.String(),
+		OutflowWindow: l.outflowWindow,
+		MaxBalance:    l.maxBalance.String(),
+	})
+}
+
+func (l *TransferLimits) UnmarshalBSON(b []byte) error {
+	e := util.StringError("unmarshal bson of TransferLimits")
+
+	var ul TransferLimitsBSONMarshaler
+	if err := bsonenc.Unmarshal(b, &ul); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := l.unpack(ul.MaxTransfer, ul.MaxOutflow, ul.OutflowWindow, ul.MaxBalance); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+type CurrencyAuthorityBSONMarshaler struct {
+	Account       string `bson:"account"`
+	ForceTransfer bool   `bson:"force_transfer"`
+}
+
+func (a CurrencyAuthority) MarshalBSON() ([]byte, error) {
+	var ac string
+	if a.account != nil {
+		ac = a.account.String()
+	}
+
+	return bsonenc.Marshal(CurrencyAuthorityBSONMarshaler{
+		Account:       ac,
+		ForceTransfer: a.forceTransfer,
+	})
 }
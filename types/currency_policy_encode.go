@@ -2,12 +2,16 @@ package types
 
 import (
 	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util/encoder"
 	"github.com/imfact-labs/mitum2/util/hint"
 	"github.com/pkg/errors"
 )
 
-func (po *CurrencyPolicy) unpack(enc encoder.Encoder, ht hint.Hint, mn string, bfe []byte) error {
+func (po *CurrencyPolicy) unpack(
+	enc encoder.Encoder, ht hint.Hint, mn string, bfe []byte, fc *FeeCurrency, limits *TransferLimits,
+	au string, forceTransfer bool,
+) error {
 	if big, err := common.NewBigFromString(mn); err != nil {
 		return err
 	} else {
@@ -21,6 +25,57 @@ func (po *CurrencyPolicy) unpack(enc encoder.Encoder, ht hint.Hint, mn string, b
 		return errors.Errorf("Decode feeer, %v", err)
 	}
 	po.feeer = feeer
+	po.feeCurrency = fc
+	po.limits = limits
+
+	if len(au) > 0 {
+		a, err := base.DecodeAddress(au, enc)
+		if err != nil {
+			return errors.Errorf("Decode authority, %v", err)
+		}
+
+		po.authority = &CurrencyAuthority{account: a, forceTransfer: forceTransfer}
+	}
+
+	return nil
+}
+
+func (fc *FeeCurrency) unpack(cid CurrencyID, numerator, denominator string) error {
+	fc.currency = cid
+
+	if big, err := common.NewBigFromString(numerator); err != nil {
+		return err
+	} else {
+		fc.numerator = big
+	}
+
+	if big, err := common.NewBigFromString(denominator); err != nil {
+		return err
+	} else {
+		fc.denominator = big
+	}
+
+	return nil
+}
+
+func (l *TransferLimits) unpack(maxTransfer, maxOutflow string, outflowWindow uint64, maxBalance string) error {
+	for _, i := range []struct {
+		s string
+		v *common.Big
+	}{
+		{s: maxTransfer, v: &l.maxTransfer},
+		{s: maxOutflow, v: &l.maxOutflow},
+		{s: maxBalance, v: &l.maxBalance},
+	} {
+		big, err := common.NewBigFromString(i.s)
+		if err != nil {
+			return err
+		}
+
+		*i.v = big
+	}
+
+	l.outflowWindow = outflowWindow
 
 	return nil
 }
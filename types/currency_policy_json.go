@@ -9,22 +9,31 @@ import (
 
 type CurrencyPolicyJSONMarshaler struct {
 	hint.BaseHinter
-	MinBalance string `json:"min_balance"`
-	Feeer      Feeer  `json:"feeer"`
+	MinBalance  string             `json:"min_balance"`
+	Feeer       Feeer              `json:"feeer"`
+	FeeCurrency *FeeCurrency       `json:"fee_currency,omitempty"`
+	Limits      *TransferLimits    `json:"limits,omitempty"`
+	Authority   *CurrencyAuthority `json:"authority,omitempty"`
 }
 
 func (po CurrencyPolicy) MarshalJSON() ([]byte, error) {
 	return util.MarshalJSON(CurrencyPolicyJSONMarshaler{
-		BaseHinter: po.BaseHinter,
-		MinBalance: po.minBalance.String(),
-		Feeer:      po.feeer,
+		BaseHinter:  po.BaseHinter,
+		MinBalance:  po.minBalance.String(),
+		Feeer:       po.feeer,
+		FeeCurrency: po.feeCurrency,
+		Limits:      po.limits,
+		Authority:   po.authority,
 	})
 }
 
 type CurrencyPolicyJSONUnmarshaler struct {
-	Hint       hint.Hint       `json:"_hint"`
-	MinBalance string          `json:"min_balance"`
-	Feeer      json.RawMessage `json:"feeer"`
+	Hint        hint.Hint                       `json:"_hint"`
+	MinBalance  string                          `json:"min_balance"`
+	Feeer       json.RawMessage                 `json:"feeer"`
+	FeeCurrency *FeeCurrency                    `json:"fee_currency,omitempty"`
+	Limits      *TransferLimits                 `json:"limits,omitempty"`
+	Authority   *CurrencyAuthorityJSONMarshaler `json:"authority,omitempty"`
 }
 
 func (po *CurrencyPolicy) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -35,5 +44,88 @@ func (po *CurrencyPolicy) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return po.unpack(enc, upo.Hint, upo.MinBalance, upo.Feeer)
+	var au string
+	var forceTransfer bool
+	if upo.Authority != nil {
+		au, forceTransfer = upo.Authority.Account, upo.Authority.ForceTransfer
+	}
+
+	return po.unpack(enc, upo.Hint, upo.MinBalance, upo.Feeer, upo.FeeCurrency, upo.Limits, au, forceTransfer)
+}
+
+type FeeCurrencyJSONMarshaler struct {
+	Currency    CurrencyID `json:"currency"`
+	Numerator   string     `json:"numerator"`
+	Denominator string     `json:"denominator"`
+}
+
+func (fc FeeCurrency) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(FeeCurrencyJSONMarshaler{
+		Currency:    fc.currency,
+		Numerator:   fc.numerator.String(),
+		Denominator: fc.denominator.String(),
+	})
+}
+
+func (fc *FeeCurrency) UnmarshalJSON(b []byte) error {
+	e := util.StringError("unmarshal json of FeeCurrency")
+
+	var ufc FeeCurrencyJSONMarshaler
+	if err := util.UnmarshalJSON(b, &ufc); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := fc.unpack(ufc.Currency, ufc.Numerator, ufc.Denominator); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+type TransferLimitsJSONMarshaler struct {
+	MaxTransfer   string `json:"max_transfer"`
+	MaxOutflow    string `json:"max_outflow"`
+	OutflowWindow uint64 `json:"outflow_window"`
+	MaxBalance    string `json:"max_balance"`
+}
+
+func (l TransferLimits) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(TransferLimitsJSONMarshaler{
+		MaxTransfer:   l.maxTransfer.String(),
+		MaxOutflow:    l.maxOutflow.String(),
+		OutflowWindow: l.outflowWindow,
+		MaxBalance:    l.maxBalance.String(),
+	})
+}
+
+func (l *TransferLimits) UnmarshalJSON(b []byte) error {
+	e := util.StringError("unmarshal json of TransferLimits")
+
+	var ul TransferLimitsJSONMarshaler
+	if err := util.UnmarshalJSON(b, &ul); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := l.unpack(ul.MaxTransfer, ul.MaxOutflow, ul.OutflowWindow, ul.MaxBalance); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+type CurrencyAuthorityJSONMarshaler struct {
+	Account       string `json:"account"`
+	ForceTransfer bool   `json:"force_transfer"`
+}
+
+func (a CurrencyAuthority) MarshalJSON() ([]byte, error) {
+	var ac string
+	if a.account != nil {
+		ac = a.account.String()
+	}
+
+	return util.MarshalJSON(CurrencyAuthorityJSONMarshaler{
+		Account:       ac,
+		ForceTransfer: a.forceTransfer,
+	})
 }
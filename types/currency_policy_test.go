@@ -0,0 +1,205 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+)
+
+// roundTrip encodes v by json and bson, and returns the decoded ones.
+func roundTrip[T any](t *testing.T, v T) (fromJSON, fromBSON T) {
+	t.Helper()
+
+	encs, benc := newTestEncoders(t)
+
+	decode := func(name string, enc encoder.Encoder) T {
+		b, err := enc.Marshal(v)
+		if err != nil {
+			t.Fatalf("marshal %s: %v", name, err)
+		}
+
+		i, err := enc.Decode(b)
+		if err != nil {
+			t.Fatalf("decode %s: %v", name, err)
+		}
+
+		got, ok := i.(T)
+		if !ok {
+			t.Fatalf("decoded %s type = %T", name, i)
+		}
+
+		return got
+	}
+
+	return decode("json", encs.JSON()), decode("bson", benc)
+}
+
+func requireSamePolicy(t *testing.T, a, b types.CurrencyPolicy) {
+	t.Helper()
+
+	if err := b.IsValid(nil); err != nil {
+		t.Fatalf("invalid decoded policy: %v", err)
+	}
+
+	if !bytes.Equal(a.Bytes(), b.Bytes()) {
+		t.Fatalf("decoded policy not matched, %v != %v", a, b)
+	}
+}
+
+func TestCurrencyPolicyRoundTripWithLimits(t *testing.T) {
+	policy := types.NewCurrencyPolicy(common.NewBig(1), types.NewNilFeeer()).
+		WithLimits(types.NewTransferLimits(common.NewBig(500), common.NewBig(300), 10, common.NewBig(1000)))
+
+	j, b := roundTrip(t, policy)
+	for _, got := range []types.CurrencyPolicy{j, b} {
+		requireSamePolicy(t, policy, got)
+
+		limits, ok := got.Limits()
+		if !ok {
+			t.Fatal("expected limits in decoded policy")
+		}
+
+		if !limits.MaxTransfer().Equal(common.NewBig(500)) ||
+			!limits.MaxOutflow().Equal(common.NewBig(300)) ||
+			limits.OutflowWindow() != 10 ||
+			!limits.MaxBalance().Equal(common.NewBig(1000)) {
+			t.Fatalf("unexpected decoded limits: %+v", limits)
+		}
+	}
+
+	j, b = roundTrip(t, types.NewCurrencyPolicy(common.NewBig(1), types.NewNilFeeer()))
+	for _, got := range []types.CurrencyPolicy{j, b} {
+		if _, ok := got.Limits(); ok {
+			t.Fatal("expected no limits in decoded policy without limits")
+		}
+	}
+}
+
+func TestTransferLimitsValidation(t *testing.T) {
+	cases := []struct {
+		name   string
+		limits types.TransferLimits
+		valid  bool
+	}{
+		{"empty", types.NewTransferLimits(common.ZeroBig, common.ZeroBig, 0, common.ZeroBig), true},
+		{"outflow", types.NewTransferLimits(common.ZeroBig, common.NewBig(1), 1, common.ZeroBig), true},
+		{"negative-max-transfer", types.NewTransferLimits(common.NewBig(-1), common.ZeroBig, 0, common.ZeroBig), false},
+		{"negative-max-balance", types.NewTransferLimits(common.ZeroBig, common.ZeroBig, 0, common.NewBig(-1)), false},
+		{"outflow-without-window", types.NewTransferLimits(common.ZeroBig, common.NewBig(1), 0, common.ZeroBig), false},
+	}
+
+	for _, c := range cases {
+		if err := c.limits.IsValid(nil); (err == nil) != c.valid {
+			t.Fatalf("%s: expected valid %v, not %v", c.name, c.valid, err)
+		}
+	}
+}
+
+func TestTransferLimitsOutflowWindowStart(t *testing.T) {
+	limits := types.NewTransferLimits(common.ZeroBig, common.NewBig(1), 10, common.ZeroBig)
+
+	for height, start := range map[base.Height]base.Height{
+		0:  0,
+		9:  0,
+		10: 10,
+		19: 10,
+		25: 20,
+	} {
+		if got := limits.OutflowWindowStart(height); got != start {
+			t.Fatalf("expected window of %v starts at %v, not %v", height, start, got)
+		}
+	}
+}
+
+func TestFeeCurrencyConvert(t *testing.T) {
+	// NOTE 3 of fee currency for 2 of fee
+	fc := types.NewFeeCurrency(types.CurrencyID("USD"), common.NewBig(3), common.NewBig(2))
+
+	for fee, converted := range map[int64]int64{
+		0:  0,
+		1:  2,
+		2:  3,
+		10: 15,
+		11: 17,
+	} {
+		if got := fc.Convert(common.NewBig(fee)); !got.Equal(common.NewBig(converted)) {
+			t.Fatalf("expected %d converted from %d, not %v", converted, fee, got)
+		}
+	}
+}
+
+func TestFeeCurrencyValidation(t *testing.T) {
+	cases := []struct {
+		name  string
+		fc    types.FeeCurrency
+		valid bool
+	}{
+		{"valid", types.NewFeeCurrency(types.CurrencyID("USD"), common.NewBig(3), common.NewBig(2)), true},
+		{"empty-currency", types.NewFeeCurrency(types.CurrencyID(""), common.NewBig(3), common.NewBig(2)), false},
+		{"zero-numerator", types.NewFeeCurrency(types.CurrencyID("USD"), common.ZeroBig, common.NewBig(2)), false},
+		{"zero-denominator", types.NewFeeCurrency(types.CurrencyID("USD"), common.NewBig(3), common.ZeroBig), false},
+	}
+
+	for _, c := range cases {
+		if err := c.fc.IsValid(nil); (err == nil) != c.valid {
+			t.Fatalf("%s: expected valid %v, not %v", c.name, c.valid, err)
+		}
+
+		policy := types.NewCurrencyPolicy(common.NewBig(1), types.NewNilFeeer()).WithFeeCurrency(c.fc)
+		if err := policy.IsValid(nil); (err == nil) != c.valid {
+			t.Fatalf("%s: expected valid policy %v, not %v", c.name, c.valid, err)
+		}
+	}
+}
+
+func TestCurrencyPolicyRoundTripWithFeeCurrency(t *testing.T) {
+	policy := types.NewCurrencyPolicy(common.NewBig(1), types.NewNilFeeer()).
+		WithFeeCurrency(types.NewFeeCurrency(types.CurrencyID("USD"), common.NewBig(3), common.NewBig(2)))
+
+	j, b := roundTrip(t, policy)
+	for _, got := range []types.CurrencyPolicy{j, b} {
+		requireSamePolicy(t, policy, got)
+
+		fc, ok := got.FeeCurrency()
+		if !ok {
+			t.Fatal("expected fee currency in decoded policy")
+		}
+
+		if fc.Currency() != types.CurrencyID("USD") ||
+			!fc.Numerator().Equal(common.NewBig(3)) ||
+			!fc.Denominator().Equal(common.NewBig(2)) {
+			t.Fatalf("unexpected decoded fee currency: %+v", fc)
+		}
+	}
+
+	j, b = roundTrip(t, types.NewCurrencyPolicy(common.NewBig(1), types.NewNilFeeer()))
+	for _, got := range []types.CurrencyPolicy{j, b} {
+		if _, ok := got.FeeCurrency(); ok {
+			t.Fatal("expected no fee currency in decoded policy without fee currency")
+		}
+	}
+}
+
+func TestCurrencyPolicyRoundTripWithAuthority(t *testing.T) {
+	authority := types.NewCurrencyAuthority(types.NewAddress("0x52908400098527886E0F7030069857D2E4169EE7"), true)
+	policy := types.NewCurrencyPolicy(common.NewBig(1), types.NewNilFeeer()).WithAuthority(authority)
+
+	j, b := roundTrip(t, policy)
+	for _, got := range []types.CurrencyPolicy{j, b} {
+		requireSamePolicy(t, policy, got)
+
+		au, ok := got.Authority()
+		if !ok || !au.Account().Equal(authority.Account()) || !au.ForceTransfer() {
+			t.Fatalf("unexpected decoded authority: %+v", au)
+		}
+	}
+
+	if err := types.NewCurrencyPolicy(common.NewBig(1), types.NewNilFeeer()).
+		WithAuthority(types.NewCurrencyAuthority(nil, true)).IsValid(nil); err == nil {
+		t.Fatal("expected policy with empty authority invalid")
+	}
+}
@@ -11,16 +11,25 @@ const (
 	FeeerNil                        = "nil"
 	FeeerFixed                      = "fixed"
 	FeeerFixedItemDataSizeExecution = "fixed-item-data-size-execution"
+	FeeerRatio                      = "ratio"
+	FeeerTiered                     = "tiered"
+	FeeerSplit                      = "split"
 )
 
 var (
 	NilFeeerHint                        = hint.MustNewHint("mitum-currency-nil-feeer-v0.0.1")
 	FixedFeeerHint                      = hint.MustNewHint("mitum-currency-fixed-feeer-v0.0.1")
 	FixedItemDataSizeExecutionFeeerHint = hint.MustNewHint("mitum-currency-fixed-item-data-size-execution-feeer-v0.0.1")
+	RatioFeeerHint                      = hint.MustNewHint("mitum-currency-ratio-feeer-v0.0.1")
+	TieredFeeerHint                     = hint.MustNewHint("mitum-currency-tiered-feeer-v0.0.1")
+	SplitFeeerHint                      = hint.MustNewHint("mitum-currency-split-feeer-v0.0.1")
 )
 
 var UnlimitedMaxFeeAmount = common.NewBig(-1)
 
+// RatioFeeerDenominator is the denominator of RatioFeeer ratio; ratio is expressed in basis points.
+const RatioFeeerDenominator uint64 = 10000
+
 type Feeer interface {
 	util.IsValider
 	hint.Hinter
@@ -44,6 +53,10 @@ type ExecutionFeeer interface {
 	ExecutionFee() common.Big
 }
 
+type AmountFeeer interface {
+	AmountFee(common.Big) common.Big
+}
+
 type ExtFeeer interface {
 	Feeer
 	ItemFeeer
@@ -264,3 +277,503 @@ func (fa FixedItemDataSizeExecutionFeeer) IsValid([]byte) error {
 func (fa FixedItemDataSizeExecutionFeeer) isZero(am common.Big) bool {
 	return am.IsZero()
 }
+
+type RatioFeeer struct {
+	hint.BaseHinter
+	receiver base.Address
+	ratio    uint64
+	min      common.Big
+	max      common.Big
+}
+
+func NewRatioFeeer(receiver base.Address, ratio uint64, min, max common.Big) RatioFeeer {
+	return RatioFeeer{
+		BaseHinter: hint.NewBaseHinter(RatioFeeerHint),
+		receiver:   receiver,
+		ratio:      ratio,
+		min:        min,
+		max:        max,
+	}
+}
+
+func (RatioFeeer) Type() string {
+	return FeeerRatio
+}
+
+func (fa RatioFeeer) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fa.receiver.Bytes(),
+		util.Uint64ToBytes(fa.ratio),
+		fa.min.Bytes(),
+		fa.max.Bytes(),
+	)
+}
+
+func (fa RatioFeeer) Receiver() base.Address {
+	return fa.receiver
+}
+
+// Ratio returns the fee ratio in basis points.
+func (fa RatioFeeer) Ratio() uint64 {
+	return fa.ratio
+}
+
+func (fa RatioFeeer) Min() common.Big {
+	return fa.min
+}
+
+func (fa RatioFeeer) Max() common.Big {
+	return fa.max
+}
+
+// Fee returns the fee for a zero amount, which is the minimum fee.
+func (fa RatioFeeer) Fee() common.Big {
+	return fa.AmountFee(common.ZeroBig)
+}
+
+// AmountFee returns amount * ratio / RatioFeeerDenominator, bounded by min and max.
+func (fa RatioFeeer) AmountFee(amount common.Big) common.Big {
+	if fa.isZero() {
+		return common.ZeroBig
+	}
+
+	if !amount.OverZero() {
+		return fa.min
+	}
+
+	f := amount.MulInt64(int64(fa.ratio)).Div(common.NewBig(int64(RatioFeeerDenominator)))
+
+	switch {
+	case f.Compare(fa.min) < 0:
+		return fa.min
+	case !fa.isUnlimited() && f.Compare(fa.max) > 0:
+		return fa.max
+	default:
+		return f
+	}
+}
+
+func (fa RatioFeeer) IsValid([]byte) error {
+	if err := fa.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fa.receiver); err != nil {
+		return util.ErrInvalid.Errorf("invalid receiver for ratio feeer: %v", err)
+	}
+
+	if fa.ratio > RatioFeeerDenominator {
+		return util.ErrInvalid.Errorf("ratio of ratio feeer over %d, %d", RatioFeeerDenominator, fa.ratio)
+	}
+
+	if !fa.min.OverNil() {
+		return util.ErrInvalid.Errorf("min of ratio feeer under zero")
+	}
+
+	if !fa.isUnlimited() {
+		if !fa.max.OverNil() {
+			return util.ErrInvalid.Errorf("max of ratio feeer under zero")
+		} else if fa.min.Compare(fa.max) > 0 {
+			return util.ErrInvalid.Errorf("min of ratio feeer over max, %v > %v", fa.min, fa.max)
+		}
+	}
+
+	return nil
+}
+
+func (fa RatioFeeer) isZero() bool {
+	return fa.ratio == 0 && fa.min.IsZero()
+}
+
+func (fa RatioFeeer) isUnlimited() bool {
+	return fa.max.Equal(UnlimitedMaxFeeAmount)
+}
+
+// FeeBracket charges fee for amount in [min, max); max, UnlimitedMaxFeeAmount means no upper bound.
+type FeeBracket struct {
+	min common.Big
+	max common.Big
+	fee common.Big
+}
+
+func NewFeeBracket(min, max, fee common.Big) FeeBracket {
+	return FeeBracket{
+		min: min,
+		max: max,
+		fee: fee,
+	}
+}
+
+func (fb FeeBracket) Min() common.Big {
+	return fb.min
+}
+
+func (fb FeeBracket) Max() common.Big {
+	return fb.max
+}
+
+func (fb FeeBracket) Fee() common.Big {
+	return fb.fee
+}
+
+func (fb FeeBracket) Bytes() []byte {
+	return util.ConcatBytesSlice(fb.min.Bytes(), fb.max.Bytes(), fb.fee.Bytes())
+}
+
+func (fb FeeBracket) IsValid([]byte) error {
+	if !fb.min.OverNil() {
+		return util.ErrInvalid.Errorf("fee bracket min under zero")
+	}
+
+	if !fb.fee.OverNil() {
+		return util.ErrInvalid.Errorf("fee bracket fee under zero")
+	}
+
+	if !fb.isUnlimited() && fb.max.Compare(fb.min) <= 0 {
+		return util.ErrInvalid.Errorf("fee bracket max not over min, %v <= %v", fb.max, fb.min)
+	}
+
+	return nil
+}
+
+func (fb FeeBracket) Contains(amount common.Big) bool {
+	if amount.Compare(fb.min) < 0 {
+		return false
+	}
+
+	return fb.isUnlimited() || amount.Compare(fb.max) < 0
+}
+
+func (fb FeeBracket) isUnlimited() bool {
+	return fb.max.Equal(UnlimitedMaxFeeAmount)
+}
+
+// TieredFeeer charges the fee of the bracket which the amount of each item belongs to. Brackets must be ordered,
+// start from zero and be contiguous, and the last bracket must not have upper bound.
+type TieredFeeer struct {
+	hint.BaseHinter
+	receiver base.Address
+	brackets []FeeBracket
+}
+
+func NewTieredFeeer(receiver base.Address, brackets []FeeBracket) TieredFeeer {
+	return TieredFeeer{
+		BaseHinter: hint.NewBaseHinter(TieredFeeerHint),
+		receiver:   receiver,
+		brackets:   brackets,
+	}
+}
+
+func (TieredFeeer) Type() string {
+	return FeeerTiered
+}
+
+func (fa TieredFeeer) Bytes() []byte {
+	bs := make([][]byte, len(fa.brackets)+1)
+	bs[0] = fa.receiver.Bytes()
+
+	for i := range fa.brackets {
+		bs[i+1] = fa.brackets[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (fa TieredFeeer) Receiver() base.Address {
+	return fa.receiver
+}
+
+func (fa TieredFeeer) Brackets() []FeeBracket {
+	return fa.brackets
+}
+
+// Min returns the lowest fee among brackets.
+func (fa TieredFeeer) Min() common.Big {
+	if len(fa.brackets) < 1 {
+		return common.ZeroBig
+	}
+
+	min := fa.brackets[0].fee
+	for i := range fa.brackets[1:] {
+		if f := fa.brackets[i+1].fee; f.Compare(min) < 0 {
+			min = f
+		}
+	}
+
+	return min
+}
+
+// Fee returns the fee for a zero amount, which is the fee of the first bracket.
+func (fa TieredFeeer) Fee() common.Big {
+	return fa.AmountFee(common.ZeroBig)
+}
+
+func (fa TieredFeeer) AmountFee(amount common.Big) common.Big {
+	if len(fa.brackets) < 1 {
+		return common.ZeroBig
+	}
+
+	if !amount.OverNil() {
+		amount = common.ZeroBig
+	}
+
+	for i := range fa.brackets {
+		if fa.brackets[i].Contains(amount) {
+			return fa.brackets[i].fee
+		}
+	}
+
+	return fa.brackets[len(fa.brackets)-1].fee
+}
+
+func (fa TieredFeeer) IsValid([]byte) error {
+	if err := fa.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fa.receiver); err != nil {
+		return util.ErrInvalid.Errorf("invalid receiver for tiered feeer: %v", err)
+	}
+
+	if len(fa.brackets) < 1 {
+		return util.ErrInvalid.Errorf("empty brackets of tiered feeer")
+	}
+
+	for i := range fa.brackets {
+		b := fa.brackets[i]
+		if err := b.IsValid(nil); err != nil {
+			return util.ErrInvalid.Errorf("invalid bracket %d of tiered feeer: %v", i, err)
+		}
+
+		switch {
+		case i == 0 && !b.min.IsZero():
+			return util.ErrInvalid.Errorf("first bracket of tiered feeer does not start from zero, %v", b.min)
+		case i > 0 && fa.brackets[i-1].isUnlimited():
+			return util.ErrInvalid.Errorf("bracket %d of tiered feeer follows unlimited bracket", i)
+		case i > 0 && b.min.Compare(fa.brackets[i-1].max) < 0:
+			return util.ErrInvalid.Errorf(
+				"bracket %d of tiered feeer overlaps previous bracket, %v < %v", i, b.min, fa.brackets[i-1].max)
+		case i > 0 && b.min.Compare(fa.brackets[i-1].max) > 0:
+			return util.ErrInvalid.Errorf(
+				"bracket %d of tiered feeer leaves gap after previous bracket, %v > %v", i, b.min, fa.brackets[i-1].max)
+		}
+	}
+
+	if !fa.brackets[len(fa.brackets)-1].isUnlimited() {
+		return util.ErrInvalid.Errorf("last bracket of tiered feeer must be unlimited")
+	}
+
+	return nil
+}
+
+// FeeShare is the weighted receiver of SplitFeeer.
+type FeeShare struct {
+	receiver base.Address
+	weight   uint
+}
+
+func NewFeeShare(receiver base.Address, weight uint) FeeShare {
+	return FeeShare{receiver: receiver, weight: weight}
+}
+
+func (fs FeeShare) Receiver() base.Address {
+	return fs.receiver
+}
+
+func (fs FeeShare) Weight() uint {
+	return fs.weight
+}
+
+func (fs FeeShare) Bytes() []byte {
+	return util.ConcatBytesSlice(fs.receiver.Bytes(), util.UintToBytes(fs.weight))
+}
+
+func (fs FeeShare) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, fs.receiver); err != nil {
+		return util.ErrInvalid.Errorf("invalid fee share receiver: %v", err)
+	}
+
+	if fs.weight < 1 {
+		return util.ErrInvalid.Errorf("zero weight of fee share, %v", fs.receiver)
+	}
+
+	return nil
+}
+
+// SplitFeeer calculates the fee by the wrapped Feeer and splits it between the
+// receivers by weight. The first receiver is the receiver of the wrapped Feeer
+// and takes the remainder of the split. With burn weight, the share of burn is
+// removed from the total supply of currency; without receivers, whole fee is
+// burned.
+type SplitFeeer struct {
+	hint.BaseHinter
+	feeer  Feeer
+	shares []FeeShare
+	burn   uint
+}
+
+func NewSplitFeeer(feeer Feeer, shares []FeeShare, burn uint) SplitFeeer {
+	return SplitFeeer{
+		BaseHinter: hint.NewBaseHinter(SplitFeeerHint),
+		feeer:      feeer,
+		shares:     shares,
+		burn:       burn,
+	}
+}
+
+func (SplitFeeer) Type() string {
+	return FeeerSplit
+}
+
+func (fa SplitFeeer) Bytes() []byte {
+	bs := make([][]byte, len(fa.shares)+2)
+	if fa.feeer != nil {
+		bs[0] = fa.feeer.Bytes()
+	}
+
+	for i := range fa.shares {
+		bs[i+1] = fa.shares[i].Bytes()
+	}
+
+	if fa.burn > 0 {
+		bs[len(bs)-1] = util.UintToBytes(fa.burn)
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (fa SplitFeeer) Feeer() Feeer {
+	return fa.feeer
+}
+
+func (fa SplitFeeer) Shares() []FeeShare {
+	return fa.shares
+}
+
+// Burn returns the weight of fee, which is burned.
+func (fa SplitFeeer) Burn() uint {
+	return fa.burn
+}
+
+func (fa SplitFeeer) Receiver() base.Address {
+	if len(fa.shares) < 1 {
+		return nil
+	}
+
+	return fa.shares[0].receiver
+}
+
+func (fa SplitFeeer) Min() common.Big {
+	if fa.feeer == nil {
+		return common.ZeroBig
+	}
+
+	return fa.feeer.Min()
+}
+
+func (fa SplitFeeer) Fee() common.Big {
+	if fa.feeer == nil {
+		return common.ZeroBig
+	}
+
+	return fa.feeer.Fee()
+}
+
+// Split returns the amounts of fee for each share in order and the amount to
+// burn. Each share and burn take fee * weight / total weight rounded down, and
+// the first share takes the remainder, so the sum of amounts is always same
+// with fee. Without shares, whole fee is burned.
+func (fa SplitFeeer) Split(fee common.Big) ([]common.Big, common.Big) {
+	amounts := make([]common.Big, len(fa.shares))
+	if len(amounts) < 1 {
+		if fa.burn < 1 {
+			return amounts, common.ZeroBig
+		}
+
+		return amounts, fee
+	}
+
+	total := uint64(fa.burn)
+	for i := range fa.shares {
+		total += uint64(fa.shares[i].weight)
+	}
+
+	burned := fee.MulInt64(int64(fa.burn)).Div(common.NewBig(int64(total)))
+
+	rest := fee.Sub(burned)
+	for i := len(fa.shares) - 1; i > 0; i-- {
+		amounts[i] = fee.MulInt64(int64(fa.shares[i].weight)).Div(common.NewBig(int64(total)))
+		rest = rest.Sub(amounts[i])
+	}
+
+	amounts[0] = rest
+
+	return amounts, burned
+}
+
+func (fa SplitFeeer) IsValid([]byte) error {
+	if err := fa.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if fa.feeer == nil {
+		return util.ErrInvalid.Errorf("empty feeer of split feeer")
+	}
+
+	if _, ok := fa.feeer.(SplitFeeer); ok {
+		return util.ErrInvalid.Errorf("split feeer can not wrap split feeer")
+	}
+
+	if err := fa.feeer.IsValid(nil); err != nil {
+		return util.ErrInvalid.Errorf("invalid feeer of split feeer: %v", err)
+	}
+
+	if len(fa.shares) < 1 {
+		if fa.burn < 1 {
+			return util.ErrInvalid.Errorf("empty shares of split feeer")
+		}
+
+		return nil
+	}
+
+	founds := map[string]struct{}{}
+	for i := range fa.shares {
+		if err := fa.shares[i].IsValid(nil); err != nil {
+			return util.ErrInvalid.Errorf("invalid share %d of split feeer: %v", i, err)
+		}
+
+		k := fa.shares[i].receiver.String()
+		if _, found := founds[k]; found {
+			return util.ErrInvalid.Errorf("duplicated receiver of split feeer, %v", k)
+		}
+
+		founds[k] = struct{}{}
+	}
+
+	if rc := fa.feeer.Receiver(); rc == nil || !rc.Equal(fa.shares[0].receiver) {
+		return util.ErrInvalid.Errorf("first share of split feeer is not the receiver of feeer, %v", rc)
+	}
+
+	return nil
+}
+
+// FeeerReceivers returns all the receivers of feeer.
+func FeeerReceivers(feeer Feeer) []base.Address {
+	switch fa := feeer.(type) {
+	case nil:
+		return nil
+	case SplitFeeer:
+		receivers := make([]base.Address, len(fa.shares))
+		for i := range fa.shares {
+			receivers[i] = fa.shares[i].receiver
+		}
+
+		return receivers
+	default:
+		if rc := feeer.Receiver(); rc != nil {
+			return []base.Address{rc}
+		}
+
+		return nil
+	}
+}
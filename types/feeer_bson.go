@@ -105,3 +105,159 @@ func (fa *FixedItemDataSizeExecutionFeeer) DecodeBSON(b []byte, enc *bsonenc.Enc
 		ufa.DataSizeFeeAmount, ufa.DataSizeUnit, ufa.ExecutionFeeAmount,
 	)
 }
+
+func (fa RatioFeeer) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    fa.Hint().String(),
+			"receiver": fa.receiver,
+			"ratio":    fa.ratio,
+			"min":      fa.min.String(),
+			"max":      fa.max.String(),
+		},
+	)
+}
+
+type RatioFeeerBSONUnmarshaler struct {
+	Hint     string `bson:"_hint"`
+	Receiver string `bson:"receiver"`
+	Ratio    uint64 `bson:"ratio"`
+	Min      string `bson:"min"`
+	Max      string `bson:"max"`
+}
+
+func (fa *RatioFeeer) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("Decode bson of RatioFeeer")
+
+	var ufa RatioFeeerBSONUnmarshaler
+	if err := enc.Unmarshal(b, &ufa); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(ufa.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return fa.unpack(enc, ht, ufa.Receiver, ufa.Ratio, ufa.Min, ufa.Max)
+}
+
+type FeeBracketBSONMarshaler struct {
+	Min string `bson:"min"`
+	Max string `bson:"max"`
+	Fee string `bson:"fee"`
+}
+
+func (fb FeeBracket) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(FeeBracketBSONMarshaler{
+		Min: fb.min.String(),
+		Max: fb.max.String(),
+		Fee: fb.fee.String(),
+	})
+}
+
+func (fb *FeeBracket) UnmarshalBSON(b []byte) error {
+	e := util.StringError("unmarshal bson of FeeBracket")
+
+	var ufb FeeBracketBSONMarshaler
+	if err := bsonenc.Unmarshal(b, &ufb); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := fb.unpack(ufb.Min, ufb.Max, ufb.Fee); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (fa TieredFeeer) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    fa.Hint().String(),
+			"receiver": fa.receiver,
+			"brackets": fa.brackets,
+		},
+	)
+}
+
+type TieredFeeerBSONUnmarshaler struct {
+	Hint     string       `bson:"_hint"`
+	Receiver string       `bson:"receiver"`
+	Brackets []FeeBracket `bson:"brackets"`
+}
+
+func (fa *TieredFeeer) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("Decode bson of TieredFeeer")
+
+	var ufa TieredFeeerBSONUnmarshaler
+	if err := enc.Unmarshal(b, &ufa); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(ufa.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return fa.unpack(enc, ht, ufa.Receiver, ufa.Brackets)
+}
+
+func (fs FeeShare) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"receiver": fs.receiver,
+			"weight":   fs.weight,
+		},
+	)
+}
+
+type FeeShareBSONUnmarshaler struct {
+	Receiver string `bson:"receiver"`
+	Weight   uint   `bson:"weight"`
+}
+
+func (fa SplitFeeer) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  fa.Hint().String(),
+			"feeer":  fa.feeer,
+			"shares": fa.shares,
+			"burn":   fa.burn,
+		},
+	)
+}
+
+type SplitFeeerBSONUnmarshaler struct {
+	Hint   string                    `bson:"_hint"`
+	Feeer  bson.Raw                  `bson:"feeer"`
+	Shares []FeeShareBSONUnmarshaler `bson:"shares"`
+	Burn   uint                      `bson:"burn"`
+}
+
+func (fa *SplitFeeer) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("Decode bson of SplitFeeer")
+
+	var ufa SplitFeeerBSONUnmarshaler
+	if err := enc.Unmarshal(b, &ufa); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(ufa.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	rcs := make([]string, len(ufa.Shares))
+	weights := make([]uint, len(ufa.Shares))
+	for i := range ufa.Shares {
+		rcs[i] = ufa.Shares[i].Receiver
+		weights[i] = ufa.Shares[i].Weight
+	}
+
+	if err := fa.unpack(enc, ht, ufa.Feeer, rcs, weights, ufa.Burn); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util/encoder"
 	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/pkg/errors"
 )
 
 func (fa *FixedFeeer) unpack(enc encoder.Encoder, ht hint.Hint, rc string, am string) error {
@@ -64,3 +65,91 @@ func (fa *FixedItemDataSizeExecutionFeeer) unpack(
 
 	return nil
 }
+
+func (fa *RatioFeeer) unpack(enc encoder.Encoder, ht hint.Hint, rc string, ratio uint64, min, max string) error {
+	switch ad, err := base.DecodeAddress(rc, enc); {
+	case err != nil:
+		return err
+	default:
+		fa.receiver = ad
+	}
+
+	fa.ratio = ratio
+
+	if big, err := common.NewBigFromString(min); err != nil {
+		return err
+	} else {
+		fa.min = big
+	}
+
+	if big, err := common.NewBigFromString(max); err != nil {
+		return err
+	} else {
+		fa.max = big
+	}
+	fa.BaseHinter = hint.NewBaseHinter(ht)
+
+	return nil
+}
+
+func (fb *FeeBracket) unpack(min, max, fee string) error {
+	if big, err := common.NewBigFromString(min); err != nil {
+		return err
+	} else {
+		fb.min = big
+	}
+
+	if big, err := common.NewBigFromString(max); err != nil {
+		return err
+	} else {
+		fb.max = big
+	}
+
+	if big, err := common.NewBigFromString(fee); err != nil {
+		return err
+	} else {
+		fb.fee = big
+	}
+
+	return nil
+}
+
+func (fa *TieredFeeer) unpack(enc encoder.Encoder, ht hint.Hint, rc string, brackets []FeeBracket) error {
+	switch ad, err := base.DecodeAddress(rc, enc); {
+	case err != nil:
+		return err
+	default:
+		fa.receiver = ad
+	}
+
+	fa.brackets = brackets
+	fa.BaseHinter = hint.NewBaseHinter(ht)
+
+	return nil
+}
+
+func (fa *SplitFeeer) unpack(
+	enc encoder.Encoder, ht hint.Hint, bfe []byte, rcs []string, weights []uint, burn uint,
+) error {
+	var feeer Feeer
+	if err := encoder.Decode(enc, bfe, &feeer); err != nil {
+		return errors.Errorf("Decode feeer, %v", err)
+	}
+
+	shares := make([]FeeShare, len(rcs))
+	for i := range rcs {
+		switch ad, err := base.DecodeAddress(rcs[i], enc); {
+		case err != nil:
+			return err
+		default:
+			shares[i] = NewFeeShare(ad, weights[i])
+		}
+	}
+
+	fa.BaseHinter = hint.NewBaseHinter(ht)
+	fa.feeer = feeer
+	fa.shares = shares
+	fa.burn = burn
+
+	return nil
+}
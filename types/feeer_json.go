@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/json"
+
 	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util"
 	"github.com/imfact-labs/mitum2/util/encoder"
@@ -102,3 +104,162 @@ func (fa *FixedItemDataSizeExecutionFeeer) DecodeJSON(b []byte, enc encoder.Enco
 		ufa.DataSizeFeeAmount, ufa.DataSizeUnit, ufa.ExecutionFeeAmount,
 	)
 }
+
+type RatioFeeerJSONMarshaler struct {
+	hint.BaseHinter
+	Receiver base.Address `json:"receiver"`
+	Ratio    uint64       `json:"ratio"`
+	Min      string       `json:"min"`
+	Max      string       `json:"max"`
+}
+
+func (fa RatioFeeer) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RatioFeeerJSONMarshaler{
+		BaseHinter: fa.BaseHinter,
+		Receiver:   fa.receiver,
+		Ratio:      fa.ratio,
+		Min:        fa.min.String(),
+		Max:        fa.max.String(),
+	})
+}
+
+type RatioFeeerJSONUnmarshaler struct {
+	Hint     hint.Hint `json:"_hint"`
+	Receiver string    `json:"receiver"`
+	Ratio    uint64    `json:"ratio"`
+	Min      string    `json:"min"`
+	Max      string    `json:"max"`
+}
+
+func (fa *RatioFeeer) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("Decode json of RatioFeeer")
+
+	var ufa RatioFeeerJSONUnmarshaler
+	if err := enc.Unmarshal(b, &ufa); err != nil {
+		return e.Wrap(err)
+	}
+
+	return fa.unpack(enc, ufa.Hint, ufa.Receiver, ufa.Ratio, ufa.Min, ufa.Max)
+}
+
+type FeeBracketJSONMarshaler struct {
+	Min string `json:"min"`
+	Max string `json:"max"`
+	Fee string `json:"fee"`
+}
+
+func (fb FeeBracket) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(FeeBracketJSONMarshaler{
+		Min: fb.min.String(),
+		Max: fb.max.String(),
+		Fee: fb.fee.String(),
+	})
+}
+
+func (fb *FeeBracket) UnmarshalJSON(b []byte) error {
+	e := util.StringError("unmarshal json of FeeBracket")
+
+	var ufb FeeBracketJSONMarshaler
+	if err := util.UnmarshalJSON(b, &ufb); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := fb.unpack(ufb.Min, ufb.Max, ufb.Fee); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+type TieredFeeerJSONMarshaler struct {
+	hint.BaseHinter
+	Receiver base.Address `json:"receiver"`
+	Brackets []FeeBracket `json:"brackets"`
+}
+
+func (fa TieredFeeer) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(TieredFeeerJSONMarshaler{
+		BaseHinter: fa.BaseHinter,
+		Receiver:   fa.receiver,
+		Brackets:   fa.brackets,
+	})
+}
+
+type TieredFeeerJSONUnmarshaler struct {
+	Hint     hint.Hint    `json:"_hint"`
+	Receiver string       `json:"receiver"`
+	Brackets []FeeBracket `json:"brackets"`
+}
+
+func (fa *TieredFeeer) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("Decode json of TieredFeeer")
+
+	var ufa TieredFeeerJSONUnmarshaler
+	if err := enc.Unmarshal(b, &ufa); err != nil {
+		return e.Wrap(err)
+	}
+
+	return fa.unpack(enc, ufa.Hint, ufa.Receiver, ufa.Brackets)
+}
+
+type FeeShareJSONMarshaler struct {
+	Receiver base.Address `json:"receiver"`
+	Weight   uint         `json:"weight"`
+}
+
+func (fs FeeShare) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(FeeShareJSONMarshaler{
+		Receiver: fs.receiver,
+		Weight:   fs.weight,
+	})
+}
+
+type FeeShareJSONUnmarshaler struct {
+	Receiver string `json:"receiver"`
+	Weight   uint   `json:"weight"`
+}
+
+type SplitFeeerJSONMarshaler struct {
+	hint.BaseHinter
+	Feeer  Feeer      `json:"feeer"`
+	Shares []FeeShare `json:"shares"`
+	Burn   uint       `json:"burn,omitempty"`
+}
+
+func (fa SplitFeeer) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SplitFeeerJSONMarshaler{
+		BaseHinter: fa.BaseHinter,
+		Feeer:      fa.feeer,
+		Shares:     fa.shares,
+		Burn:       fa.burn,
+	})
+}
+
+type SplitFeeerJSONUnmarshaler struct {
+	Hint   hint.Hint                 `json:"_hint"`
+	Feeer  json.RawMessage           `json:"feeer"`
+	Shares []FeeShareJSONUnmarshaler `json:"shares"`
+	Burn   uint                      `json:"burn"`
+}
+
+func (fa *SplitFeeer) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("Decode json of SplitFeeer")
+
+	var ufa SplitFeeerJSONUnmarshaler
+	if err := enc.Unmarshal(b, &ufa); err != nil {
+		return e.Wrap(err)
+	}
+
+	rcs := make([]string, len(ufa.Shares))
+	weights := make([]uint, len(ufa.Shares))
+	for i := range ufa.Shares {
+		rcs[i] = ufa.Shares[i].Receiver
+		weights[i] = ufa.Shares[i].Weight
+	}
+
+	if err := fa.unpack(enc, ufa.Hint, ufa.Feeer, rcs, weights, ufa.Burn); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
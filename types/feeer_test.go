@@ -0,0 +1,294 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/types"
+)
+
+var testFeeReceiver = types.NewAddress("0x52908400098527886E0F7030069857D2E4169EE7")
+
+func requireSameFeeer(t *testing.T, a, b types.Feeer) {
+	t.Helper()
+
+	if err := b.IsValid(nil); err != nil {
+		t.Fatalf("invalid decoded %T: %v", b, err)
+	}
+
+	if !bytes.Equal(a.Bytes(), b.Bytes()) {
+		t.Fatalf("decoded %T not matched, %v != %v", b, a, b)
+	}
+}
+
+func TestRatioFeeerAmountFee(t *testing.T) {
+	// NOTE 1% with min 5 and max 50
+	feeer := types.NewRatioFeeer(testFeeReceiver, 100, common.NewBig(5), common.NewBig(50))
+
+	for amount, fee := range map[int64]int64{
+		0:     5,
+		100:   5,
+		1000:  10,
+		4999:  49,
+		10000: 50,
+	} {
+		if got := feeer.AmountFee(common.NewBig(amount)); !got.Equal(common.NewBig(fee)) {
+			t.Fatalf("expected fee %d of %d, not %v", fee, amount, got)
+		}
+	}
+
+	unlimited := types.NewRatioFeeer(testFeeReceiver, 100, common.ZeroBig, types.UnlimitedMaxFeeAmount)
+	if got := unlimited.AmountFee(common.NewBig(1000000)); !got.Equal(common.NewBig(10000)) {
+		t.Fatalf("expected uncapped fee 10000, not %v", got)
+	}
+
+	zero := types.NewRatioFeeer(testFeeReceiver, 0, common.ZeroBig, types.UnlimitedMaxFeeAmount)
+	if got := zero.AmountFee(common.NewBig(1000000)); !got.IsZero() {
+		t.Fatalf("expected zero fee of zero ratio, not %v", got)
+	}
+}
+
+func TestRatioFeeerValidation(t *testing.T) {
+	cases := []struct {
+		name  string
+		feeer types.RatioFeeer
+		valid bool
+	}{
+		{"capped", types.NewRatioFeeer(testFeeReceiver, 100, common.NewBig(5), common.NewBig(50)), true},
+		{"unlimited", types.NewRatioFeeer(testFeeReceiver, 100, common.ZeroBig, types.UnlimitedMaxFeeAmount), true},
+		{"whole", types.NewRatioFeeer(testFeeReceiver, types.RatioFeeerDenominator, common.ZeroBig, types.UnlimitedMaxFeeAmount), true},
+		{"over-denominator", types.NewRatioFeeer(testFeeReceiver, types.RatioFeeerDenominator+1, common.ZeroBig, types.UnlimitedMaxFeeAmount), false},
+		{"negative-min", types.NewRatioFeeer(testFeeReceiver, 100, common.NewBig(-2), common.NewBig(50)), false},
+		{"negative-max", types.NewRatioFeeer(testFeeReceiver, 100, common.ZeroBig, common.NewBig(-2)), false},
+		{"min-over-max", types.NewRatioFeeer(testFeeReceiver, 100, common.NewBig(51), common.NewBig(50)), false},
+		{"empty-receiver", types.NewRatioFeeer(nil, 100, common.ZeroBig, common.NewBig(50)), false},
+	}
+
+	for _, c := range cases {
+		if err := c.feeer.IsValid(nil); (err == nil) != c.valid {
+			t.Fatalf("%s: expected valid %v, not %v", c.name, c.valid, err)
+		}
+	}
+}
+
+func TestRatioFeeerRoundTrip(t *testing.T) {
+	for _, feeer := range []types.RatioFeeer{
+		types.NewRatioFeeer(testFeeReceiver, 100, common.NewBig(5), common.NewBig(50)),
+		types.NewRatioFeeer(testFeeReceiver, 25, common.ZeroBig, types.UnlimitedMaxFeeAmount),
+	} {
+		j, b := roundTrip(t, feeer)
+		for _, got := range []types.RatioFeeer{j, b} {
+			requireSameFeeer(t, feeer, got)
+
+			if got.Ratio() != feeer.Ratio() || !got.Max().Equal(feeer.Max()) {
+				t.Fatalf("unexpected decoded ratio feeer: %v", got)
+			}
+		}
+	}
+}
+
+func testFeeBrackets() []types.FeeBracket {
+	return []types.FeeBracket{
+		types.NewFeeBracket(common.ZeroBig, common.NewBig(1000), common.NewBig(1)),
+		types.NewFeeBracket(common.NewBig(1000), common.NewBig(100000), common.NewBig(5)),
+		types.NewFeeBracket(common.NewBig(100000), types.UnlimitedMaxFeeAmount, common.NewBig(20)),
+	}
+}
+
+func TestTieredFeeerAmountFee(t *testing.T) {
+	feeer := types.NewTieredFeeer(testFeeReceiver, testFeeBrackets())
+
+	for amount, fee := range map[int64]int64{
+		0:       1,
+		999:     1,
+		1000:    5,
+		99999:   5,
+		100000:  20,
+		1000000: 20,
+	} {
+		if got := feeer.AmountFee(common.NewBig(amount)); !got.Equal(common.NewBig(fee)) {
+			t.Fatalf("expected fee %d of %d, not %v", fee, amount, got)
+		}
+	}
+
+	if got := feeer.Min(); !got.Equal(common.NewBig(1)) {
+		t.Fatalf("expected min fee 1, not %v", got)
+	}
+}
+
+func TestTieredFeeerValidation(t *testing.T) {
+	bracket := func(min, max, fee int64) types.FeeBracket {
+		return types.NewFeeBracket(common.NewBig(min), common.NewBig(max), common.NewBig(fee))
+	}
+
+	unlimited := func(min, fee int64) types.FeeBracket {
+		return types.NewFeeBracket(common.NewBig(min), types.UnlimitedMaxFeeAmount, common.NewBig(fee))
+	}
+
+	cases := []struct {
+		name     string
+		brackets []types.FeeBracket
+		valid    bool
+	}{
+		{"ordered", testFeeBrackets(), true},
+		{"single", []types.FeeBracket{unlimited(0, 1)}, true},
+		{"empty", nil, false},
+		{"not-from-zero", []types.FeeBracket{bracket(1, 10, 1), unlimited(10, 2)}, false},
+		{"overlapped", []types.FeeBracket{bracket(0, 10, 1), unlimited(5, 2)}, false},
+		{"gap", []types.FeeBracket{bracket(0, 10, 1), unlimited(11, 2)}, false},
+		{"not-ordered", []types.FeeBracket{bracket(0, 10, 1), bracket(20, 30, 3), bracket(10, 20, 2), unlimited(30, 4)}, false},
+		{"empty-bracket", []types.FeeBracket{bracket(0, 0, 1), unlimited(0, 2)}, false},
+		{"negative-fee", []types.FeeBracket{bracket(0, 10, -1), unlimited(10, 2)}, false},
+		{"after-unlimited", []types.FeeBracket{unlimited(0, 1), bracket(10, 20, 2)}, false},
+		{"last-limited", []types.FeeBracket{bracket(0, 10, 1), bracket(10, 20, 2)}, false},
+	}
+
+	for _, c := range cases {
+		if err := types.NewTieredFeeer(testFeeReceiver, c.brackets).IsValid(nil); (err == nil) != c.valid {
+			t.Fatalf("%s: expected valid %v, not %v", c.name, c.valid, err)
+		}
+	}
+}
+
+func TestTieredFeeerRoundTrip(t *testing.T) {
+	feeer := types.NewTieredFeeer(testFeeReceiver, testFeeBrackets())
+
+	j, b := roundTrip(t, feeer)
+	for _, got := range []types.TieredFeeer{j, b} {
+		requireSameFeeer(t, feeer, got)
+
+		if len(got.Brackets()) != len(feeer.Brackets()) {
+			t.Fatalf("expected %d decoded brackets, not %d", len(feeer.Brackets()), len(got.Brackets()))
+		}
+	}
+
+	policy := types.NewCurrencyPolicy(common.NewBig(1), feeer)
+
+	jp, bp := roundTrip(t, policy)
+	for _, got := range []types.CurrencyPolicy{jp, bp} {
+		requireSamePolicy(t, policy, got)
+
+		if _, ok := got.Feeer().(types.TieredFeeer); !ok {
+			t.Fatalf("expected tiered feeer in decoded policy, not %T", got.Feeer())
+		}
+	}
+}
+
+func testSplitShares() []types.FeeShare {
+	return []types.FeeShare{
+		types.NewFeeShare(testFeeReceiver, 2),
+		types.NewFeeShare(types.NewAddress("0x8617E340B3D01FA5F11F306F4090FD50E238070D"), 1),
+	}
+}
+
+func TestSplitFeeerSplit(t *testing.T) {
+	feeer := types.NewSplitFeeer(types.NewFixedFeeer(testFeeReceiver, common.NewBig(10)), testSplitShares(), 0)
+
+	for fee, expected := range map[int64][]int64{
+		0:  {0, 0},
+		1:  {1, 0},
+		10: {7, 3},
+		12: {8, 4},
+	} {
+		amounts, burned := feeer.Split(common.NewBig(fee))
+		if !burned.IsZero() {
+			t.Fatalf("expected nothing burned of %d, not %v", fee, burned)
+		}
+
+		sum := common.ZeroBig
+		for i := range amounts {
+			if !amounts[i].Equal(common.NewBig(expected[i])) {
+				t.Fatalf("expected share %d of %d is %d, not %v", i, fee, expected[i], amounts[i])
+			}
+
+			sum = sum.Add(amounts[i])
+		}
+
+		if !sum.Equal(common.NewBig(fee)) {
+			t.Fatalf("expected sum of shares %d, not %v", fee, sum)
+		}
+	}
+}
+
+func TestSplitFeeerValidation(t *testing.T) {
+	fixed := types.NewFixedFeeer(testFeeReceiver, common.NewBig(10))
+	other := types.NewAddress("0x8617E340B3D01FA5F11F306F4090FD50E238070D")
+
+	cases := []struct {
+		name  string
+		feeer types.SplitFeeer
+		valid bool
+	}{
+		{"valid", types.NewSplitFeeer(fixed, testSplitShares(), 0), true},
+		{"empty-feeer", types.NewSplitFeeer(nil, testSplitShares(), 0), false},
+		{"nested", types.NewSplitFeeer(types.NewSplitFeeer(fixed, testSplitShares(), 0), testSplitShares(), 0), false},
+		{"invalid-feeer", types.NewSplitFeeer(types.NewFixedFeeer(testFeeReceiver, common.NewBig(-1)), testSplitShares(), 0), false},
+		{"empty-shares", types.NewSplitFeeer(fixed, nil, 0), false},
+		{"zero-weight", types.NewSplitFeeer(fixed, []types.FeeShare{
+			types.NewFeeShare(testFeeReceiver, 1), types.NewFeeShare(other, 0),
+		}, 0), false},
+		{"duplicated", types.NewSplitFeeer(fixed, []types.FeeShare{
+			types.NewFeeShare(testFeeReceiver, 1), types.NewFeeShare(testFeeReceiver, 1),
+		}, 0), false},
+		{"first-not-receiver", types.NewSplitFeeer(fixed, []types.FeeShare{
+			types.NewFeeShare(other, 1), types.NewFeeShare(testFeeReceiver, 1),
+		}, 0), false},
+	}
+
+	for _, c := range cases {
+		if err := c.feeer.IsValid(nil); (err == nil) != c.valid {
+			t.Fatalf("%s: expected valid %v, not %v", c.name, c.valid, err)
+		}
+	}
+}
+
+func TestSplitFeeerRoundTrip(t *testing.T) {
+	feeer := types.NewSplitFeeer(
+		types.NewRatioFeeer(testFeeReceiver, 100, common.NewBig(5), common.NewBig(50)), testSplitShares(), 0)
+
+	j, b := roundTrip(t, feeer)
+	for _, got := range []types.SplitFeeer{j, b} {
+		requireSameFeeer(t, feeer, got)
+
+		if _, ok := got.Feeer().(types.RatioFeeer); !ok {
+			t.Fatalf("expected wrapped ratio feeer, not %T", got.Feeer())
+		}
+
+		if len(got.Shares()) != 2 || got.Shares()[1].Weight() != 1 {
+			t.Fatalf("unexpected decoded shares: %v", got.Shares())
+		}
+	}
+}
+
+func TestSplitFeeerBurn(t *testing.T) {
+	fixed := types.NewFixedFeeer(testFeeReceiver, common.NewBig(10))
+
+	feeer := types.NewSplitFeeer(fixed, testSplitShares(), 1)
+
+	amounts, burned := feeer.Split(common.NewBig(10))
+	if !burned.Equal(common.NewBig(2)) || !amounts[0].Equal(common.NewBig(6)) || !amounts[1].Equal(common.NewBig(2)) {
+		t.Fatalf("expected 6, 2 shared and 2 burned, not %v and %v burned", amounts, burned)
+	}
+
+	burnOnly := types.NewSplitFeeer(fixed, nil, 1)
+	if err := burnOnly.IsValid(nil); err != nil {
+		t.Fatalf("expected split feeer only to burn valid, not %v", err)
+	}
+
+	amounts, burned = burnOnly.Split(common.NewBig(10))
+	if len(amounts) != 0 || !burned.Equal(common.NewBig(10)) {
+		t.Fatalf("expected whole fee burned, not %v and %v burned", amounts, burned)
+	}
+
+	for _, feeer := range []types.SplitFeeer{feeer, burnOnly} {
+		j, b := roundTrip(t, feeer)
+		for _, got := range []types.SplitFeeer{j, b} {
+			requireSameFeeer(t, feeer, got)
+
+			if got.Burn() != 1 {
+				t.Fatalf("expected decoded burn weight 1, not %d", got.Burn())
+			}
+		}
+	}
+}
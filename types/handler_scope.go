@@ -0,0 +1,149 @@
+package types // nolint: dupl, revive
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var HandlerScopeHint = hint.MustNewHint("mitum-currency-contract-account-handler-scope-v0.0.1")
+
+const MaxHandlerScopeOperations = 20
+
+// HandlerScope restricts what the handler of contract account can do. The
+// handler can send only the operations of the given hint types; empty
+// operations allow every operation, which the handler is allowed to send. The
+// handler can withdraw only the currencies of the withdraw limits, up to the
+// limit amount in total over its withdraw operations.
+type HandlerScope struct {
+	hint.BaseHinter
+	handler    base.Address
+	operations []hint.Type
+	limits     []Amount
+}
+
+func NewHandlerScope(handler base.Address, operations []hint.Type, limits []Amount) HandlerScope {
+	sort.Slice(operations, func(i, j int) bool {
+		return operations[i] < operations[j]
+	})
+
+	sort.Slice(limits, func(i, j int) bool {
+		return limits[i].Currency() < limits[j].Currency()
+	})
+
+	return HandlerScope{
+		BaseHinter: hint.NewBaseHinter(HandlerScopeHint),
+		handler:    handler,
+		operations: operations,
+		limits:     limits,
+	}
+}
+
+func (hs HandlerScope) Bytes() []byte {
+	ops := make([][]byte, len(hs.operations))
+	for i := range hs.operations {
+		ops[i] = hs.operations[i].Bytes()
+	}
+
+	lms := make([][]byte, len(hs.limits))
+	for i := range hs.limits {
+		lms[i] = hs.limits[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		hs.handler.Bytes(),
+		util.ConcatBytesSlice(ops...),
+		util.ConcatBytesSlice(lms...),
+	)
+}
+
+func (hs HandlerScope) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, hs.BaseHinter, hs.handler); err != nil {
+		return err
+	}
+
+	if len(hs.operations) > MaxHandlerScopeOperations {
+		return common.ErrArrayLen.Wrap(
+			errors.Errorf(
+				"number of operations, %d, exceeds maximum limit, %d", len(hs.operations), MaxHandlerScopeOperations))
+	}
+
+	founds := map[hint.Type]struct{}{}
+	for i := range hs.operations {
+		if err := hs.operations[i].IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[hs.operations[i]]; found {
+			return common.ErrDupVal.Wrap(errors.Errorf("operation, %v", hs.operations[i]))
+		}
+
+		founds[hs.operations[i]] = struct{}{}
+	}
+
+	cids := map[CurrencyID]struct{}{}
+	for i := range hs.limits {
+		am := hs.limits[i]
+		if err := am.IsValid(nil); err != nil {
+			return err
+		} else if !am.Big().OverZero() {
+			return common.ErrValOOR.Wrap(errors.Errorf("withdraw limit of %v should be over zero", am.Currency()))
+		}
+
+		if _, found := cids[am.Currency()]; found {
+			return common.ErrDupVal.Wrap(errors.Errorf("currency id of withdraw limit, %v", am.Currency()))
+		}
+
+		cids[am.Currency()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (hs HandlerScope) Handler() base.Address {
+	return hs.handler
+}
+
+func (hs HandlerScope) Operations() []hint.Type {
+	return hs.operations
+}
+
+func (hs HandlerScope) Limits() []Amount {
+	return hs.limits
+}
+
+// AllowOperation checks whether the handler can send the operation.
+func (hs HandlerScope) AllowOperation(ht hint.Hint) bool {
+	if len(hs.operations) < 1 {
+		return true
+	}
+
+	for i := range hs.operations {
+		if hs.operations[i] == ht.Type() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WithdrawLimit returns the maximum amount of currency, which the handler can
+// withdraw in total.
+func (hs HandlerScope) WithdrawLimit(cid CurrencyID) (common.Big, bool) {
+	for i := range hs.limits {
+		if hs.limits[i].Currency() == cid {
+			return hs.limits[i].Big(), true
+		}
+	}
+
+	return common.ZeroBig, false
+}
+
+func (hs HandlerScope) Equal(b HandlerScope) bool {
+	return bytes.Equal(hs.Bytes(), b.Bytes())
+}
@@ -0,0 +1,51 @@
+package types // nolint: dupl, revive
+
+import (
+	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func (hs HandlerScope) MarshalBSON() ([]byte, error) {
+	operations := make([]string, len(hs.operations))
+	for i := range hs.operations {
+		operations[i] = hs.operations[i].String()
+	}
+
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":           hs.Hint().String(),
+			"handler":         hs.handler,
+			"operations":      operations,
+			"withdraw_limits": hs.limits,
+		},
+	)
+}
+
+type HandlerScopeBSONUnmarshaler struct {
+	Hint       string   `bson:"_hint"`
+	Handler    string   `bson:"handler"`
+	Operations []string `bson:"operations"`
+	Limits     bson.Raw `bson:"withdraw_limits"`
+}
+
+func (hs *HandlerScope) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("Decode bson of HandlerScope")
+
+	var uhs HandlerScopeBSONUnmarshaler
+	if err := bsonenc.Unmarshal(b, &uhs); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(uhs.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := hs.unpack(enc, ht, uhs.Handler, uhs.Operations, uhs.Limits); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
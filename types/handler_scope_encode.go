@@ -0,0 +1,50 @@
+package types // nolint: dupl, revive
+
+import (
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+func (hs *HandlerScope) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	hd string,
+	ops []string,
+	blm []byte,
+) error {
+	hs.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch a, err := base.DecodeAddress(hd, enc); {
+	case err != nil:
+		return errors.Errorf("Decode handler address, %v", err)
+	default:
+		hs.handler = a
+	}
+
+	operations := make([]hint.Type, len(ops))
+	for i := range ops {
+		operations[i] = hint.Type(ops[i])
+	}
+	hs.operations = operations
+
+	hlm, err := enc.DecodeSlice(blm)
+	if err != nil {
+		return err
+	}
+
+	limits := make([]Amount, len(hlm))
+	for i := range hlm {
+		j, ok := hlm[i].(Amount)
+		if !ok {
+			return common.ErrTypeMismatch.Wrap(errors.Errorf("expected Amount, not %T", hlm[i]))
+		}
+
+		limits[i] = j
+	}
+	hs.limits = limits
+
+	return nil
+}
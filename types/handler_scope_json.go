@@ -0,0 +1,48 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/encoder"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+type HandlerScopeJSONMarshaler struct {
+	hint.BaseHinter
+	Handler    base.Address `json:"handler"`
+	Operations []hint.Type  `json:"operations"`
+	Limits     []Amount     `json:"withdraw_limits"`
+}
+
+func (hs HandlerScope) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(HandlerScopeJSONMarshaler{
+		BaseHinter: hs.BaseHinter,
+		Handler:    hs.handler,
+		Operations: hs.operations,
+		Limits:     hs.limits,
+	})
+}
+
+type HandlerScopeJSONUnmarshaler struct {
+	Hint       hint.Hint       `json:"_hint"`
+	Handler    string          `json:"handler"`
+	Operations []string        `json:"operations"`
+	Limits     json.RawMessage `json:"withdraw_limits"`
+}
+
+func (hs *HandlerScope) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("Decode json of HandlerScope")
+
+	var uhs HandlerScopeJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uhs); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := hs.unpack(enc, uhs.Hint, uhs.Handler, uhs.Operations, uhs.Limits); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
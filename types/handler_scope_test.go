@@ -0,0 +1,86 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/util/hint"
+)
+
+var testHandler = types.NewAddress("0x0000000000000000000000000000000000000001")
+
+func testHandlerScope() types.HandlerScope {
+	return types.NewHandlerScope(
+		testHandler,
+		[]hint.Type{"mitum-extension-withdraw-operation", "mitum-currency-transfer-operation"},
+		[]types.Amount{
+			types.NewAmount(common.NewBig(100), types.CurrencyID("MCC")),
+			types.NewAmount(common.NewBig(50), types.CurrencyID("ABC")),
+		},
+	)
+}
+
+func TestHandlerScope(t *testing.T) {
+	scope := testHandlerScope()
+
+	if err := scope.IsValid(nil); err != nil {
+		t.Fatalf("invalid handler scope: %v", err)
+	}
+
+	if !scope.AllowOperation(hint.MustNewHint("mitum-extension-withdraw-operation-v0.0.1")) {
+		t.Fatal("expected withdraw allowed")
+	} else if scope.AllowOperation(hint.MustNewHint("mitum-extension-update-handler-operation-v0.0.1")) {
+		t.Fatal("expected update handler not allowed")
+	}
+
+	if limit, found := scope.WithdrawLimit(types.CurrencyID("MCC")); !found || !limit.Equal(common.NewBig(100)) {
+		t.Fatalf("expected withdraw limit 100, not %v", limit)
+	} else if _, found := scope.WithdrawLimit(types.CurrencyID("XYZ")); found {
+		t.Fatal("expected no withdraw limit of other currency")
+	}
+
+	// NOTE empty operations allow every operation.
+	unrestricted := types.NewHandlerScope(testHandler, nil, nil)
+	if !unrestricted.AllowOperation(hint.MustNewHint("mitum-extension-update-handler-operation-v0.0.1")) {
+		t.Fatal("expected every operation allowed by empty operations")
+	}
+}
+
+func TestHandlerScopeValidation(t *testing.T) {
+	mcc := types.CurrencyID("MCC")
+
+	operations := make([]hint.Type, types.MaxHandlerScopeOperations+1)
+	for i := range operations {
+		operations[i] = hint.Type("mitum-operation-" + string(rune('a'+i)))
+	}
+
+	for name, scope := range map[string]types.HandlerScope{
+		"duplicated operation": types.NewHandlerScope(testHandler,
+			[]hint.Type{"mitum-currency-transfer-operation", "mitum-currency-transfer-operation"}, nil),
+		"duplicated currency": types.NewHandlerScope(testHandler, nil, []types.Amount{
+			types.NewAmount(common.NewBig(1), mcc), types.NewAmount(common.NewBig(2), mcc),
+		}),
+		"zero limit":          types.NewHandlerScope(testHandler, nil, []types.Amount{types.NewZeroAmount(mcc)}),
+		"too many operations": types.NewHandlerScope(testHandler, operations, nil),
+	} {
+		if err := scope.IsValid(nil); err == nil {
+			t.Fatalf("expected %s invalid", name)
+		}
+	}
+}
+
+func TestHandlerScopeRoundTrip(t *testing.T) {
+	scope := testHandlerScope()
+
+	j, b := roundTrip(t, scope)
+	for _, got := range []types.HandlerScope{j, b} {
+		if err := got.IsValid(nil); err != nil {
+			t.Fatalf("invalid decoded handler scope: %v", err)
+		}
+
+		if !got.Equal(scope) {
+			t.Fatalf("decoded handler scope not matched, %v != %v", scope, got)
+		}
+	}
+}
@@ -228,12 +228,41 @@ func (ks BaseAccountKeys) Equal(b AccountKeys) bool {
 	return true
 }
 
-func CheckThreshold(fs []base.Sign, keys AccountKeys) error {
-	var sum uint
+// CheckThreshold checks the signs pass the threshold of keys. While a key
+// rotation is in its grace period, the signs may pass the threshold of one of
+// the graces instead.
+func CheckThreshold(fs []base.Sign, keys AccountKeys, graces ...AccountKeys) error {
+	signers := make([]base.Publickey, len(fs))
 	for i := range fs {
-		ky, found := keys.Key(fs[i].Signer())
+		signers[i] = fs[i].Signer()
+	}
+
+	err := CheckSignersThreshold(signers, keys)
+	if err == nil {
+		return nil
+	}
+
+	for i := range graces {
+		if graces[i] == nil {
+			continue
+		}
+
+		if CheckSignersThreshold(signers, graces[i]) == nil {
+			return nil
+		}
+	}
+
+	return err
+}
+
+// CheckSignersThreshold checks the sum of weights of signers passes the
+// threshold of keys.
+func CheckSignersThreshold(signers []base.Publickey, keys AccountKeys) error {
+	var sum uint
+	for i := range signers {
+		ky, found := keys.Key(signers[i])
 		if !found {
-			return errors.Errorf("Unknown key found, %s", fs[i].Signer())
+			return errors.Errorf("Unknown key found, %s", signers[i])
 		}
 		sum += ky.Weight()
 	}
@@ -16,6 +16,10 @@ var FixedItemDataSizeExecutionFeeReceiptHint = hint.MustNewHint(
 	"currency-fixed-item-data-size-execution-fee-receipt-v0.0.1",
 )
 
+var RatioFeeReceiptHint = hint.MustNewHint("currency-ratio-fee-receipt-v0.0.1")
+
+var ConvertedFeeReceiptHint = hint.MustNewHint("currency-converted-fee-receipt-v0.0.1")
+
 type FeeReceipt interface {
 	hint.Hinter
 	util.IsValider
@@ -321,17 +325,302 @@ func (r FixedItemDataSizeExecutionFeeReceipt) IsValid([]byte) error {
 	return nil
 }
 
+type RatioFeeReceipt struct {
+	hint.BaseHinter
+	currencyID CurrencyID
+	totalFee   string
+	ratio      uint64
+	minFee     string
+	maxFee     string
+	itemCount  int
+	amount     string
+}
+
+func NewRatioFeeReceipt(
+	currencyID CurrencyID,
+	totalFee common.Big,
+	ratio uint64,
+	minFee common.Big,
+	maxFee common.Big,
+	itemCount int,
+	amount common.Big,
+) RatioFeeReceipt {
+	return RatioFeeReceipt{
+		BaseHinter: hint.NewBaseHinter(RatioFeeReceiptHint),
+		currencyID: currencyID,
+		totalFee:   totalFee.String(),
+		ratio:      ratio,
+		minFee:     minFee.String(),
+		maxFee:     maxFee.String(),
+		itemCount:  itemCount,
+		amount:     amount.String(),
+	}
+}
+
+func (r RatioFeeReceipt) Currency() CurrencyID {
+	return r.currencyID
+}
+
+func (r RatioFeeReceipt) FeeAmount() string {
+	return r.totalFee
+}
+
+// Ratio returns the fee ratio in basis points.
+func (r RatioFeeReceipt) Ratio() uint64 {
+	return r.ratio
+}
+
+func (r RatioFeeReceipt) MinFee() string {
+	return r.minFee
+}
+
+func (r RatioFeeReceipt) MaxFee() string {
+	return r.maxFee
+}
+
+func (r RatioFeeReceipt) ItemCount() int {
+	return r.itemCount
+}
+
+// Amount returns the sum of the amounts moved by items in the fee currency.
+func (r RatioFeeReceipt) Amount() string {
+	return r.amount
+}
+
+func (r RatioFeeReceipt) IsValid([]byte) error {
+	if err := r.BaseHinter.IsValid(RatioFeeReceiptHint.Type().Bytes()); err != nil {
+		return err
+	}
+
+	if err := r.currencyID.IsValid(nil); err != nil {
+		return err
+	}
+
+	if r.itemCount < 0 {
+		return util.ErrInvalid.Errorf("item count under zero")
+	}
+
+	if r.ratio > RatioFeeerDenominator {
+		return util.ErrInvalid.Errorf("ratio over %d", RatioFeeerDenominator)
+	}
+
+	totalFee, err := parseReceiptAmount("total_fee", r.totalFee)
+	if err != nil {
+		return err
+	}
+
+	minFee, err := parseReceiptAmount("min_fee", r.minFee)
+	if err != nil {
+		return err
+	}
+
+	maxFee, err := common.NewBigFromString(r.maxFee)
+	if err != nil {
+		return util.ErrInvalid.Errorf("invalid max_fee: %v", err)
+	}
+
+	amount, err := parseReceiptAmount("amount", r.amount)
+	if err != nil {
+		return err
+	}
+
+	if totalFee.Compare(minFee.MulInt64(int64(r.itemCount))) < 0 {
+		return util.ErrInvalid.Errorf("total_fee under min_fee * item_count")
+	}
+
+	if !maxFee.Equal(UnlimitedMaxFeeAmount) {
+		if !maxFee.OverNil() {
+			return util.ErrInvalid.Errorf("max_fee under zero")
+		} else if totalFee.Compare(maxFee.MulInt64(int64(r.itemCount))) > 0 {
+			return util.ErrInvalid.Errorf("total_fee over max_fee * item_count")
+		}
+	}
+
+	upper := amount.MulInt64(int64(r.ratio)).
+		Div(common.NewBig(int64(RatioFeeerDenominator))).
+		Add(minFee.MulInt64(int64(r.itemCount)))
+	if totalFee.Compare(upper) > 0 {
+		return util.ErrInvalid.Errorf("total_fee does not match ratio fee formula")
+	}
+
+	return nil
+}
+
+func newRatioFeeReceipt(
+	currencyID CurrencyID,
+	fa RatioFeeer,
+	itemCount int,
+	amounts []common.Big,
+) (FeeReceipt, common.Big) {
+	if len(amounts) < 1 {
+		amounts = make([]common.Big, itemCount)
+		for i := range amounts {
+			amounts[i] = common.ZeroBig
+		}
+	}
+
+	totalAmount := common.ZeroBig
+	totalFee := common.ZeroBig
+	for i := range amounts {
+		if amounts[i].OverZero() {
+			totalAmount = totalAmount.Add(amounts[i])
+		}
+
+		totalFee = totalFee.Add(fa.AmountFee(amounts[i]))
+	}
+
+	return NewRatioFeeReceipt(
+		currencyID,
+		totalFee,
+		fa.ratio,
+		fa.min,
+		fa.max,
+		len(amounts),
+		totalAmount,
+	), totalFee
+}
+
+// ConvertedFeeReceipt is the fee receipt of the policy with FeeCurrency; the
+// source receipt keeps the fee calculated in the currency of the operation and
+// the total fee is charged in the fee currency.
+type ConvertedFeeReceipt struct {
+	hint.BaseHinter
+	currencyID  CurrencyID
+	totalFee    string
+	numerator   string
+	denominator string
+	source      FeeReceipt
+}
+
+func NewConvertedFeeReceipt(fc FeeCurrency, source FeeReceipt) (ConvertedFeeReceipt, common.Big) {
+	var sourceFee common.Big
+	if source != nil {
+		if i, err := common.NewBigFromString(source.FeeAmount()); err == nil {
+			sourceFee = i
+		}
+	}
+
+	totalFee := fc.Convert(sourceFee)
+
+	return ConvertedFeeReceipt{
+		BaseHinter:  hint.NewBaseHinter(ConvertedFeeReceiptHint),
+		currencyID:  fc.Currency(),
+		totalFee:    totalFee.String(),
+		numerator:   fc.Numerator().String(),
+		denominator: fc.Denominator().String(),
+		source:      source,
+	}, totalFee
+}
+
+// Currency returns the currency, in which the fee is charged.
+func (r ConvertedFeeReceipt) Currency() CurrencyID {
+	return r.currencyID
+}
+
+func (r ConvertedFeeReceipt) FeeAmount() string {
+	return r.totalFee
+}
+
+func (r ConvertedFeeReceipt) Numerator() string {
+	return r.numerator
+}
+
+func (r ConvertedFeeReceipt) Denominator() string {
+	return r.denominator
+}
+
+// Source returns the fee receipt before conversion.
+func (r ConvertedFeeReceipt) Source() FeeReceipt {
+	return r.source
+}
+
+func (r ConvertedFeeReceipt) IsValid([]byte) error {
+	if err := r.BaseHinter.IsValid(ConvertedFeeReceiptHint.Type().Bytes()); err != nil {
+		return err
+	}
+
+	if err := r.currencyID.IsValid(nil); err != nil {
+		return err
+	}
+
+	if r.source == nil {
+		return util.ErrInvalid.Errorf("empty source fee receipt")
+	}
+
+	if err := r.source.IsValid(nil); err != nil {
+		return err
+	}
+
+	if r.source.Currency() == r.currencyID {
+		return util.ErrInvalid.Errorf("source fee receipt has same currency, %v", r.currencyID)
+	}
+
+	totalFee, err := parseReceiptAmount("total_fee", r.totalFee)
+	if err != nil {
+		return err
+	}
+
+	numerator, err := parseReceiptAmount("numerator", r.numerator)
+	if err != nil {
+		return err
+	}
+
+	denominator, err := parseReceiptAmount("denominator", r.denominator)
+	if err != nil {
+		return err
+	}
+
+	sourceFee, err := parseReceiptAmount("source total_fee", r.source.FeeAmount())
+	if err != nil {
+		return err
+	}
+
+	fc := NewFeeCurrency(r.currencyID, numerator, denominator)
+	if err := fc.IsValid(nil); err != nil {
+		return err
+	}
+
+	if !totalFee.Equal(fc.Convert(sourceFee)) {
+		return util.ErrInvalid.Errorf("total_fee does not match converted source fee")
+	}
+
+	return nil
+}
+
 func NewFeeReceiptFromFeeer(
 	currencyID CurrencyID,
 	feeer Feeer,
 	itemCount int,
 	dataSize int,
+) (FeeReceipt, common.Big) {
+	return NewFeeReceiptFromFeeerWithAmounts(currencyID, feeer, itemCount, dataSize, nil)
+}
+
+// NewFeeReceiptFromFeeerWithAmounts calculates the fee with the amounts moved by each item. The amounts are used by
+// AmountFeeer like RatioFeeer, which charges each item separately; without amounts, each item is charged as zero
+// amount.
+func NewFeeReceiptFromFeeerWithAmounts(
+	currencyID CurrencyID,
+	feeer Feeer,
+	itemCount int,
+	dataSize int,
+	amounts []common.Big,
 ) (FeeReceipt, common.Big) {
 	if feeer == nil {
 		return nil, common.ZeroBig
 	}
 
 	switch fa := feeer.(type) {
+	case SplitFeeer:
+		return NewFeeReceiptFromFeeerWithAmounts(currencyID, fa.feeer, itemCount, dataSize, amounts)
+	case *RatioFeeer:
+		if fa == nil {
+			return nil, common.ZeroBig
+		}
+
+		return newRatioFeeReceipt(currencyID, *fa, itemCount, amounts)
+	case RatioFeeer:
+		return newRatioFeeReceipt(currencyID, fa, itemCount, amounts)
 	case *FixedItemDataSizeExecutionFeeer:
 		if fa == nil {
 			return nil, common.ZeroBig
@@ -392,6 +681,17 @@ func NewFeeReceiptFromFeeer(
 		totalAmount := fa.Fee()
 
 		return NewFixedFeeReceipt(currencyID, totalAmount), totalAmount
+	case AmountFeeer:
+		totalAmount := common.ZeroBig
+		if len(amounts) < 1 {
+			totalAmount = fa.AmountFee(common.ZeroBig).MulInt64(int64(itemCount))
+		}
+
+		for i := range amounts {
+			totalAmount = totalAmount.Add(fa.AmountFee(amounts[i]))
+		}
+
+		return NewBaseFeeReceipt(currencyID, totalAmount), totalAmount
 	default:
 		totalAmount := feeer.Fee()
 
@@ -172,6 +172,116 @@ func (r *FixedItemDataSizeExecutionFeeReceipt) DecodeBSON(b []byte, enc *bsonenc
 	return nil
 }
 
+func (r RatioFeeReceipt) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":       r.Hint().String(),
+			"currency_id": r.currencyID,
+			"total_fee":   r.totalFee,
+			"ratio":       r.ratio,
+			"min_fee":     r.minFee,
+			"max_fee":     r.maxFee,
+			"item_count":  r.itemCount,
+			"amount":      r.amount,
+		},
+	)
+}
+
+type RatioFeeReceiptBSONUnmarshaler struct {
+	Hint       string     `bson:"_hint"`
+	CurrencyID CurrencyID `bson:"currency_id"`
+	TotalFee   string     `bson:"total_fee"`
+	Ratio      uint64     `bson:"ratio"`
+	MinFee     string     `bson:"min_fee"`
+	MaxFee     string     `bson:"max_fee"`
+	ItemCount  int        `bson:"item_count"`
+	Amount     string     `bson:"amount"`
+}
+
+func (r *RatioFeeReceipt) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	var u RatioFeeReceiptBSONUnmarshaler
+
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return err
+	}
+
+	hts := u.Hint
+	if hts == "" {
+		hts = RatioFeeReceiptHint.String()
+	}
+
+	ht, err := hint.ParseHint(hts)
+	if err != nil {
+		return err
+	}
+
+	r.BaseHinter = hint.NewBaseHinter(ht)
+	r.currencyID = u.CurrencyID
+	r.totalFee = u.TotalFee
+	r.ratio = u.Ratio
+	r.minFee = u.MinFee
+	r.maxFee = u.MaxFee
+	r.itemCount = u.ItemCount
+	r.amount = u.Amount
+
+	return nil
+}
+
+func (r ConvertedFeeReceipt) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":       r.Hint().String(),
+			"currency_id": r.currencyID,
+			"total_fee":   r.totalFee,
+			"numerator":   r.numerator,
+			"denominator": r.denominator,
+			"source":      r.source,
+		},
+	)
+}
+
+type ConvertedFeeReceiptBSONUnmarshaler struct {
+	Hint        string     `bson:"_hint"`
+	CurrencyID  CurrencyID `bson:"currency_id"`
+	TotalFee    string     `bson:"total_fee"`
+	Numerator   string     `bson:"numerator"`
+	Denominator string     `bson:"denominator"`
+	Source      bson.Raw   `bson:"source"`
+}
+
+func (r *ConvertedFeeReceipt) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	var u ConvertedFeeReceiptBSONUnmarshaler
+
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return err
+	}
+
+	hts := u.Hint
+	if hts == "" {
+		hts = ConvertedFeeReceiptHint.String()
+	}
+
+	ht, err := hint.ParseHint(hts)
+	if err != nil {
+		return err
+	}
+
+	r.BaseHinter = hint.NewBaseHinter(ht)
+	r.currencyID = u.CurrencyID
+	r.totalFee = u.TotalFee
+	r.numerator = u.Numerator
+	r.denominator = u.Denominator
+
+	var source FeeReceipt
+	if err := encoder.Decode(enc, u.Source, &source); err != nil {
+		return err
+	}
+
+	r.source = source
+
+	return nil
+}
+
 func (r CurrencyOperationReceipt) MarshalBSON() ([]byte, error) {
 	m := bson.M{
 		"_hint": r.Hint().String(),
@@ -190,6 +190,122 @@ func (r *FixedItemDataSizeExecutionFeeReceipt) DecodeJSON(b []byte, enc encoder.
 	return nil
 }
 
+type RatioFeeReceiptJSONMarshaler struct {
+	hint.BaseHinter
+	CurrencyID CurrencyID `json:"currency_id"`
+	TotalFee   string     `json:"total_fee"`
+	Ratio      uint64     `json:"ratio"`
+	MinFee     string     `json:"min_fee"`
+	MaxFee     string     `json:"max_fee"`
+	ItemCount  int        `json:"item_count"`
+	Amount     string     `json:"amount"`
+}
+
+func (r RatioFeeReceipt) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RatioFeeReceiptJSONMarshaler{
+		BaseHinter: r.BaseHinter,
+		CurrencyID: r.currencyID,
+		TotalFee:   r.totalFee,
+		Ratio:      r.ratio,
+		MinFee:     r.minFee,
+		MaxFee:     r.maxFee,
+		ItemCount:  r.itemCount,
+		Amount:     r.amount,
+	})
+}
+
+type RatioFeeReceiptJSONUnmarshaler struct {
+	Hint       hint.Hint  `json:"_hint"`
+	CurrencyID CurrencyID `json:"currency_id"`
+	TotalFee   string     `json:"total_fee"`
+	Ratio      uint64     `json:"ratio"`
+	MinFee     string     `json:"min_fee"`
+	MaxFee     string     `json:"max_fee"`
+	ItemCount  int        `json:"item_count"`
+	Amount     string     `json:"amount"`
+}
+
+func (r *RatioFeeReceipt) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u RatioFeeReceiptJSONUnmarshaler
+
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return err
+	}
+
+	ht := u.Hint
+	if ht.String() == "" {
+		ht = RatioFeeReceiptHint
+	}
+
+	r.BaseHinter = hint.NewBaseHinter(ht)
+	r.currencyID = u.CurrencyID
+	r.totalFee = u.TotalFee
+	r.ratio = u.Ratio
+	r.minFee = u.MinFee
+	r.maxFee = u.MaxFee
+	r.itemCount = u.ItemCount
+	r.amount = u.Amount
+
+	return nil
+}
+
+type ConvertedFeeReceiptJSONMarshaler struct {
+	hint.BaseHinter
+	CurrencyID  CurrencyID `json:"currency_id"`
+	TotalFee    string     `json:"total_fee"`
+	Numerator   string     `json:"numerator"`
+	Denominator string     `json:"denominator"`
+	Source      FeeReceipt `json:"source"`
+}
+
+func (r ConvertedFeeReceipt) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ConvertedFeeReceiptJSONMarshaler{
+		BaseHinter:  r.BaseHinter,
+		CurrencyID:  r.currencyID,
+		TotalFee:    r.totalFee,
+		Numerator:   r.numerator,
+		Denominator: r.denominator,
+		Source:      r.source,
+	})
+}
+
+type ConvertedFeeReceiptJSONUnmarshaler struct {
+	Hint        hint.Hint       `json:"_hint"`
+	CurrencyID  CurrencyID      `json:"currency_id"`
+	TotalFee    string          `json:"total_fee"`
+	Numerator   string          `json:"numerator"`
+	Denominator string          `json:"denominator"`
+	Source      json.RawMessage `json:"source"`
+}
+
+func (r *ConvertedFeeReceipt) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	var u ConvertedFeeReceiptJSONUnmarshaler
+
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return err
+	}
+
+	ht := u.Hint
+	if ht.String() == "" {
+		ht = ConvertedFeeReceiptHint
+	}
+
+	r.BaseHinter = hint.NewBaseHinter(ht)
+	r.currencyID = u.CurrencyID
+	r.totalFee = u.TotalFee
+	r.numerator = u.Numerator
+	r.denominator = u.Denominator
+
+	var source FeeReceipt
+	if err := encoder.Decode(enc, u.Source, &source); err != nil {
+		return err
+	}
+
+	r.source = source
+
+	return nil
+}
+
 type CurrencyOperationReceiptJSONMarshaler struct {
 	hint.BaseHinter
 	Feeer   string     `json:"feeer,omitempty"`
@@ -8,6 +8,7 @@ import (
 	"github.com/imfact-labs/currency-model/common"
 	"github.com/imfact-labs/currency-model/types"
 	"github.com/imfact-labs/currency-model/utils/bsonenc"
+	"github.com/imfact-labs/mitum2/base"
 	"github.com/imfact-labs/mitum2/util/encoder"
 	jsonenc "github.com/imfact-labs/mitum2/util/encoder/json"
 )
@@ -94,6 +95,69 @@ func requireFixedItemDataSizeExecutionFeeReceipt(t *testing.T, fee types.FeeRece
 	}
 }
 
+func requireRatioFeeReceipt(t *testing.T, fee types.FeeReceipt) {
+	t.Helper()
+
+	var got types.RatioFeeReceipt
+	switch r := fee.(type) {
+	case types.RatioFeeReceipt:
+		got = r
+	case *types.RatioFeeReceipt:
+		if r == nil {
+			t.Fatal("nil ratio fee receipt")
+		}
+
+		got = *r
+	default:
+		t.Fatalf("unexpected fee receipt type: %T", fee)
+	}
+
+	if got.Currency() != types.CurrencyID("MCC") || got.FeeAmount() != "75" || got.Ratio() != 100 {
+		t.Fatalf("unexpected ratio fee receipt: %+v", got)
+	}
+
+	if got.MinFee() != "5" || got.MaxFee() != "50" || got.ItemCount() != 3 || got.Amount() != "11100" {
+		t.Fatalf("unexpected ratio fee detail: %+v", got)
+	}
+}
+
+func newTestConvertedFeeReceipt() types.FeeReceipt {
+	r, _ := types.NewConvertedFeeReceipt(
+		types.NewFeeCurrency(types.CurrencyID("USD"), common.NewBig(3), common.NewBig(2)),
+		types.NewFixedFeeReceipt(types.CurrencyID("MCC"), common.NewBig(10)),
+	)
+
+	return r
+}
+
+func requireConvertedFeeReceipt(t *testing.T, fee types.FeeReceipt) {
+	t.Helper()
+
+	var got types.ConvertedFeeReceipt
+	switch r := fee.(type) {
+	case types.ConvertedFeeReceipt:
+		got = r
+	case *types.ConvertedFeeReceipt:
+		if r == nil {
+			t.Fatal("nil converted fee receipt")
+		}
+
+		got = *r
+	default:
+		t.Fatalf("unexpected fee receipt type: %T", fee)
+	}
+
+	if got.Currency() != types.CurrencyID("USD") || got.FeeAmount() != "15" {
+		t.Fatalf("unexpected converted fee receipt: %+v", got)
+	}
+
+	if got.Numerator() != "3" || got.Denominator() != "2" {
+		t.Fatalf("unexpected converted fee rate: %+v", got)
+	}
+
+	requireFixedFeeReceipt(t, got.Source(), types.CurrencyID("MCC"), "10")
+}
+
 func TestCurrencyOperationReceiptRoundTrip(t *testing.T) {
 	encs, benc := newTestEncoders(t)
 	gasUsed := uint64(33)
@@ -135,6 +199,26 @@ func TestCurrencyOperationReceiptRoundTrip(t *testing.T) {
 			),
 			assert: requireFixedItemDataSizeExecutionFeeReceipt,
 		},
+		{
+			name:  "ratio",
+			feeer: types.RatioFeeerHint.String(),
+			fee: types.NewRatioFeeReceipt(
+				types.CurrencyID("MCC"),
+				common.NewBig(75),
+				100,
+				common.NewBig(5),
+				common.NewBig(50),
+				3,
+				common.NewBig(11100),
+			),
+			assert: requireRatioFeeReceipt,
+		},
+		{
+			name:   "converted",
+			feeer:  types.FixedFeeerHint.String(),
+			fee:    newTestConvertedFeeReceipt(),
+			assert: requireConvertedFeeReceipt,
+		},
 	}
 
 	for _, tc := range tests {
@@ -320,3 +404,64 @@ func TestFixedItemDataSizeExecutionFeeReceiptJSONOmitsEmptyExecutionFields(t *te
 		t.Fatalf("expected fee receipt not to marshal feeer: %s", s)
 	}
 }
+
+func TestRatioFeeReceiptValidationRejectsFeeOverMax(t *testing.T) {
+	receipt := types.NewRatioFeeReceipt(
+		types.CurrencyID("MCC"),
+		common.NewBig(151),
+		100,
+		common.NewBig(5),
+		common.NewBig(50),
+		3,
+		common.NewBig(100000),
+	)
+
+	if err := receipt.IsValid(nil); err == nil {
+		t.Fatal("expected ratio fee receipt validation error")
+	}
+}
+
+func TestTieredFeeerChargesByAmountBracket(t *testing.T) {
+	key, err := types.NewBaseAccountKey(base.NewMPrivatekey().Publickey(), 100)
+	if err != nil {
+		t.Fatalf("new account key: %v", err)
+	}
+
+	keys, err := types.NewBaseAccountKeys([]types.AccountKey{key}, 100)
+	if err != nil {
+		t.Fatalf("new account keys: %v", err)
+	}
+
+	receiver, err := types.NewAddressFromKeys(keys)
+	if err != nil {
+		t.Fatalf("new receiver: %v", err)
+	}
+
+	feeer := types.NewTieredFeeer(receiver, []types.FeeBracket{
+		types.NewFeeBracket(common.NewBig(0), common.NewBig(1000), common.NewBig(1)),
+		types.NewFeeBracket(common.NewBig(1000), common.NewBig(100000), common.NewBig(5)),
+		types.NewFeeBracket(common.NewBig(100000), common.NewBig(-1), common.NewBig(20)),
+	})
+	if err := feeer.IsValid(nil); err != nil {
+		t.Fatalf("invalid tiered feeer: %v", err)
+	}
+
+	fee, total := types.NewFeeReceiptFromFeeerWithAmounts(
+		types.CurrencyID("MCC"),
+		feeer,
+		3,
+		0,
+		[]common.Big{common.NewBig(999), common.NewBig(1000), common.NewBig(100000)},
+	)
+	if fee == nil || !total.Equal(common.NewBig(26)) || fee.FeeAmount() != "26" {
+		t.Fatalf("unexpected tiered fee: %v, %v", fee, total)
+	}
+
+	gap := types.NewTieredFeeer(receiver, []types.FeeBracket{
+		types.NewFeeBracket(common.NewBig(0), common.NewBig(1000), common.NewBig(1)),
+		types.NewFeeBracket(common.NewBig(1001), common.NewBig(-1), common.NewBig(5)),
+	})
+	if err := gap.IsValid(nil); err == nil {
+		t.Fatal("expected tiered feeer validation error for gap between brackets")
+	}
+}
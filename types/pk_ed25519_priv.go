@@ -0,0 +1,152 @@
+package types
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var (
+	EdPrivatekeyHint = hint.MustNewHint("epr-v0.0.1")
+	EdPublickeyHint  = hint.MustNewHint("epu-v0.0.1")
+)
+
+// EdPrivatekey is the privatekey of mitum, it is based on Ed25519. The string
+// of EdPrivatekey is the hex of the Ed25519 seed.
+type EdPrivatekey struct {
+	priv ed25519.PrivateKey
+	s    string
+	pub  EdPublickey
+	b    []byte
+	hint.BaseHinter
+}
+
+func NewEdPrivatekey() EdPrivatekey {
+	_, priv, _ := ed25519.GenerateKey(rand.Reader)
+
+	return newEdPrivatekeyFromPrivateKey(priv)
+}
+
+func NewEdPrivatekeyFromSeed(s string) (EdPrivatekey, error) {
+	if l := len([]byte(s)); l < base.PrivatekeyMinSeedSize {
+		return EdPrivatekey{}, util.ErrInvalid.Errorf(
+			"wrong seed for privatekey; too short, %d < %d", l, base.PrivatekeyMinSeedSize)
+	}
+
+	h := sha256.Sum256([]byte(s))
+
+	return newEdPrivatekeyFromPrivateKey(ed25519.NewKeyFromSeed(h[:])), nil
+}
+
+func ParseEdPrivatekey(s string) (EdPrivatekey, error) {
+	t := EdPrivatekeyHint.Type().String()
+
+	switch {
+	case !strings.HasSuffix(s, t):
+		return EdPrivatekey{}, util.ErrInvalid.Errorf("Unknown private key string")
+	case len(s) <= len(t):
+		return EdPrivatekey{}, util.ErrInvalid.Errorf("Invalid private key string; too short")
+	}
+
+	return LoadEdPrivatekey(s[:len(s)-len(t)])
+}
+
+func LoadEdPrivatekey(s string) (EdPrivatekey, error) {
+	h, err := hex.DecodeString(s)
+	if err != nil {
+		return EdPrivatekey{}, err
+	}
+
+	if len(h) != ed25519.SeedSize {
+		return EdPrivatekey{}, util.ErrInvalid.Errorf(
+			"wrong private key size, %d != %d", len(h), ed25519.SeedSize)
+	}
+
+	return newEdPrivatekeyFromPrivateKey(ed25519.NewKeyFromSeed(h)), nil
+}
+
+func newEdPrivatekeyFromPrivateKey(priv ed25519.PrivateKey) EdPrivatekey {
+	k := EdPrivatekey{
+		BaseHinter: hint.NewBaseHinter(EdPrivatekeyHint),
+		priv:       priv,
+	}
+
+	return k.ensure()
+}
+
+func (k EdPrivatekey) String() string {
+	return k.s
+}
+
+func (k EdPrivatekey) Bytes() []byte {
+	return k.b
+}
+
+func (k EdPrivatekey) IsValid([]byte) error {
+	if err := k.BaseHinter.IsValid(EdPrivatekeyHint.Type().Bytes()); err != nil {
+		return util.ErrInvalid.WithMessage(err, "wrong hint in private key")
+	}
+
+	switch {
+	case len(k.priv) != ed25519.PrivateKeySize:
+		return util.ErrInvalid.Errorf("empty ed25519 private key")
+	case len(k.s) < 1:
+		return util.ErrInvalid.Errorf("empty private key string")
+	case len(k.b) < 1:
+		return util.ErrInvalid.Errorf("empty private key []byte")
+	}
+
+	return nil
+}
+
+func (k EdPrivatekey) Publickey() base.Publickey {
+	return k.pub
+}
+
+func (k EdPrivatekey) Equal(b base.PKKey) bool {
+	switch {
+	case b == nil:
+		return false
+	default:
+		return k.s == b.String()
+	}
+}
+
+func (k EdPrivatekey) Sign(b []byte) (base.Signature, error) {
+	return base.Signature(ed25519.Sign(k.priv, b)), nil
+}
+
+func (k EdPrivatekey) MarshalText() ([]byte, error) {
+	return []byte(k.s), nil
+}
+
+func (k *EdPrivatekey) UnmarshalText(b []byte) error {
+	u, err := LoadEdPrivatekey(string(b))
+	if err != nil {
+		return errors.Wrap(err, "UnmarshalText for private key")
+	}
+
+	*k = u.ensure()
+
+	return nil
+}
+
+func (k *EdPrivatekey) ensure() EdPrivatekey {
+	if len(k.priv) < 1 {
+		return *k
+	}
+
+	k.pub = NewEdPublickey(k.priv.Public().(ed25519.PublicKey))
+	k.s = fmt.Sprintf("%s%s", hex.EncodeToString(k.priv.Seed()), k.Hint().Type().String())
+	k.b = []byte(k.s)
+
+	return *k
+}
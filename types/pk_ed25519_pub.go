@@ -0,0 +1,130 @@
+package types
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/imfact-labs/currency-model/common"
+	"github.com/imfact-labs/mitum2/base"
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/imfact-labs/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+// EdPublickey is the public key of mitum, it is based on Ed25519.
+type EdPublickey struct {
+	k ed25519.PublicKey
+	s string
+	b []byte
+	hint.BaseHinter
+}
+
+func NewEdPublickey(k ed25519.PublicKey) EdPublickey {
+	pub := EdPublickey{
+		BaseHinter: hint.NewBaseHinter(EdPublickeyHint),
+		k:          k,
+	}
+
+	return pub.ensure()
+}
+
+func ParseEdPublickey(s string) (EdPublickey, error) {
+	t := EdPublickeyHint.Type().String()
+
+	switch {
+	case !strings.HasSuffix(s, t):
+		return EdPublickey{}, util.ErrInvalid.Errorf("unknown public key string")
+	case len(s) <= len(t):
+		return EdPublickey{}, util.ErrInvalid.Errorf("invalid public key string; too short")
+	}
+
+	return LoadEdPublickey(s[:len(s)-len(t)])
+}
+
+func LoadEdPublickey(s string) (EdPublickey, error) {
+	h, err := hex.DecodeString(s)
+	if err != nil {
+		return EdPublickey{}, util.ErrInvalid.WithMessage(err, "load public key")
+	}
+
+	if len(h) != ed25519.PublicKeySize {
+		return EdPublickey{}, util.ErrInvalid.Errorf(
+			"wrong public key size, %d != %d", len(h), ed25519.PublicKeySize)
+	}
+
+	return NewEdPublickey(ed25519.PublicKey(h)), nil
+}
+
+func (k EdPublickey) String() string {
+	return k.s
+}
+
+func (k EdPublickey) Bytes() []byte {
+	return k.b
+}
+
+func (k EdPublickey) IsValid([]byte) error {
+	if err := k.BaseHinter.IsValid(EdPublickeyHint.Type().Bytes()); err != nil {
+		return util.ErrInvalid.WithMessage(err, "wrong hint in public key")
+	}
+
+	switch {
+	case len(k.k) != ed25519.PublicKeySize:
+		return util.ErrInvalid.Errorf("empty public key in EdPublickey")
+	case len(k.s) < 1:
+		return util.ErrInvalid.Errorf("empty public key string")
+	case len(k.b) < 1:
+		return util.ErrInvalid.Errorf("empty public key []byte")
+	}
+
+	return nil
+}
+
+func (k EdPublickey) Equal(b base.PKKey) bool {
+	switch {
+	case b == nil:
+		return false
+	default:
+		return k.s == b.String()
+	}
+}
+
+func (k EdPublickey) Verify(input []byte, sig base.Signature) error {
+	if len(sig) != ed25519.SignatureSize {
+		return common.ErrValueInvalid.Wrap(base.ErrSignatureVerification.WithStack())
+	}
+
+	if !ed25519.Verify(k.k, input, sig) {
+		return base.ErrSignatureVerification.WithStack()
+	}
+
+	return nil
+}
+
+func (k EdPublickey) MarshalText() ([]byte, error) {
+	return []byte(k.s), nil
+}
+
+func (k *EdPublickey) UnmarshalText(b []byte) error {
+	u, err := LoadEdPublickey(string(b))
+	if err != nil {
+		return errors.Wrap(err, "UnmarshalText for public key")
+	}
+
+	*k = u.ensure()
+
+	return nil
+}
+
+func (k *EdPublickey) ensure() EdPublickey {
+	if len(k.k) < 1 {
+		return *k
+	}
+
+	k.s = fmt.Sprintf("%s%s", hex.EncodeToString(k.k), k.Hint().Type().String())
+	k.b = []byte(k.s)
+
+	return *k
+}
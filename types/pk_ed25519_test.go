@@ -0,0 +1,156 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/imfact-labs/currency-model/types"
+	"github.com/imfact-labs/mitum2/base"
+)
+
+func TestEdPrivatekeyFromSeed(t *testing.T) {
+	a, err := types.NewEdPrivatekeyFromSeed("ed25519-seed-for-deterministic-account-keys")
+	if err != nil {
+		t.Fatalf("new private key: %v", err)
+	}
+
+	b, err := types.NewEdPrivatekeyFromSeed("ed25519-seed-for-deterministic-account-keys")
+	if err != nil {
+		t.Fatalf("new private key: %v", err)
+	}
+
+	if !a.Equal(b) || !a.Publickey().Equal(b.Publickey()) {
+		t.Fatal("expected same private key from same seed")
+	}
+
+	if _, err := types.NewEdPrivatekeyFromSeed("short"); err == nil {
+		t.Fatal("expected too short seed rejected")
+	}
+}
+
+func TestParseEdKeys(t *testing.T) {
+	priv := types.NewEdPrivatekey()
+	pub := priv.Publickey()
+
+	if !strings.HasSuffix(priv.String(), types.EdPrivatekeyHint.Type().String()) ||
+		!strings.HasSuffix(pub.String(), types.EdPublickeyHint.Type().String()) {
+		t.Fatalf("unexpected key string suffix, %q, %q", priv, pub)
+	}
+
+	if p, err := types.ParseEdPrivatekey(priv.String()); err != nil {
+		t.Fatalf("parse private key: %v", err)
+	} else if !p.Equal(priv) {
+		t.Fatal("parsed private key not matched")
+	}
+
+	if p, err := types.ParseEdPublickey(pub.String()); err != nil {
+		t.Fatalf("parse public key: %v", err)
+	} else if !p.Equal(pub) {
+		t.Fatal("parsed public key not matched")
+	}
+
+	me := base.NewMPrivatekey()
+
+	for _, s := range []string{
+		me.Publickey().String(),
+		types.EdPublickeyHint.Type().String(),
+		"zz" + types.EdPublickeyHint.Type().String(),
+		pub.String()[2:],
+	} {
+		if _, err := types.ParseEdPublickey(s); err == nil {
+			t.Fatalf("expected public key string rejected, %q", s)
+		}
+	}
+
+	if _, err := types.ParseEdPrivatekey(me.String()); err == nil {
+		t.Fatal("expected secp256k1 private key string rejected")
+	}
+}
+
+func TestEdPrivatekeySign(t *testing.T) {
+	priv := types.NewEdPrivatekey()
+	input := []byte("ed25519-sign-input")
+
+	sig, err := priv.Sign(input)
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+
+	if err := priv.Publickey().Verify(input, sig); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+
+	if err := priv.Publickey().Verify([]byte("other-input"), sig); err == nil {
+		t.Fatal("expected other input not verified")
+	}
+
+	if err := types.NewEdPrivatekey().Publickey().Verify(input, sig); err == nil {
+		t.Fatal("expected other public key not verified")
+	}
+}
+
+func TestMixedAccountKeys(t *testing.T) {
+	edKey, err := types.NewBaseAccountKey(types.NewEdPrivatekey().Publickey(), 50)
+	if err != nil {
+		t.Fatalf("new ed25519 account key: %v", err)
+	}
+
+	meKey, err := types.NewBaseAccountKey(base.NewMPrivatekey().Publickey(), 50)
+	if err != nil {
+		t.Fatalf("new secp256k1 account key: %v", err)
+	}
+
+	a, err := types.NewBaseAccountKeys([]types.AccountKey{edKey, meKey}, 100)
+	if err != nil {
+		t.Fatalf("new keys: %v", err)
+	}
+
+	b, err := types.NewBaseAccountKeys([]types.AccountKey{meKey, edKey}, 100)
+	if err != nil {
+		t.Fatalf("new keys: %v", err)
+	}
+
+	aa, err := types.NewAddressFromKeys(a)
+	if err != nil {
+		t.Fatalf("new address: %v", err)
+	}
+
+	ba, err := types.NewAddressFromKeys(b)
+	if err != nil {
+		t.Fatalf("new address: %v", err)
+	}
+
+	if !aa.Equal(ba) {
+		t.Fatalf("expected same address regardless of key order, %v != %v", aa, ba)
+	}
+
+	j, bs := roundTrip(t, a)
+	for _, got := range []types.BaseAccountKeys{j, bs} {
+		if err := got.IsValid(nil); err != nil {
+			t.Fatalf("invalid decoded keys: %v", err)
+		}
+
+		if !got.Equal(a) {
+			t.Fatal("decoded keys not matched")
+		}
+
+		if _, found := got.Key(edKey.Key()); !found {
+			t.Fatal("expected ed25519 key in decoded keys")
+		}
+	}
+}
+
+func TestVerificationMethodEdPublickey(t *testing.T) {
+	pub := types.NewEdPrivatekey().Publickey()
+
+	var vm types.VerificationMethod
+	if err := vm.SetPublicKeyMultibaseFromPublicKey(pub); err != nil {
+		t.Fatalf("set public key multibase: %v", err)
+	}
+
+	// NOTE the ed25519 multicodec prefix, 0xed01 in base58btc starts with
+	// "z6Mk".
+	if s := vm.PublicKeyMultibase(); !strings.HasPrefix(s, "z6Mk") {
+		t.Fatalf("unexpected ed25519 public key multibase, %q", s)
+	}
+}
@@ -0,0 +1,148 @@
+package types
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/imfact-labs/mitum2/util"
+)
+
+// HardenedKeyStart is the first index of the BIP-32 hardened child keys.
+const HardenedKeyStart uint32 = 0x80000000
+
+// DefaultDerivationPath is the BIP-44 path of the accounts, which hardware
+// wallets use for secp256k1 keys; the index of account is appended to it.
+var DefaultDerivationPath = DerivationPath{
+	44 + HardenedKeyStart, 60 + HardenedKeyStart, HardenedKeyStart, 0,
+}
+
+// DerivationPath is the BIP-32 path of child key indexes from the master key.
+type DerivationPath []uint32
+
+// ParseDerivationPath parses the BIP-32 path string like "m/44'/60'/0'/0";
+// "'" or "h" marks the hardened index.
+func ParseDerivationPath(s string) (DerivationPath, error) {
+	l := strings.Split(strings.TrimSpace(s), "/")
+	if l[0] != "m" {
+		return nil, util.ErrInvalid.Errorf("derivation path should start with m, %q", s)
+	}
+
+	p := make(DerivationPath, len(l)-1)
+
+	for i := range l[1:] {
+		c := l[i+1]
+
+		var hardened bool
+		if strings.HasSuffix(c, "'") || strings.HasSuffix(c, "h") {
+			hardened = true
+			c = c[:len(c)-1]
+		}
+
+		n, err := strconv.ParseUint(c, 10, 32)
+		switch {
+		case err != nil:
+			return nil, util.ErrInvalid.Errorf("wrong index in derivation path, %q", l[i+1])
+		case uint32(n) >= HardenedKeyStart:
+			return nil, util.ErrInvalid.Errorf("too big index in derivation path, %q", l[i+1])
+		case hardened:
+			n += uint64(HardenedKeyStart)
+		}
+
+		p[i] = uint32(n)
+	}
+
+	return p, nil
+}
+
+// Child gives the new path, which index is appended.
+func (p DerivationPath) Child(index uint32) DerivationPath {
+	c := make(DerivationPath, len(p)+1)
+	copy(c, p)
+	c[len(p)] = index
+
+	return c
+}
+
+func (p DerivationPath) String() string {
+	var sb strings.Builder
+	sb.WriteString("m")
+
+	for i := range p {
+		if p[i] >= HardenedKeyStart {
+			_, _ = fmt.Fprintf(&sb, "/%d'", p[i]-HardenedKeyStart)
+		} else {
+			_, _ = fmt.Fprintf(&sb, "/%d", p[i])
+		}
+	}
+
+	return sb.String()
+}
+
+// DeriveMEPrivatekey derives the BIP-32 child key of path from seed.
+func DeriveMEPrivatekey(seed []byte, path DerivationPath) (MEPrivatekey, error) {
+	if l := len(seed); l < 16 || l > 64 {
+		return MEPrivatekey{}, util.ErrInvalid.Errorf("wrong seed size, %d", l)
+	}
+
+	k, c, err := hdChild([]byte("Bitcoin seed"), seed, nil)
+	if err != nil {
+		return MEPrivatekey{}, err
+	}
+
+	for i := range path {
+		data := make([]byte, 37)
+
+		if path[i] >= HardenedKeyStart {
+			copy(data[1:33], k)
+		} else {
+			priv, _ := btcec.PrivKeyFromBytes(k)
+			data = append(priv.PubKey().SerializeCompressed(), make([]byte, 4)...)
+		}
+
+		binary.BigEndian.PutUint32(data[33:], path[i])
+
+		if k, c, err = hdChild(c, data, k); err != nil {
+			return MEPrivatekey{}, util.ErrInvalid.Errorf("invalid child key at %s, %v", path[:i+1], err)
+		}
+	}
+
+	priv, err := crypto.ToECDSA(k)
+	if err != nil {
+		return MEPrivatekey{}, err
+	}
+
+	return newMEPrivatekeyFromPrivateKey(priv), nil
+}
+
+// hdChild gives the child key and chain code; the key is added to parent
+// unless parent is nil.
+func hdChild(chainCode, data, parent []byte) ([]byte, []byte, error) {
+	mac := hmac.New(sha512.New, chainCode)
+	_, _ = mac.Write(data)
+	i := mac.Sum(nil)
+
+	n := btcec.S256().N
+
+	k := new(big.Int).SetBytes(i[:32])
+	if k.Cmp(n) >= 0 {
+		return nil, nil, util.ErrInvalid.Errorf("key out of range")
+	}
+
+	if parent != nil {
+		k.Add(k, new(big.Int).SetBytes(parent))
+		k.Mod(k, n)
+	}
+
+	if k.Sign() == 0 {
+		return nil, nil, util.ErrInvalid.Errorf("zero key")
+	}
+
+	return k.FillBytes(make([]byte, 32)), i[32:], nil
+}
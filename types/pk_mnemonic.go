@@ -0,0 +1,116 @@
+package types
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"math/big"
+	"strings"
+
+	"github.com/imfact-labs/mitum2/util"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	mnemonicWords     = strings.Fields(mnemonicEnglishWords)
+	mnemonicWordIndex = func() map[string]int {
+		m := make(map[string]int, len(mnemonicWords))
+		for i := range mnemonicWords {
+			m[mnemonicWords[i]] = i
+		}
+
+		return m
+	}()
+)
+
+// NewMnemonic creates the BIP-39 mnemonic of words from random entropy;
+// words should be one of 12, 15, 18, 21 and 24.
+func NewMnemonic(words int) (string, error) {
+	if words < 12 || words > 24 || words%3 != 0 {
+		return "", util.ErrInvalid.Errorf("wrong number of mnemonic words, %d", words)
+	}
+
+	entropy := make([]byte, words/3*4)
+	if _, err := rand.Read(entropy); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return NewMnemonicFromEntropy(entropy)
+}
+
+// NewMnemonicFromEntropy encodes entropy to the BIP-39 mnemonic.
+func NewMnemonicFromEntropy(entropy []byte) (string, error) {
+	bits := len(entropy) * 8
+	if bits < 128 || bits > 256 || bits%32 != 0 {
+		return "", util.ErrInvalid.Errorf("wrong entropy size, %d bits", bits)
+	}
+
+	cs := bits / 32
+	h := sha256.Sum256(entropy)
+
+	// NOTE entropy followed by the checksum bits
+	b := new(big.Int).SetBytes(entropy)
+	b.Lsh(b, uint(cs))
+	b.Or(b, big.NewInt(int64(h[0]>>(8-cs))))
+
+	n := (bits + cs) / 11
+	ws := make([]string, n)
+	mask := big.NewInt(2047)
+
+	for i := n - 1; i >= 0; i-- {
+		ws[i] = mnemonicWords[new(big.Int).And(b, mask).Int64()]
+		b.Rsh(b, 11)
+	}
+
+	return strings.Join(ws, " "), nil
+}
+
+// MnemonicToEntropy decodes the BIP-39 mnemonic to entropy; it fails when the
+// checksum of mnemonic does not match.
+func MnemonicToEntropy(mnemonic string) ([]byte, error) {
+	ws := strings.Fields(strings.ToLower(mnemonic))
+
+	switch n := len(ws); {
+	case n < 12 || n > 24 || n%3 != 0:
+		return nil, util.ErrInvalid.Errorf("wrong number of mnemonic words, %d", n)
+	}
+
+	b := new(big.Int)
+	for i := range ws {
+		j, found := mnemonicWordIndex[ws[i]]
+		if !found {
+			return nil, util.ErrInvalid.Errorf("unknown mnemonic word, %q", ws[i])
+		}
+
+		b.Lsh(b, 11)
+		b.Or(b, big.NewInt(int64(j)))
+	}
+
+	cs := len(ws) * 11 / 33
+	checksum := new(big.Int).And(b, big.NewInt(int64(1<<cs-1))).Int64()
+	b.Rsh(b, uint(cs))
+
+	entropy := b.FillBytes(make([]byte, cs*4))
+
+	h := sha256.Sum256(entropy)
+	if int64(h[0]>>(8-cs)) != checksum {
+		return nil, util.ErrInvalid.Errorf("wrong mnemonic checksum")
+	}
+
+	return entropy, nil
+}
+
+// NewSeedFromMnemonic gives the BIP-39 seed of mnemonic; passphrase may be
+// empty.
+func NewSeedFromMnemonic(mnemonic, passphrase string) ([]byte, error) {
+	if _, err := MnemonicToEntropy(mnemonic); err != nil {
+		return nil, err
+	}
+
+	m := strings.Join(strings.Fields(strings.ToLower(mnemonic)), " ")
+	salt := norm.NFKD.String("mnemonic" + passphrase)
+
+	return pbkdf2.Key([]byte(norm.NFKD.String(m)), []byte(salt), 2048, 64, sha512.New), nil
+}
@@ -0,0 +1,168 @@
+package types_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/imfact-labs/currency-model/types"
+)
+
+func TestMnemonicDerivesHardwareWalletKeys(t *testing.T) {
+	mnemonic, err := types.NewMnemonicFromEntropy(make([]byte, 16))
+	if err != nil {
+		t.Fatalf("new mnemonic: %v", err)
+	}
+
+	if expected := strings.Repeat("abandon ", 11) + "about"; mnemonic != expected {
+		t.Fatalf("unexpected mnemonic, %q", mnemonic)
+	}
+
+	if entropy, err := types.MnemonicToEntropy(mnemonic); err != nil {
+		t.Fatalf("mnemonic to entropy: %v", err)
+	} else if !bytes.Equal(entropy, make([]byte, 16)) {
+		t.Fatalf("unexpected entropy, %x", entropy)
+	}
+
+	if _, err := types.MnemonicToEntropy(strings.Repeat("abandon ", 12)); err == nil {
+		t.Fatal("expected wrong checksum")
+	}
+
+	seed, err := types.NewSeedFromMnemonic(mnemonic, "TREZOR")
+	if err != nil {
+		t.Fatalf("new seed: %v", err)
+	}
+
+	if s := hex.EncodeToString(seed); s != "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e5349553"+
+		"1f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04" {
+		t.Fatalf("unexpected seed, %s", s)
+	}
+
+	seed, err = types.NewSeedFromMnemonic(mnemonic, "")
+	if err != nil {
+		t.Fatalf("new seed: %v", err)
+	}
+
+	path, err := types.ParseDerivationPath("m/44'/60'/0'/0")
+	if err != nil {
+		t.Fatalf("parse derivation path: %v", err)
+	}
+
+	if path.String() != types.DefaultDerivationPath.String() {
+		t.Fatalf("unexpected derivation path, %s", path)
+	}
+
+	priv, err := types.DeriveMEPrivatekey(seed, path.Child(0))
+	if err != nil {
+		t.Fatalf("derive private key: %v", err)
+	}
+
+	pub, ok := priv.Publickey().(types.MEPublickey)
+	if !ok {
+		t.Fatalf("expected MEPublickey, not %T", priv.Publickey())
+	}
+
+	if a := pub.EtherAddress(); a != "0x9858EfFD232B4033E47d90003D41EC34EcaEda94" {
+		t.Fatalf("unexpected ether address, %s", a)
+	}
+}
+
+func TestMnemonicRejections(t *testing.T) {
+	for _, words := range []int{0, 11, 13, 27} {
+		if _, err := types.NewMnemonic(words); err == nil {
+			t.Fatalf("expected %d words rejected", words)
+		}
+	}
+
+	for _, size := range []int{15, 17, 33} {
+		if _, err := types.NewMnemonicFromEntropy(make([]byte, size)); err == nil {
+			t.Fatalf("expected entropy of %d bytes rejected", size)
+		}
+	}
+
+	for _, mnemonic := range []string{
+		strings.Repeat("abandon ", 11),
+		strings.Repeat("abandon ", 11) + "unknownword",
+		strings.Repeat("abandon ", 11) + "zoo",
+	} {
+		if _, err := types.NewSeedFromMnemonic(mnemonic, ""); err == nil {
+			t.Fatalf("expected mnemonic rejected, %q", mnemonic)
+		}
+	}
+}
+
+func TestNewMnemonic(t *testing.T) {
+	for _, words := range []int{12, 24} {
+		mnemonic, err := types.NewMnemonic(words)
+		if err != nil {
+			t.Fatalf("new mnemonic: %v", err)
+		}
+
+		if n := len(strings.Fields(mnemonic)); n != words {
+			t.Fatalf("expected %d words, not %d", words, n)
+		}
+
+		entropy, err := types.MnemonicToEntropy(mnemonic)
+		if err != nil {
+			t.Fatalf("mnemonic to entropy: %v", err)
+		}
+
+		if m, err := types.NewMnemonicFromEntropy(entropy); err != nil || m != mnemonic {
+			t.Fatalf("expected same mnemonic from entropy, %v", err)
+		}
+	}
+}
+
+func TestParseDerivationPath(t *testing.T) {
+	path, err := types.ParseDerivationPath("m/44h/60h/0h/0/3")
+	if err != nil {
+		t.Fatalf("parse derivation path: %v", err)
+	}
+
+	if s := path.String(); s != "m/44'/60'/0'/0/3" {
+		t.Fatalf("unexpected derivation path, %s", s)
+	}
+
+	for _, s := range []string{
+		"44'/60'/0'/0",
+		"m/44'/x/0'",
+		"m/44'//0'",
+		"m/2147483648",
+		"m/-1",
+	} {
+		if _, err := types.ParseDerivationPath(s); err == nil {
+			t.Fatalf("expected derivation path rejected, %q", s)
+		}
+	}
+}
+
+func TestDeriveMEPrivatekey(t *testing.T) {
+	// NOTE test vector 1 of BIP-32
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+
+	for path, expected := range map[string]string{
+		"m":    "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35",
+		"m/0'": "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea",
+	} {
+		p, err := types.ParseDerivationPath(path)
+		if err != nil {
+			t.Fatalf("parse derivation path: %v", err)
+		}
+
+		priv, err := types.DeriveMEPrivatekey(seed, p)
+		if err != nil {
+			t.Fatalf("derive private key of %s: %v", path, err)
+		}
+
+		if !strings.HasPrefix(priv.String(), expected) {
+			t.Fatalf("unexpected private key of %s, %s", path, priv)
+		}
+	}
+
+	for _, size := range []int{15, 65} {
+		if _, err := types.DeriveMEPrivatekey(make([]byte, size), types.DefaultDerivationPath); err == nil {
+			t.Fatalf("expected seed of %d bytes rejected", size)
+		}
+	}
+}
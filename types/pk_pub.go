@@ -120,6 +120,16 @@ func (k MEPublickey) Verify(input []byte, sig base.Signature) error {
 	return nil
 }
 
+// EtherAddress gives the checksummed ethereum address of public key, which
+// hardware wallets show.
+func (k MEPublickey) EtherAddress() string {
+	if k.k == nil {
+		return ""
+	}
+
+	return crypto.PubkeyToAddress(*k.k).Hex()
+}
+
 func (k MEPublickey) MarshalText() ([]byte, error) {
 	return []byte(k.s), nil
 }